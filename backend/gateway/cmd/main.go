@@ -78,7 +78,22 @@ func main() {
 	}
 	
 	// Apply auth middleware to GraphQL endpoint
-	router.Handle("/graphql", authMiddleware(h)).Methods("GET", "POST", "OPTIONS")
+	router.Handle("/graphql", authMiddleware(internal.NewMultipartHandler(&schema, h))).Methods("GET", "POST", "OPTIONS")
+
+	// Receipt attachment downloads
+	router.Handle("/attachments/{id}", authMiddleware(internal.NewAttachmentDownloadHandler(groupClient))).Methods("GET", "OPTIONS")
+
+	// Ledger export downloads
+	router.Handle("/groups/{id}/export", authMiddleware(internal.NewGroupExportHandler(groupClient))).Methods("GET", "OPTIONS")
+
+	// Printable settlement reports
+	router.Handle("/groups/{id}/report", authMiddleware(internal.NewSettlementReportHandler(groupClient))).Methods("GET", "OPTIONS")
+
+	// Accounting journal downloads
+	router.Handle("/groups/{id}/journal", authMiddleware(internal.NewJournalExportHandler(groupClient))).Methods("GET", "OPTIONS")
+
+	// Bank transfer file downloads
+	router.Handle("/groups/{id}/zengin", authMiddleware(internal.NewZenginTransferHandler(groupClient))).Methods("GET", "OPTIONS")
 
 	// Setup CORS for both local and production
 	var allowedOrigins []string
@@ -0,0 +1,231 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// uploadChunkSize is the size of each chunk streamed to the group service
+const uploadChunkSize = 64 * 1024
+
+var attachmentType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Attachment",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"expenseId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"fileName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"contentType": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"sizeBytes": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"createdAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"downloadUrl": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				attachment, ok := p.Source.(*groupv1.Attachment)
+				if !ok {
+					return nil, nil
+				}
+				return "/attachments/" + attachment.Id, nil
+			},
+		},
+	},
+})
+
+func expenseAttachmentsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewNonNull(attachmentType)),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expense, ok := p.Source.(*groupv1.ExpenseWithDetails)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListExpenseAttachmentsRequest{ExpenseId: expense.Id}
+			resp, err := groupClient.ListExpenseAttachments(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing attachments: %v", err)
+				return nil, err
+			}
+
+			return resp.Attachments, nil
+		},
+	}
+}
+
+func uploadAttachmentField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(attachmentType),
+		Args: graphql.FieldConfigArgument{
+			"expenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"file": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(uploadType),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expenseId, ok := p.Args["expenseId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			file, ok := p.Args["file"].(*Upload)
+			if !ok {
+				return nil, errors.New("file must be sent as a multipart upload")
+			}
+
+			attachment, err := uploadAttachment(groupClient, expenseId, file)
+			if err != nil {
+				log.Printf("Error uploading attachment: %v", err)
+				return nil, err
+			}
+
+			return attachment, nil
+		},
+	}
+}
+
+func deleteAttachmentField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Boolean),
+		Args: graphql.FieldConfigArgument{
+			"attachmentId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			attachmentId, ok := p.Args["attachmentId"].(string)
+			if !ok {
+				return false, nil
+			}
+
+			req := &groupv1.DeleteAttachmentRequest{AttachmentId: attachmentId}
+			resp, err := groupClient.DeleteAttachment(context.Background(), req)
+			if err != nil {
+				log.Printf("Error deleting attachment: %v", err)
+				return false, err
+			}
+
+			return resp.Success, nil
+		},
+	}
+}
+
+// uploadAttachment streams an uploaded file to the group service in chunks
+func uploadAttachment(groupClient groupv1.GroupServiceClient, expenseId string, file *Upload) (*groupv1.Attachment, error) {
+	stream, err := groupClient.UploadAttachment(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	err = stream.Send(&groupv1.UploadAttachmentRequest{
+		Data: &groupv1.UploadAttachmentRequest_Metadata{
+			Metadata: &groupv1.AttachmentMetadata{
+				ExpenseId:   expenseId,
+				FileName:    file.Filename,
+				ContentType: file.ContentType,
+			},
+		},
+	})
+	if err != nil {
+		return nil, uploadStreamError(stream, err)
+	}
+
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, readErr := file.File.Read(buf)
+		if n > 0 {
+			chunk := &groupv1.UploadAttachmentRequest{
+				Data: &groupv1.UploadAttachmentRequest_Chunk{Chunk: buf[:n]},
+			}
+			if err := stream.Send(chunk); err != nil {
+				return nil, uploadStreamError(stream, err)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			stream.CloseSend()
+			return nil, readErr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Attachment, nil
+}
+
+// uploadStreamError returns the server's error when it ends the stream early.
+// Send only reports io.EOF in that case.
+func uploadStreamError(stream groupv1.GroupService_UploadAttachmentClient, err error) error {
+	if err != io.EOF {
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// NewAttachmentDownloadHandler serves attachment content at /attachments/{id}
+func NewAttachmentDownloadHandler(groupClient groupv1.GroupServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &groupv1.DownloadAttachmentRequest{AttachmentId: mux.Vars(r)["id"]}
+		stream, err := groupClient.DownloadAttachment(r.Context(), req)
+		if err != nil {
+			log.Printf("Error downloading attachment: %v", err)
+			http.Error(w, "Failed to download attachment", http.StatusBadGateway)
+			return
+		}
+
+		// The first message carries the metadata
+		first, err := stream.Recv()
+		if err != nil || first.GetAttachment() == nil {
+			log.Printf("Error downloading attachment: %v", err)
+			http.Error(w, "Attachment not found", http.StatusNotFound)
+			return
+		}
+		attachment := first.GetAttachment()
+
+		w.Header().Set("Content-Type", attachment.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// Headers are already written, so the client sees a short body
+				log.Printf("Error streaming attachment: %v", err)
+				return
+			}
+			if _, err := w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,225 @@
+package internal
+
+import (
+	"context"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var bankAccountTypeEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "BankAccountType",
+	Values: graphql.EnumValueConfigMap{
+		"ORDINARY": &graphql.EnumValueConfig{
+			Value: groupv1.BankAccountType_BANK_ACCOUNT_TYPE_ORDINARY,
+		},
+		"CHECKING": &graphql.EnumValueConfig{
+			Value: groupv1.BankAccountType_BANK_ACCOUNT_TYPE_CHECKING,
+		},
+		"SAVINGS": &graphql.EnumValueConfig{
+			Value: groupv1.BankAccountType_BANK_ACCOUNT_TYPE_SAVINGS,
+		},
+	},
+})
+
+var bankAccountType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "BankAccount",
+	Fields: graphql.Fields{
+		"memberId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"bankCode": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"bankName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"branchCode": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"branchName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"accountType": &graphql.Field{
+			Type: graphql.NewNonNull(bankAccountTypeEnum),
+		},
+		"accountNumberLast4": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"accountHolder": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"updatedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+	},
+})
+
+var setMemberBankAccountInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "SetMemberBankAccountInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"groupId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"bankCode": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"bankName": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"branchCode": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"branchName": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"accountType": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(bankAccountTypeEnum),
+		},
+		"accountNumber": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"accountHolder": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+func bankAccountsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(bankAccountType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListBankAccountsRequest{GroupId: groupId}
+			resp, err := groupClient.ListBankAccounts(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing bank accounts: %v", err)
+				return nil, err
+			}
+
+			return resp.BankAccounts, nil
+		},
+	}
+}
+
+func setMemberBankAccountField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(bankAccountType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(setMemberBankAccountInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.SetMemberBankAccountRequest{}
+			req.GroupId, _ = input["groupId"].(string)
+			req.MemberId, _ = input["memberId"].(string)
+			req.BankCode, _ = input["bankCode"].(string)
+			req.BankName, _ = input["bankName"].(string)
+			req.BranchCode, _ = input["branchCode"].(string)
+			req.BranchName, _ = input["branchName"].(string)
+			req.AccountType, _ = input["accountType"].(groupv1.BankAccountType)
+			req.AccountNumber, _ = input["accountNumber"].(string)
+			req.AccountHolder, _ = input["accountHolder"].(string)
+
+			resp, err := groupClient.SetMemberBankAccount(context.Background(), req)
+			if err != nil {
+				log.Printf("Error setting bank account: %v", err)
+				return nil, err
+			}
+
+			return resp.BankAccount, nil
+		},
+	}
+}
+
+func deleteMemberBankAccountField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Boolean),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"memberId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, _ := p.Args["groupId"].(string)
+			memberId, _ := p.Args["memberId"].(string)
+
+			req := &groupv1.DeleteMemberBankAccountRequest{GroupId: groupId, MemberId: memberId}
+			resp, err := groupClient.DeleteMemberBankAccount(context.Background(), req)
+			if err != nil {
+				log.Printf("Error deleting bank account: %v", err)
+				return false, err
+			}
+
+			return resp.Success, nil
+		},
+	}
+}
+
+// NewZenginTransferHandler serves a bulk transfer file at
+// /groups/{id}/zengin. payer (member ID) and requester_code are required;
+// requester_name and date (YYYY-MM-DD, default today) are optional.
+func NewZenginTransferHandler(groupClient groupv1.GroupServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		req := &groupv1.ExportZenginTransfersRequest{
+			GroupId:       mux.Vars(r)["id"],
+			PayerMemberId: query.Get("payer"),
+			RequesterCode: query.Get("requester_code"),
+			RequesterName: query.Get("requester_name"),
+		}
+		if date := query.Get("date"); date != "" {
+			transferDate, err := time.ParseInLocation("2006-01-02", date, time.Local)
+			if err != nil {
+				http.Error(w, "Invalid date", http.StatusBadRequest)
+				return
+			}
+			req.TransferDate = timestamppb.New(transferDate)
+		}
+
+		resp, err := groupClient.ExportZenginTransfers(r.Context(), req)
+		if err != nil {
+			log.Printf("Error exporting transfers: %v", err)
+			http.Error(w, "Failed to export transfers", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", resp.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Write(resp.Content)
+	})
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// budgetPeriodEnum is how often a budget starts over
+var budgetPeriodEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "BudgetPeriod",
+	Values: graphql.EnumValueConfigMap{
+		"TOTAL": &graphql.EnumValueConfig{
+			Value: groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL,
+		},
+		"MONTHLY": &graphql.EnumValueConfig{
+			Value: groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		},
+		"WEEKLY": &graphql.EnumValueConfig{
+			Value: groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY,
+		},
+	},
+})
+
+var budgetType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Budget",
+	Fields: graphql.Fields{
+		"category": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"amount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"period": &graphql.Field{
+			Type: graphql.NewNonNull(budgetPeriodEnum),
+		},
+	},
+})
+
+var budgetStatusType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "BudgetStatus",
+	Fields: graphql.Fields{
+		"budget": &graphql.Field{
+			Type: graphql.NewNonNull(budgetType),
+		},
+		"periodStart": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"periodEnd": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"spent": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"remaining": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"percentUsed": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
+var budgetInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "BudgetInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"amount": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"period": &graphql.InputObjectFieldConfig{
+			Type: budgetPeriodEnum,
+		},
+	},
+})
+
+func budgetsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(budgetType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetBudgetsRequest{GroupId: groupId}
+			resp, err := groupClient.GetBudgets(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting budgets: %v", err)
+				return nil, err
+			}
+
+			return resp.Budgets, nil
+		},
+	}
+}
+
+func updateBudgetsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(budgetType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"budgets": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(budgetInput))),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.UpdateBudgetsRequest{GroupId: groupId}
+			if budgets, ok := p.Args["budgets"].([]interface{}); ok {
+				for _, b := range budgets {
+					budget, ok := b.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					category, _ := budget["category"].(string)
+					amount, _ := budget["amount"].(int)
+					period, _ := budget["period"].(groupv1.BudgetPeriod)
+					req.Budgets = append(req.Budgets, &groupv1.Budget{Category: category, Amount: int64(amount), Period: period})
+				}
+			}
+
+			resp, err := groupClient.UpdateBudgets(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating budgets: %v", err)
+				return nil, err
+			}
+
+			return resp.Budgets, nil
+		},
+	}
+}
+
+func budgetStatusField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(budgetStatusType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"at": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetBudgetStatusRequest{GroupId: groupId}
+			at, ok, err := dateTimeArg(p.Args, "at")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.At = timestamppb.New(at)
+			}
+
+			resp, err := groupClient.GetBudgetStatus(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting budget status: %v", err)
+				return nil, err
+			}
+
+			return resp.Statuses, nil
+		},
+	}
+}
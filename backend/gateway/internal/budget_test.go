@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestBudgetStatus_At(t *testing.T) {
+	client := &fakeGroupClient{}
+
+	executeQuery(t, client, `{
+		budgetStatus(groupId: "g1", at: "2026-04-15T09:00:00Z") { spent }
+	}`)
+
+	if len(client.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(client.requests))
+	}
+	req := client.requests[0].(*groupv1.GetBudgetStatusRequest)
+	if req.At == nil {
+		t.Fatal("at was not sent")
+	}
+	if want := time.Date(2026, 4, 15, 9, 0, 0, 0, time.UTC); !req.At.AsTime().Equal(want) {
+		t.Errorf("At = %v, want %v", req.At.AsTime(), want)
+	}
+}
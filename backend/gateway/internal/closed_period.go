@@ -0,0 +1,153 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var closedPeriodType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ClosedPeriod",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"groupId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"periodStart": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"closedUntil": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"openingBalances": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberBalanceType))),
+		},
+		"balances": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberBalanceType))),
+		},
+		"settlements": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(settlementType))),
+		},
+		"closedBy": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				period, ok := p.Source.(*groupv1.ClosedPeriod)
+				if !ok || period.ClosedBy == "" {
+					return nil, nil
+				}
+				return period.ClosedBy, nil
+			},
+		},
+		"closedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"reopenedAt": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"reopenedBy": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				period, ok := p.Source.(*groupv1.ClosedPeriod)
+				if !ok || period.ReopenedBy == "" {
+					return nil, nil
+				}
+				return period.ReopenedBy, nil
+			},
+		},
+	},
+})
+
+func closedPeriodsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(closedPeriodType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListClosedPeriodsRequest{GroupId: groupId}
+			resp, err := groupClient.ListClosedPeriods(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing closed periods: %v", err)
+				return nil, err
+			}
+
+			return resp.Periods, nil
+		},
+	}
+}
+
+func closePeriodField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(closedPeriodType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"until": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+			"closedBy": &graphql.ArgumentConfig{
+				Type: graphql.ID,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.ClosePeriodRequest{}
+			req.GroupId, _ = p.Args["groupId"].(string)
+			req.ClosedBy, _ = p.Args["closedBy"].(string)
+			until, ok, err := dateTimeArg(p.Args, "until")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.Until = timestamppb.New(until)
+			}
+
+			resp, err := groupClient.ClosePeriod(context.Background(), req)
+			if err != nil {
+				log.Printf("Error closing period: %v", err)
+				return nil, err
+			}
+
+			return resp.Period, nil
+		},
+	}
+}
+
+func reopenPeriodField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(closedPeriodType),
+		Args: graphql.FieldConfigArgument{
+			"periodId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"reopenedBy": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.ReopenPeriodRequest{}
+			req.PeriodId, _ = p.Args["periodId"].(string)
+			req.ReopenedBy, _ = p.Args["reopenedBy"].(string)
+
+			resp, err := groupClient.ReopenPeriod(context.Background(), req)
+			if err != nil {
+				log.Printf("Error reopening period: %v", err)
+				return nil, err
+			}
+
+			return resp.Period, nil
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestClosePeriod_Until(t *testing.T) {
+	client := &fakeGroupClient{}
+
+	executeQuery(t, client, `mutation {
+		closePeriod(groupId: "g1", until: "2026-03-31T23:59:59+09:00") { id }
+	}`)
+
+	if len(client.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(client.requests))
+	}
+	req := client.requests[0].(*groupv1.ClosePeriodRequest)
+	if req.Until == nil {
+		t.Fatal("until was not sent")
+	}
+	if want := time.Date(2026, 3, 31, 14, 59, 59, 0, time.UTC); !req.Until.AsTime().Equal(want) {
+		t.Errorf("Until = %v, want %v", req.Until.AsTime(), want)
+	}
+}
@@ -0,0 +1,186 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var commentType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Comment",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"expenseId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"body": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"createdAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"updatedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+	},
+})
+
+var addExpenseCommentInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "AddExpenseCommentInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"expenseId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"body": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+var updateExpenseCommentInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "UpdateExpenseCommentInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"commentId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"body": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+func expenseCommentsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewNonNull(commentType)),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expense, ok := p.Source.(*groupv1.ExpenseWithDetails)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListExpenseCommentsRequest{ExpenseId: expense.Id}
+			resp, err := groupClient.ListExpenseComments(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing comments: %v", err)
+				return nil, err
+			}
+
+			return resp.Comments, nil
+		},
+	}
+}
+
+func addExpenseCommentField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(commentType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(addExpenseCommentInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.AddExpenseCommentRequest{
+				ExpenseId: input["expenseId"].(string),
+				MemberId:  input["memberId"].(string),
+				Body:      input["body"].(string),
+			}
+
+			resp, err := groupClient.AddExpenseComment(context.Background(), req)
+			if err != nil {
+				log.Printf("Error adding comment: %v", err)
+				return nil, err
+			}
+
+			return resp.Comment, nil
+		},
+	}
+}
+
+func updateExpenseCommentField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(commentType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(updateExpenseCommentInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.UpdateExpenseCommentRequest{
+				CommentId: input["commentId"].(string),
+				MemberId:  input["memberId"].(string),
+				Body:      input["body"].(string),
+			}
+
+			resp, err := groupClient.UpdateExpenseComment(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating comment: %v", err)
+				return nil, err
+			}
+
+			return resp.Comment, nil
+		},
+	}
+}
+
+func deleteExpenseCommentField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Boolean),
+		Args: graphql.FieldConfigArgument{
+			"commentId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"memberId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			commentId, ok := p.Args["commentId"].(string)
+			if !ok {
+				return false, nil
+			}
+			memberId, ok := p.Args["memberId"].(string)
+			if !ok {
+				return false, nil
+			}
+
+			req := &groupv1.DeleteExpenseCommentRequest{
+				CommentId: commentId,
+				MemberId:  memberId,
+			}
+
+			resp, err := groupClient.DeleteExpenseComment(context.Background(), req)
+			if err != nil {
+				log.Printf("Error deleting comment: %v", err)
+				return false, err
+			}
+
+			return resp.Success, nil
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// approvalStatusEnum tells whether an expense counts towards balances yet.
+// Only approved expenses do.
+var approvalStatusEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ApprovalStatus",
+	Values: graphql.EnumValueConfigMap{
+		"APPROVED": &graphql.EnumValueConfig{
+			Value: groupv1.ApprovalStatus_APPROVAL_STATUS_APPROVED,
+		},
+		"PENDING": &graphql.EnumValueConfig{
+			Value: groupv1.ApprovalStatus_APPROVAL_STATUS_PENDING,
+		},
+		"DISPUTED": &graphql.EnumValueConfig{
+			Value: groupv1.ApprovalStatus_APPROVAL_STATUS_DISPUTED,
+		},
+	},
+})
+
+var approvalVoteEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ApprovalVote",
+	Values: graphql.EnumValueConfigMap{
+		"APPROVE": &graphql.EnumValueConfig{
+			Value: groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE,
+		},
+		"DISPUTE": &graphql.EnumValueConfig{
+			Value: groupv1.ApprovalVote_APPROVAL_VOTE_DISPUTE,
+		},
+	},
+})
+
+var groupSettingsType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "GroupSettings",
+	Fields: graphql.Fields{
+		"requireExpenseApproval": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+		},
+		"adminMemberId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				settings, ok := p.Source.(*groupv1.GroupSettings)
+				if !ok || settings.AdminMemberId == "" {
+					return nil, nil
+				}
+				return settings.AdminMemberId, nil
+			},
+		},
+	},
+})
+
+var groupSettingsInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "GroupSettingsInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"requireExpenseApproval": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.Boolean),
+		},
+		"adminMemberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+	},
+})
+
+func groupSettingsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(groupSettingsType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetGroupSettingsRequest{GroupId: groupId}
+			resp, err := groupClient.GetGroupSettings(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting group settings: %v", err)
+				return nil, err
+			}
+
+			return resp.Settings, nil
+		},
+	}
+}
+
+func updateGroupSettingsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(groupSettingsType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"settings": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(groupSettingsInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			settings := &groupv1.GroupSettings{}
+			if input, ok := p.Args["settings"].(map[string]interface{}); ok {
+				settings.RequireExpenseApproval, _ = input["requireExpenseApproval"].(bool)
+				settings.AdminMemberId, _ = input["adminMemberId"].(string)
+			}
+
+			req := &groupv1.UpdateGroupSettingsRequest{GroupId: groupId, Settings: settings}
+			resp, err := groupClient.UpdateGroupSettings(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating group settings: %v", err)
+				return nil, err
+			}
+
+			return resp.Settings, nil
+		},
+	}
+}
+
+func voteOnExpenseField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseWithDetailsType),
+		Args: graphql.FieldConfigArgument{
+			"expenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"memberId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"vote": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(approvalVoteEnum),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.VoteOnExpenseRequest{}
+			req.ExpenseId, _ = p.Args["expenseId"].(string)
+			req.MemberId, _ = p.Args["memberId"].(string)
+			req.Vote, _ = p.Args["vote"].(groupv1.ApprovalVote)
+
+			resp, err := groupClient.VoteOnExpense(context.Background(), req)
+			if err != nil {
+				log.Printf("Error voting on expense: %v", err)
+				return nil, err
+			}
+
+			return resp.Expense, nil
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var expenseEntryInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "ExpenseEntryInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"amount": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"description": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"paidById": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"splitMemberIds": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"kind": &graphql.InputObjectFieldConfig{
+			Type: expenseKindEnum,
+		},
+		"splitAmounts": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+		},
+		"splitAmongPresent": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+		"splitByHousehold": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+	},
+})
+
+var expenseErrorType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseError",
+	Fields: graphql.Fields{
+		"index": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"field": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				expenseError, ok := p.Source.(*groupv1.ExpenseError)
+				if !ok || expenseError.Field == "" {
+					return nil, nil
+				}
+				return expenseError.Field, nil
+			},
+		},
+		"message": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+var addExpensesResultType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "AddExpensesResult",
+	Fields: graphql.Fields{
+		"expenses": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseWithDetailsType))),
+		},
+		"errors": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseErrorType))),
+		},
+	},
+})
+
+func addExpensesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(addExpensesResultType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"expenses": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseEntryInput))),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+			entries, ok := p.Args["expenses"].([]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.AddExpensesRequest{
+				GroupId:  groupId,
+				Expenses: make([]*groupv1.AddExpenseRequest, 0, len(entries)),
+			}
+			for _, entry := range entries {
+				input, ok := entry.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				req.Expenses = append(req.Expenses, expenseEntryFromInput(input))
+			}
+
+			resp, err := groupClient.AddExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error adding expenses: %v", err)
+				return nil, err
+			}
+
+			return resp, nil
+		},
+	}
+}
+
+func expenseEntryFromInput(input map[string]interface{}) *groupv1.AddExpenseRequest {
+	entry := &groupv1.AddExpenseRequest{}
+	if amount, ok := input["amount"].(int); ok {
+		entry.Amount = int64(amount)
+	}
+	if description, ok := input["description"].(string); ok {
+		entry.Description = description
+	}
+	if paidById, ok := input["paidById"].(string); ok {
+		entry.PaidById = paidById
+	}
+	if splitMemberIds, ok := input["splitMemberIds"].([]interface{}); ok {
+		for _, id := range splitMemberIds {
+			if idStr, ok := id.(string); ok {
+				entry.SplitMemberIds = append(entry.SplitMemberIds, idStr)
+			}
+		}
+	}
+	if category, ok := input["category"].(string); ok {
+		entry.Category = category
+	}
+	entry.Kind = expenseKindFromInput(input)
+	entry.SplitAmounts = splitAmountsFromInput(input)
+	entry.SplitAmongPresent, _ = input["splitAmongPresent"].(bool)
+	entry.SplitByHousehold, _ = input["splitByHousehold"].(bool)
+	return entry
+}
+
+// splitAmountsFromInput reads the optional exact share of each split member
+func splitAmountsFromInput(input map[string]interface{}) []int64 {
+	var splitAmounts []int64
+	if amounts, ok := input["splitAmounts"].([]interface{}); ok {
+		for _, amount := range amounts {
+			if amountInt, ok := amount.(int); ok {
+				splitAmounts = append(splitAmounts, int64(amountInt))
+			}
+		}
+	}
+	return splitAmounts
+}
@@ -0,0 +1,234 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultExpensePageSize is used when a connection query omits "first"
+const defaultExpensePageSize = 20
+
+var expenseSortFieldEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ExpenseSortField",
+	Values: graphql.EnumValueConfigMap{
+		"CREATED_AT": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_CREATED_AT,
+		},
+		"AMOUNT": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_AMOUNT,
+		},
+		"DESCRIPTION": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_DESCRIPTION,
+		},
+	},
+})
+
+var sortDirectionEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "SortDirection",
+	Values: graphql.EnumValueConfigMap{
+		"ASC": &graphql.EnumValueConfig{
+			Value: groupv1.SortDirection_SORT_DIRECTION_ASC,
+		},
+		"DESC": &graphql.EnumValueConfig{
+			Value: groupv1.SortDirection_SORT_DIRECTION_DESC,
+		},
+	},
+})
+
+var expenseFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "ExpenseFilterInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"createdFrom": &graphql.InputObjectFieldConfig{
+			Type: dateTimeType,
+		},
+		"createdTo": &graphql.InputObjectFieldConfig{
+			Type: dateTimeType,
+		},
+		"payerId": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+		"participantId": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+		"minAmount": &graphql.InputObjectFieldConfig{
+			Type: graphql.Int,
+		},
+		"maxAmount": &graphql.InputObjectFieldConfig{
+			Type: graphql.Int,
+		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"text": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+	},
+})
+
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+		},
+		"hasPreviousPage": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Boolean),
+		},
+		"startCursor": &graphql.Field{
+			Type: graphql.String,
+		},
+		"endCursor": &graphql.Field{
+			Type: graphql.String,
+		},
+	},
+})
+
+var expenseEdgeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseEdge",
+	Fields: graphql.Fields{
+		"cursor": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"node": &graphql.Field{
+			Type: graphql.NewNonNull(expenseWithDetailsType),
+		},
+	},
+})
+
+var expenseConnectionType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseConnection",
+	Fields: graphql.Fields{
+		"edges": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseEdgeType))),
+		},
+		"pageInfo": &graphql.Field{
+			Type: graphql.NewNonNull(pageInfoType),
+		},
+	},
+})
+
+func expensesConnectionField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseConnectionType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"filter": &graphql.ArgumentConfig{
+				Type: expenseFilterInput,
+			},
+			"sortBy": &graphql.ArgumentConfig{
+				Type: expenseSortFieldEnum,
+			},
+			"sortDirection": &graphql.ArgumentConfig{
+				Type: sortDirectionEnum,
+			},
+			"first": &graphql.ArgumentConfig{
+				Type: graphql.Int,
+			},
+			"after": &graphql.ArgumentConfig{
+				Type: graphql.String,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetGroupExpensesRequest{
+				GroupId:  groupId,
+				PageSize: defaultExpensePageSize,
+			}
+
+			if filter, ok := p.Args["filter"].(map[string]interface{}); ok {
+				expenseFilter, err := expenseFilterFromInput(filter)
+				if err != nil {
+					return nil, err
+				}
+				req.Filter = expenseFilter
+			}
+			if sortBy, ok := p.Args["sortBy"].(groupv1.ExpenseSortField); ok {
+				req.SortBy = sortBy
+			}
+			if sortDirection, ok := p.Args["sortDirection"].(groupv1.SortDirection); ok {
+				req.SortDirection = sortDirection
+			}
+			if first, ok := p.Args["first"].(int); ok {
+				req.PageSize = int32(first)
+			}
+			if after, ok := p.Args["after"].(string); ok {
+				req.PageToken = after
+			}
+
+			resp, err := groupClient.GetGroupExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting group expenses: %v", err)
+				return nil, err
+			}
+
+			edges := make([]map[string]interface{}, len(resp.Expenses))
+			for i, expense := range resp.Expenses {
+				edges[i] = map[string]interface{}{
+					"cursor": resp.Cursors[i],
+					"node":   expense,
+				}
+			}
+
+			pageInfo := map[string]interface{}{
+				"hasNextPage":     resp.NextPageToken != "",
+				"hasPreviousPage": req.PageToken != "",
+			}
+			if len(resp.Cursors) > 0 {
+				pageInfo["startCursor"] = resp.Cursors[0]
+				pageInfo["endCursor"] = resp.Cursors[len(resp.Cursors)-1]
+			}
+
+			return map[string]interface{}{
+				"edges":    edges,
+				"pageInfo": pageInfo,
+			}, nil
+		},
+	}
+}
+
+func expenseFilterFromInput(input map[string]interface{}) (*groupv1.ExpenseFilter, error) {
+	filter := &groupv1.ExpenseFilter{}
+	createdFrom, ok, err := dateTimeArg(input, "createdFrom")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		filter.CreatedFrom = timestamppb.New(createdFrom)
+	}
+	createdTo, ok, err := dateTimeArg(input, "createdTo")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		filter.CreatedTo = timestamppb.New(createdTo)
+	}
+	if payerId, ok := input["payerId"].(string); ok {
+		filter.PayerId = payerId
+	}
+	if participantId, ok := input["participantId"].(string); ok {
+		filter.ParticipantId = participantId
+	}
+	if minAmount, ok := input["minAmount"].(int); ok {
+		filter.MinAmount = int64(minAmount)
+	}
+	if maxAmount, ok := input["maxAmount"].(int); ok {
+		filter.MaxAmount = int64(maxAmount)
+	}
+	if category, ok := input["category"].(string); ok {
+		filter.Category = category
+	}
+	if text, ok := input["text"].(string); ok {
+		filter.Text = text
+	}
+	return filter, nil
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestExpensesConnection_DateFilter(t *testing.T) {
+	client := &fakeGroupClient{}
+
+	executeQuery(t, client, `{
+		expenses(groupId: "g1", filter: {createdFrom: "2026-04-01T00:00:00Z", createdTo: "2026-04-30T23:59:59Z"}) {
+			pageInfo { hasNextPage }
+		}
+	}`)
+
+	if len(client.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(client.requests))
+	}
+	filter := client.requests[0].(*groupv1.GetGroupExpensesRequest).Filter
+	if filter == nil {
+		t.Fatal("filter was not sent")
+	}
+	if want := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC); !filter.CreatedFrom.AsTime().Equal(want) {
+		t.Errorf("CreatedFrom = %v, want %v", filter.CreatedFrom.AsTime(), want)
+	}
+	if want := time.Date(2026, 4, 30, 23, 59, 59, 0, time.UTC); !filter.CreatedTo.AsTime().Equal(want) {
+		t.Errorf("CreatedTo = %v, want %v", filter.CreatedTo.AsTime(), want)
+	}
+}
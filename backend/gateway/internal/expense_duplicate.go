@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var duplicateExpensePairType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "DuplicateExpensePair",
+	Fields: graphql.Fields{
+		"expense": &graphql.Field{
+			Type: graphql.NewNonNull(expenseWithDetailsType),
+		},
+		"duplicate": &graphql.Field{
+			Type: graphql.NewNonNull(expenseWithDetailsType),
+		},
+	},
+})
+
+func duplicateExpensesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(duplicateExpensePairType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListDuplicateExpensesRequest{GroupId: groupId}
+			resp, err := groupClient.ListDuplicateExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing duplicate expenses: %v", err)
+				return nil, err
+			}
+
+			return resp.Pairs, nil
+		},
+	}
+}
+
+func mergeExpensesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseWithDetailsType),
+		Args: graphql.FieldConfigArgument{
+			"keepExpenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"duplicateExpenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"mergedBy": &graphql.ArgumentConfig{
+				Type: graphql.ID,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.MergeExpensesRequest{}
+			req.KeepExpenseId, _ = p.Args["keepExpenseId"].(string)
+			req.DuplicateExpenseId, _ = p.Args["duplicateExpenseId"].(string)
+			req.MergedBy, _ = p.Args["mergedBy"].(string)
+
+			resp, err := groupClient.MergeExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error merging expenses: %v", err)
+				return nil, err
+			}
+
+			return resp.Expense, nil
+		},
+	}
+}
+
+// probableDuplicateError reports an expense held back because it looks like
+// expenses already recorded
+func probableDuplicateError(duplicates []*groupv1.ExpenseWithDetails) error {
+	descriptions := make([]string, len(duplicates))
+	for i, duplicate := range duplicates {
+		descriptions[i] = fmt.Sprintf("%s（%s）", duplicate.Description, duplicate.Id)
+	}
+	return fmt.Errorf("同じ支払いが既に登録されている可能性があります: %s。登録する場合は allowDuplicate を指定してください",
+		strings.Join(descriptions, "、"))
+}
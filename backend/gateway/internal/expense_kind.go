@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// expenseKindEnum distinguishes money paid for the group from income such as
+// refunds, where paidById is the member who received the money, and from
+// settle-up payments from paidById to the single split member
+var expenseKindEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "ExpenseKind",
+	Values: graphql.EnumValueConfigMap{
+		"EXPENSE": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseKind_EXPENSE_KIND_EXPENSE,
+		},
+		"INCOME": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseKind_EXPENSE_KIND_INCOME,
+		},
+		"PAYMENT": &graphql.EnumValueConfig{
+			Value: groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT,
+		},
+	},
+})
+
+// expenseKindFromInput reads the optional "kind" of an input object
+func expenseKindFromInput(input map[string]interface{}) groupv1.ExpenseKind {
+	if kind, ok := input["kind"].(groupv1.ExpenseKind); ok {
+		return kind
+	}
+	return groupv1.ExpenseKind_EXPENSE_KIND_EXPENSE
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var fieldChangeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "FieldChange",
+	Fields: graphql.Fields{
+		"field": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"oldValue": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"newValue": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"memberId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				change, ok := p.Source.(*groupv1.FieldChange)
+				if !ok || change.MemberId == "" {
+					return nil, nil
+				}
+				return change.MemberId, nil
+			},
+		},
+		"memberName": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				change, ok := p.Source.(*groupv1.FieldChange)
+				if !ok || change.MemberId == "" {
+					return nil, nil
+				}
+				return change.MemberName, nil
+			},
+		},
+	},
+})
+
+var expenseRevisionType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseRevision",
+	Fields: graphql.Fields{
+		"revision": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"amount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"description": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"category": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"kind": &graphql.Field{
+			Type: graphql.NewNonNull(expenseKindEnum),
+		},
+		"paidById": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"paidByName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"splitMembers": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(splitMemberType))),
+		},
+		"changedById": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				revision, ok := p.Source.(*groupv1.ExpenseRevision)
+				if !ok || revision.ChangedById == "" {
+					return nil, nil
+				}
+				return revision.ChangedById, nil
+			},
+		},
+		"changedByName": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				revision, ok := p.Source.(*groupv1.ExpenseRevision)
+				if !ok || revision.ChangedByName == "" {
+					return nil, nil
+				}
+				return revision.ChangedByName, nil
+			},
+		},
+		"revertedFrom": &graphql.Field{
+			Type: graphql.Int,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				revision, ok := p.Source.(*groupv1.ExpenseRevision)
+				if !ok || revision.RevertedFrom == 0 {
+					return nil, nil
+				}
+				return revision.RevertedFrom, nil
+			},
+		},
+		"createdAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"changes": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(fieldChangeType))),
+		},
+	},
+})
+
+func expenseHistoryField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseRevisionType))),
+		Args: graphql.FieldConfigArgument{
+			"expenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expenseId, ok := p.Args["expenseId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetExpenseHistoryRequest{ExpenseId: expenseId}
+			resp, err := groupClient.GetExpenseHistory(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting expense history: %v", err)
+				return nil, err
+			}
+
+			return resp.Revisions, nil
+		},
+	}
+}
+
+func revertExpenseField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseWithDetailsType),
+		Args: graphql.FieldConfigArgument{
+			"expenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"revision": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.Int),
+			},
+			"revertedBy": &graphql.ArgumentConfig{
+				Type: graphql.ID,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expenseId, ok := p.Args["expenseId"].(string)
+			if !ok {
+				return nil, nil
+			}
+			revision, ok := p.Args["revision"].(int)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.RevertExpenseRequest{
+				ExpenseId: expenseId,
+				Revision:  int32(revision),
+			}
+			if revertedBy, ok := p.Args["revertedBy"].(string); ok {
+				req.RevertedBy = revertedBy
+			}
+
+			resp, err := groupClient.RevertExpense(context.Background(), req)
+			if err != nil {
+				log.Printf("Error reverting expense: %v", err)
+				return nil, err
+			}
+
+			return resp.Expense, nil
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// splitModeEnum tells whether an expense is split equally or in proportion
+// to each member's shares
+var splitModeEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "SplitMode",
+	Values: graphql.EnumValueConfigMap{
+		"EQUAL": &graphql.EnumValueConfig{
+			Value: groupv1.SplitMode_SPLIT_MODE_EQUAL,
+		},
+		"SHARES": &graphql.EnumValueConfig{
+			Value: groupv1.SplitMode_SPLIT_MODE_SHARES,
+		},
+	},
+})
+
+var templateMemberType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "TemplateMember",
+	Fields: graphql.Fields{
+		"memberId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"shares": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
+var expenseTemplateType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseTemplate",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"groupId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"name": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"description": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"amount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"category": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"kind": &graphql.Field{
+			Type: graphql.NewNonNull(expenseKindEnum),
+		},
+		"paidById": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"paidByName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"splitMode": &graphql.Field{
+			Type: graphql.NewNonNull(splitModeEnum),
+		},
+		"members": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(templateMemberType))),
+		},
+		"createdAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"updatedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+	},
+})
+
+// expenseTemplateFields are the fields shared by the create and update inputs
+func expenseTemplateFields(idField string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		idField: &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"name": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"description": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"amount": &graphql.InputObjectFieldConfig{
+			Type: graphql.Int,
+		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"kind": &graphql.InputObjectFieldConfig{
+			Type: expenseKindEnum,
+		},
+		"paidById": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"splitMemberIds": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+		},
+		"splitMode": &graphql.InputObjectFieldConfig{
+			Type: splitModeEnum,
+		},
+		"splitShares": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+		},
+	}
+}
+
+var createExpenseTemplateInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:   "CreateExpenseTemplateInput",
+	Fields: expenseTemplateFields("groupId"),
+})
+
+var updateExpenseTemplateInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:   "UpdateExpenseTemplateInput",
+	Fields: expenseTemplateFields("templateId"),
+})
+
+var addExpenseFromTemplateInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "AddExpenseFromTemplateInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"templateId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"amount": &graphql.InputObjectFieldConfig{
+			Type: graphql.Int,
+		},
+		"description": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"paidById": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+		"splitMemberIds": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(graphql.ID)),
+		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"allowDuplicate": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+	},
+})
+
+func expenseTemplatesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseTemplateType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListExpenseTemplatesRequest{GroupId: groupId}
+			resp, err := groupClient.ListExpenseTemplates(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing expense templates: %v", err)
+				return nil, err
+			}
+
+			return resp.Templates, nil
+		},
+	}
+}
+
+func createExpenseTemplateField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseTemplateType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(createExpenseTemplateInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := expenseTemplateFromInput(input)
+			req.GroupId, _ = input["groupId"].(string)
+
+			resp, err := groupClient.CreateExpenseTemplate(context.Background(), req)
+			if err != nil {
+				log.Printf("Error creating expense template: %v", err)
+				return nil, err
+			}
+
+			return resp.Template, nil
+		},
+	}
+}
+
+func updateExpenseTemplateField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseTemplateType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(updateExpenseTemplateInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			fields := expenseTemplateFromInput(input)
+			req := &groupv1.UpdateExpenseTemplateRequest{
+				Name:           fields.Name,
+				Description:    fields.Description,
+				Amount:         fields.Amount,
+				Category:       fields.Category,
+				Kind:           fields.Kind,
+				PaidById:       fields.PaidById,
+				SplitMemberIds: fields.SplitMemberIds,
+				SplitMode:      fields.SplitMode,
+				SplitShares:    fields.SplitShares,
+			}
+			req.TemplateId, _ = input["templateId"].(string)
+
+			resp, err := groupClient.UpdateExpenseTemplate(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating expense template: %v", err)
+				return nil, err
+			}
+
+			return resp.Template, nil
+		},
+	}
+}
+
+func deleteExpenseTemplateField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Boolean),
+		Args: graphql.FieldConfigArgument{
+			"templateId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			templateId, _ := p.Args["templateId"].(string)
+
+			req := &groupv1.DeleteExpenseTemplateRequest{TemplateId: templateId}
+			resp, err := groupClient.DeleteExpenseTemplate(context.Background(), req)
+			if err != nil {
+				log.Printf("Error deleting expense template: %v", err)
+				return false, err
+			}
+
+			return resp.Success, nil
+		},
+	}
+}
+
+func addExpenseFromTemplateField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: expenseWithDetailsType,
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(addExpenseFromTemplateInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.AddExpenseFromTemplateRequest{}
+			req.TemplateId, _ = input["templateId"].(string)
+			if amount, ok := input["amount"].(int); ok {
+				req.Amount = int64(amount)
+			}
+			req.Description, _ = input["description"].(string)
+			req.PaidById, _ = input["paidById"].(string)
+			req.SplitMemberIds = stringsFromInput(input["splitMemberIds"])
+			req.Category, _ = input["category"].(string)
+			req.AllowDuplicate, _ = input["allowDuplicate"].(bool)
+
+			resp, err := groupClient.AddExpenseFromTemplate(context.Background(), req)
+			if err != nil {
+				log.Printf("Error adding expense from template: %v", err)
+				return nil, err
+			}
+
+			if resp.Expense == nil && len(resp.ProbableDuplicates) > 0 {
+				return nil, probableDuplicateError(resp.ProbableDuplicates)
+			}
+
+			return resp.Expense, nil
+		},
+	}
+}
+
+func expenseTemplateFromInput(input map[string]interface{}) *groupv1.CreateExpenseTemplateRequest {
+	req := &groupv1.CreateExpenseTemplateRequest{}
+	req.Name, _ = input["name"].(string)
+	req.Description, _ = input["description"].(string)
+	if amount, ok := input["amount"].(int); ok {
+		req.Amount = int64(amount)
+	}
+	req.Category, _ = input["category"].(string)
+	req.Kind = expenseKindFromInput(input)
+	req.PaidById, _ = input["paidById"].(string)
+	req.SplitMemberIds = stringsFromInput(input["splitMemberIds"])
+	req.SplitMode, _ = input["splitMode"].(groupv1.SplitMode)
+	if shares, ok := input["splitShares"].([]interface{}); ok {
+		for _, share := range shares {
+			if shareInt, ok := share.(int); ok {
+				req.SplitShares = append(req.SplitShares, int32(shareInt))
+			}
+		}
+	}
+	return req
+}
+
+func stringsFromInput(value interface{}) []string {
+	var values []string
+	if list, ok := value.([]interface{}); ok {
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
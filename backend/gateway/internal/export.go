@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"io"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var exportFormats = map[string]groupv1.ExportFormat{
+	"":     groupv1.ExportFormat_EXPORT_FORMAT_CSV,
+	"csv":  groupv1.ExportFormat_EXPORT_FORMAT_CSV,
+	"json": groupv1.ExportFormat_EXPORT_FORMAT_JSON,
+}
+
+var exportCsvLayouts = map[string]groupv1.ExportCsvLayout{
+	"":         groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_EXPENSES,
+	"expenses": groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_EXPENSES,
+	"splits":   groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_SPLITS,
+}
+
+// NewGroupExportHandler serves a group's ledger at /groups/{id}/export.
+// The format query parameter is csv (default) or json; for CSV, layout is
+// expenses (one row per expense, default) or splits (one row per share).
+func NewGroupExportHandler(groupClient groupv1.GroupServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, ok := exportFormats[r.URL.Query().Get("format")]
+		if !ok {
+			http.Error(w, "Unsupported format", http.StatusBadRequest)
+			return
+		}
+		layout, ok := exportCsvLayouts[r.URL.Query().Get("layout")]
+		if !ok {
+			http.Error(w, "Unsupported layout", http.StatusBadRequest)
+			return
+		}
+
+		req := &groupv1.ExportGroupRequest{
+			GroupId:   mux.Vars(r)["id"],
+			Format:    format,
+			CsvLayout: layout,
+		}
+		stream, err := groupClient.ExportGroup(r.Context(), req)
+		if err != nil {
+			log.Printf("Error exporting group: %v", err)
+			http.Error(w, "Failed to export group", http.StatusBadGateway)
+			return
+		}
+
+		// The first message carries the metadata
+		first, err := stream.Recv()
+		if err != nil {
+			log.Printf("Error exporting group: %v", err)
+			switch status.Code(err) {
+			case codes.InvalidArgument:
+				http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			case codes.NotFound:
+				http.Error(w, "Group not found", http.StatusNotFound)
+			default:
+				http.Error(w, "Failed to export group", http.StatusBadGateway)
+			}
+			return
+		}
+		if first.GetMetadata() == nil {
+			log.Printf("Error exporting group: no metadata")
+			http.Error(w, "Failed to export group", http.StatusBadGateway)
+			return
+		}
+		metadata := first.GetMetadata()
+
+		w.Header().Set("Content-Type", metadata.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": metadata.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// Headers are already written, so the client sees a short body
+				log.Printf("Error streaming group export: %v", err)
+				return
+			}
+			if _, err := w.Write(resp.GetChunk()); err != nil {
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingExportClient answers every export with a stream that fails with err
+type failingExportClient struct {
+	groupv1.GroupServiceClient
+	err error
+}
+
+func (f *failingExportClient) ExportGroup(ctx context.Context, in *groupv1.ExportGroupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[groupv1.ExportGroupResponse], error) {
+	return &failingExportStream{err: f.err}, nil
+}
+
+type failingExportStream struct {
+	grpc.ClientStream
+	err error
+}
+
+func (s *failingExportStream) Recv() (*groupv1.ExportGroupResponse, error) {
+	return nil, s.err
+}
+
+func TestGroupExportHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"invalid request", status.Error(codes.InvalidArgument, "groupId: グループIDが無効です"), http.StatusBadRequest},
+		{"group not found", status.Error(codes.NotFound, "group not found"), http.StatusNotFound},
+		{"service unavailable", status.Error(codes.Unavailable, "connection refused"), http.StatusBadGateway},
+		{"service failure", status.Error(codes.Internal, "boom"), http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mux.NewRouter()
+			router.Handle("/groups/{id}/export", NewGroupExportHandler(&failingExportClient{err: tt.err}))
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/groups/group-1/export", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
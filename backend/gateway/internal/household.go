@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var householdType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Household",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"name": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"memberIds": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+		},
+	},
+})
+
+var householdBalanceType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "HouseholdBalance",
+	Fields: graphql.Fields{
+		"householdId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				balance, ok := p.Source.(*groupv1.HouseholdBalance)
+				if !ok || balance.HouseholdId == "" {
+					return nil, nil
+				}
+				return balance.HouseholdId, nil
+			},
+		},
+		"name": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"memberIds": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+		},
+		"balance": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
+var householdInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "HouseholdInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+		"name": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"memberIds": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+		},
+	},
+})
+
+func householdsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(householdType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetHouseholdsRequest{GroupId: groupId}
+			resp, err := groupClient.GetHouseholds(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting households: %v", err)
+				return nil, err
+			}
+
+			return resp.Households, nil
+		},
+	}
+}
+
+func updateHouseholdsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(householdType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"households": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(householdInput))),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.UpdateHouseholdsRequest{GroupId: groupId}
+			if households, ok := p.Args["households"].([]interface{}); ok {
+				for _, h := range households {
+					input, ok := h.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					household := &groupv1.Household{}
+					household.Id, _ = input["id"].(string)
+					household.Name, _ = input["name"].(string)
+					if memberIds, ok := input["memberIds"].([]interface{}); ok {
+						for _, memberId := range memberIds {
+							if id, ok := memberId.(string); ok {
+								household.MemberIds = append(household.MemberIds, id)
+							}
+						}
+					}
+					req.Households = append(req.Households, household)
+				}
+			}
+
+			resp, err := groupClient.UpdateHouseholds(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating households: %v", err)
+				return nil, err
+			}
+
+			return resp.Households, nil
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var accountMappingType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "AccountMapping",
+	Fields: graphql.Fields{
+		"category": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"account": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+var accountMappingInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "AccountMappingInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"account": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+	},
+})
+
+func accountMappingsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountMappingType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.GetAccountMappingsRequest{GroupId: groupId}
+			resp, err := groupClient.GetAccountMappings(context.Background(), req)
+			if err != nil {
+				log.Printf("Error getting account mappings: %v", err)
+				return nil, err
+			}
+
+			return resp.Mappings, nil
+		},
+	}
+}
+
+func updateAccountMappingsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountMappingType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"mappings": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(accountMappingInput))),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.UpdateAccountMappingsRequest{GroupId: groupId}
+			if mappings, ok := p.Args["mappings"].([]interface{}); ok {
+				for _, m := range mappings {
+					mapping, ok := m.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					category, _ := mapping["category"].(string)
+					account, _ := mapping["account"].(string)
+					req.Mappings = append(req.Mappings, &groupv1.AccountMapping{Category: category, Account: account})
+				}
+			}
+
+			resp, err := groupClient.UpdateAccountMappings(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating account mappings: %v", err)
+				return nil, err
+			}
+
+			return resp.Mappings, nil
+		},
+	}
+}
+
+var journalFormats = map[string]groupv1.JournalFormat{
+	"freee":        groupv1.JournalFormat_JOURNAL_FORMAT_FREEE,
+	"moneyforward": groupv1.JournalFormat_JOURNAL_FORMAT_MONEYFORWARD,
+}
+
+// NewJournalExportHandler serves a group's accounting journal at
+// /groups/{id}/journal. The format query parameter, freee or moneyforward, is
+// required; settlements=1 also books the transfers that settle the group.
+func NewJournalExportHandler(groupClient groupv1.GroupServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, ok := journalFormats[r.URL.Query().Get("format")]
+		if !ok {
+			http.Error(w, "Unsupported format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := groupClient.ExportJournal(r.Context(), &groupv1.ExportJournalRequest{
+			GroupId:            mux.Vars(r)["id"],
+			Format:             format,
+			IncludeSettlements: r.URL.Query().Get("settlements") == "1",
+		})
+		if err != nil {
+			log.Printf("Error exporting journal: %v", err)
+			http.Error(w, "Failed to export journal", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", resp.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write(resp.Content)
+	})
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func setMemberActivePeriodField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(memberType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"memberId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"activeFrom": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+			"activeUntil": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.SetMemberActivePeriodRequest{}
+			req.GroupId, _ = p.Args["groupId"].(string)
+			req.MemberId, _ = p.Args["memberId"].(string)
+			activeFrom, ok, err := dateTimeArg(p.Args, "activeFrom")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.ActiveFrom = timestamppb.New(activeFrom)
+			}
+			activeUntil, ok, err := dateTimeArg(p.Args, "activeUntil")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.ActiveUntil = timestamppb.New(activeUntil)
+			}
+
+			resp, err := groupClient.SetMemberActivePeriod(context.Background(), req)
+			if err != nil {
+				log.Printf("Error setting member active period: %v", err)
+				return nil, err
+			}
+
+			return resp.Member, nil
+		},
+	}
+}
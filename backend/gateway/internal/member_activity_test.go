@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestSetMemberActivePeriod_Dates(t *testing.T) {
+	client := &fakeGroupClient{}
+
+	executeQuery(t, client, `mutation {
+		setMemberActivePeriod(groupId: "g1", memberId: "m1", activeFrom: "2026-08-11T00:00:00Z", activeUntil: "2026-08-13T00:00:00Z") { __typename }
+	}`)
+
+	if len(client.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(client.requests))
+	}
+	req := client.requests[0].(*groupv1.SetMemberActivePeriodRequest)
+	if req.ActiveFrom == nil || req.ActiveUntil == nil {
+		t.Fatalf("active period was not sent: %v", req)
+	}
+	if want := time.Date(2026, 8, 11, 0, 0, 0, 0, time.UTC); !req.ActiveFrom.AsTime().Equal(want) {
+		t.Errorf("ActiveFrom = %v, want %v", req.ActiveFrom.AsTime(), want)
+	}
+	if want := time.Date(2026, 8, 13, 0, 0, 0, 0, time.UTC); !req.ActiveUntil.AsTime().Equal(want) {
+		t.Errorf("ActiveUntil = %v, want %v", req.ActiveUntil.AsTime(), want)
+	}
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var memberMergeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "MemberMerge",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"groupId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"keptMemberId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"keptMemberName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"mergedMemberId": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"mergedMemberName": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"expenseCount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"mergedBy": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				merge, ok := p.Source.(*groupv1.MemberMerge)
+				if !ok || merge.MergedBy == "" {
+					return nil, nil
+				}
+				return merge.MergedBy, nil
+			},
+		},
+		"mergedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+	},
+})
+
+func memberMergesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberMergeType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListMemberMergesRequest{GroupId: groupId}
+			resp, err := groupClient.ListMemberMerges(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing member merges: %v", err)
+				return nil, err
+			}
+
+			return resp.Merges, nil
+		},
+	}
+}
+
+func mergeMembersField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(memberMergeType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"keepId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"mergeId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"mergedBy": &graphql.ArgumentConfig{
+				Type: graphql.ID,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.MergeMembersRequest{}
+			req.GroupId, _ = p.Args["groupId"].(string)
+			req.KeepId, _ = p.Args["keepId"].(string)
+			req.MergeId, _ = p.Args["mergeId"].(string)
+			req.MergedBy, _ = p.Args["mergedBy"].(string)
+
+			resp, err := groupClient.MergeMembers(context.Background(), req)
+			if err != nil {
+				log.Printf("Error merging members: %v", err)
+				return nil, err
+			}
+
+			return resp.Merge, nil
+		},
+	}
+}
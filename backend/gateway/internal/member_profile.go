@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var updateMemberInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "UpdateMemberInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"groupId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"memberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"name": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"email": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"color": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"paymentHandle": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+	},
+})
+
+func updateMemberField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(memberType),
+		Args: graphql.FieldConfigArgument{
+			"input": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(updateMemberInput),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			input, ok := p.Args["input"].(map[string]interface{})
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.UpdateMemberRequest{}
+			req.GroupId, _ = input["groupId"].(string)
+			req.MemberId, _ = input["memberId"].(string)
+			req.Name, _ = input["name"].(string)
+			req.Email, _ = input["email"].(string)
+			req.Color, _ = input["color"].(string)
+			req.PaymentHandle, _ = input["paymentHandle"].(string)
+
+			resp, err := groupClient.UpdateMember(context.Background(), req)
+			if err != nil {
+				log.Printf("Error updating member: %v", err)
+				return nil, err
+			}
+
+			return resp.Member, nil
+		},
+	}
+}
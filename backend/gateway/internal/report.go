@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var reportFormats = map[string]groupv1.ReportFormat{
+	"":     groupv1.ReportFormat_REPORT_FORMAT_HTML,
+	"html": groupv1.ReportFormat_REPORT_FORMAT_HTML,
+	"pdf":  groupv1.ReportFormat_REPORT_FORMAT_PDF,
+}
+
+// NewSettlementReportHandler serves a group's printable settlement report at
+// /groups/{id}/report. The format query parameter is html (default) or pdf.
+// The report opens in the browser; download=1 saves it as a file instead.
+func NewSettlementReportHandler(groupClient groupv1.GroupServiceClient) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		format, ok := reportFormats[r.URL.Query().Get("format")]
+		if !ok {
+			http.Error(w, "Unsupported format", http.StatusBadRequest)
+			return
+		}
+
+		resp, err := groupClient.RenderSettlementReport(r.Context(), &groupv1.RenderSettlementReportRequest{
+			GroupId: mux.Vars(r)["id"],
+			Format:  format,
+		})
+		if err != nil {
+			log.Printf("Error rendering settlement report: %v", err)
+			http.Error(w, "Group not found", http.StatusNotFound)
+			return
+		}
+
+		disposition := "inline"
+		if r.URL.Query().Get("download") == "1" {
+			disposition = "attachment"
+		}
+
+		w.Header().Set("Content-Type", resp.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp.Content)))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": resp.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Write(resp.Content)
+	})
+}
@@ -2,10 +2,12 @@ package internal
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/jt-chihara/warikan/backend/proto/group/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -34,8 +36,34 @@ var dateTimeType = graphql.NewScalar(graphql.ScalarConfig{
 		}
 		return nil
 	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch v := valueAST.(type) {
+		case *ast.StringValue:
+			t, err := time.Parse(time.RFC3339, v.Value)
+			if err != nil {
+				return nil
+			}
+			return t
+		}
+		return nil
+	},
 })
 
+// dateTimeArg reads an optional DateTime argument or input field. ok is false
+// when the value is absent; a value that is not a time is an error instead of
+// being silently dropped.
+func dateTimeArg(args map[string]interface{}, name string) (t time.Time, ok bool, err error) {
+	value, present := args[name]
+	if !present || value == nil {
+		return time.Time{}, false, nil
+	}
+	t, ok = value.(time.Time)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("%s must be an RFC 3339 date-time", name)
+	}
+	return t, true, nil
+}
+
 var expenseType = graphql.NewObject(graphql.ObjectConfig{
 	Name: "Expense",
 	Fields: graphql.Fields{
@@ -78,6 +106,26 @@ var settlementType = graphql.NewObject(graphql.ObjectConfig{
 		"toName": &graphql.Field{
 			Type: graphql.NewNonNull(graphql.String),
 		},
+		"fromHouseholdId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				settlement, ok := p.Source.(*groupv1.Settlement)
+				if !ok || settlement.FromHouseholdId == "" {
+					return nil, nil
+				}
+				return settlement.FromHouseholdId, nil
+			},
+		},
+		"toHouseholdId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				settlement, ok := p.Source.(*groupv1.Settlement)
+				if !ok || settlement.ToHouseholdId == "" {
+					return nil, nil
+				}
+				return settlement.ToHouseholdId, nil
+			},
+		},
 	},
 })
 
@@ -105,6 +153,9 @@ var settlementResultType = graphql.NewObject(graphql.ObjectConfig{
 		"balances": &graphql.Field{
 			Type: graphql.NewList(graphql.NewNonNull(memberBalanceType)),
 		},
+		"householdBalances": &graphql.Field{
+			Type: graphql.NewList(graphql.NewNonNull(householdBalanceType)),
+		},
 	},
 })
 
@@ -120,6 +171,12 @@ var splitMemberType = graphql.NewObject(graphql.ObjectConfig{
 		"amount": &graphql.Field{
 			Type: graphql.NewNonNull(graphql.Int),
 		},
+		"vote": &graphql.Field{
+			Type: approvalVoteEnum,
+		},
+		"votedAt": &graphql.Field{
+			Type: dateTimeType,
+		},
 	},
 })
 
@@ -150,6 +207,15 @@ var expenseWithDetailsType = graphql.NewObject(graphql.ObjectConfig{
 		"createdAt": &graphql.Field{
 			Type: graphql.NewNonNull(dateTimeType),
 		},
+		"category": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"kind": &graphql.Field{
+			Type: graphql.NewNonNull(expenseKindEnum),
+		},
+		"approvalStatus": &graphql.Field{
+			Type: graphql.NewNonNull(approvalStatusEnum),
+		},
 	},
 })
 
@@ -171,6 +237,12 @@ var expenseInputType = graphql.NewInputObject(graphql.InputObjectConfig{
 		"splitBetween": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
 		},
+		"kind": &graphql.InputObjectFieldConfig{
+			Type: expenseKindEnum,
+		},
+		"splitAmounts": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+		},
 	},
 })
 
@@ -185,10 +257,43 @@ var memberType = graphql.NewObject(graphql.ObjectConfig{
 		},
 		"email": &graphql.Field{
 			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				member, ok := p.Source.(*groupv1.Member)
+				if !ok || member.Email == "" {
+					return nil, nil
+				}
+				return member.Email, nil
+			},
 		},
 		"joinedAt": &graphql.Field{
 			Type: graphql.NewNonNull(dateTimeType),
 		},
+		"activeFrom": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"activeUntil": &graphql.Field{
+			Type: dateTimeType,
+		},
+		"color": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				member, ok := p.Source.(*groupv1.Member)
+				if !ok || member.Color == "" {
+					return nil, nil
+				}
+				return member.Color, nil
+			},
+		},
+		"paymentHandle": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				member, ok := p.Source.(*groupv1.Member)
+				if !ok || member.PaymentHandle == "" {
+					return nil, nil
+				}
+				return member.PaymentHandle, nil
+			},
+		},
 	},
 })
 
@@ -271,6 +376,18 @@ var addMemberInput = graphql.NewInputObject(graphql.InputObjectConfig{
 	},
 })
 
+var balanceTransferInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "BalanceTransferInput",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"memberId": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.ID),
+		},
+		"amount": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
 var removeMemberInput = graphql.NewInputObject(graphql.InputObjectConfig{
 	Name: "RemoveMemberInput",
 	Fields: graphql.InputObjectConfigFieldMap{
@@ -280,6 +397,9 @@ var removeMemberInput = graphql.NewInputObject(graphql.InputObjectConfig{
 		"memberId": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewNonNull(graphql.ID),
 		},
+		"redistribution": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(balanceTransferInput)),
+		},
 	},
 })
 
@@ -301,6 +421,24 @@ var addExpenseInput = graphql.NewInputObject(graphql.InputObjectConfig{
 		"splitMemberIds": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
 		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"kind": &graphql.InputObjectFieldConfig{
+			Type: expenseKindEnum,
+		},
+		"splitAmounts": &graphql.InputObjectFieldConfig{
+			Type: graphql.NewList(graphql.NewNonNull(graphql.Int)),
+		},
+		"allowDuplicate": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+		"splitAmongPresent": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+		"splitByHousehold": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
 	},
 })
 
@@ -322,6 +460,21 @@ var updateExpenseInput = graphql.NewInputObject(graphql.InputObjectConfig{
 		"splitMemberIds": &graphql.InputObjectFieldConfig{
 			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
 		},
+		"category": &graphql.InputObjectFieldConfig{
+			Type: graphql.String,
+		},
+		"kind": &graphql.InputObjectFieldConfig{
+			Type: expenseKindEnum,
+		},
+		"updatedBy": &graphql.InputObjectFieldConfig{
+			Type: graphql.ID,
+		},
+		"splitAmongPresent": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
+		"splitByHousehold": &graphql.InputObjectFieldConfig{
+			Type: graphql.Boolean,
+		},
 	},
 })
 
@@ -362,6 +515,9 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 					"expenses": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseInputType))),
 					},
+					"byHousehold": &graphql.ArgumentConfig{
+						Type: graphql.Boolean,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					groupId, ok := p.Args["groupId"].(string)
@@ -404,6 +560,14 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 							}
 							expense.SplitBetween = memberIds
 						}
+						if splitAmounts, ok := expenseMap["splitAmounts"].([]interface{}); ok {
+							for _, splitAmount := range splitAmounts {
+								if amount, ok := splitAmount.(int); ok {
+									expense.SplitAmounts = append(expense.SplitAmounts, int64(amount))
+								}
+							}
+						}
+						expense.Kind = expenseKindFromInput(expenseMap)
 
 						expenses[i] = expense
 					}
@@ -412,6 +576,7 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 						GroupId:  groupId,
 						Expenses: expenses,
 					}
+					req.ByHousehold, _ = p.Args["byHousehold"].(bool)
 
 					resp, err := groupClient.CalculateSettlements(context.Background(), req)
 					if err != nil {
@@ -420,8 +585,9 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 					}
 
 					return map[string]interface{}{
-						"settlements": resp.Settlements,
-						"balances":    resp.Balances,
+						"settlements":       resp.Settlements,
+						"balances":          resp.Balances,
+						"householdBalances": resp.HouseholdBalances,
 					}, nil
 				},
 			},
@@ -606,6 +772,20 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 						GroupId:  input["groupId"].(string),
 						MemberId: input["memberId"].(string),
 					}
+					if redistribution, ok := input["redistribution"].([]interface{}); ok {
+						for _, item := range redistribution {
+							transfer, ok := item.(map[string]interface{})
+							if !ok {
+								continue
+							}
+							balanceTransfer := &groupv1.BalanceTransfer{}
+							balanceTransfer.MemberId, _ = transfer["memberId"].(string)
+							if amount, ok := transfer["amount"].(int); ok {
+								balanceTransfer.Amount = int64(amount)
+							}
+							req.Redistribution = append(req.Redistribution, balanceTransfer)
+						}
+					}
 
 					resp, err := groupClient.RemoveMember(context.Background(), req)
 					if err != nil {
@@ -656,12 +836,25 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 						SplitMemberIds:  splitMemberIds,
 					}
 
+					if category, exists := input["category"]; exists && category != nil {
+						req.Category = category.(string)
+					}
+					req.Kind = expenseKindFromInput(input)
+					req.SplitAmounts = splitAmountsFromInput(input)
+					req.AllowDuplicate, _ = input["allowDuplicate"].(bool)
+					req.SplitAmongPresent, _ = input["splitAmongPresent"].(bool)
+					req.SplitByHousehold, _ = input["splitByHousehold"].(bool)
+
 					resp, err := groupClient.AddExpense(context.Background(), req)
 					if err != nil {
 						log.Printf("Error adding expense: %v", err)
 						return nil, err
 					}
 
+					if resp.Expense == nil && len(resp.ProbableDuplicates) > 0 {
+						return nil, probableDuplicateError(resp.ProbableDuplicates)
+					}
+
 					return resp.Expense, nil
 				},
 			},
@@ -701,6 +894,15 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 						PaidById:       input["paidById"].(string),
 						SplitMemberIds: splitMemberIds,
 					}
+					if category, exists := input["category"]; exists && category != nil {
+						req.Category = category.(string)
+					}
+					req.Kind = expenseKindFromInput(input)
+					if updatedBy, exists := input["updatedBy"]; exists && updatedBy != nil {
+						req.UpdatedBy = updatedBy.(string)
+					}
+					req.SplitAmongPresent, _ = input["splitAmongPresent"].(bool)
+					req.SplitByHousehold, _ = input["splitByHousehold"].(bool)
 					resp, err := groupClient.UpdateExpense(context.Background(), req)
 					if err != nil {
 						log.Printf("Error updating expense: %v", err)
@@ -715,6 +917,9 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 					"expenseId": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.ID),
 					},
+					"deletedBy": &graphql.ArgumentConfig{
+						Type: graphql.ID,
+					},
 				},
 				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
 					expenseId, ok := p.Args["expenseId"].(string)
@@ -725,6 +930,9 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 					req := &groupv1.DeleteExpenseRequest{
 						ExpenseId: expenseId,
 					}
+					if deletedBy, ok := p.Args["deletedBy"].(string); ok {
+						req.DeletedBy = deletedBy
+					}
 
 					resp, err := groupClient.DeleteExpense(context.Background(), req)
 					if err != nil {
@@ -738,6 +946,86 @@ func NewSchema(groupClient groupv1.GroupServiceClient) (graphql.Schema, error) {
 		},
 	})
 
+	// Batch expense creation
+	mutationType.AddFieldConfig("addExpenses", addExpensesField(groupClient))
+
+	// Filtered, sorted and paginated expense listing
+	queryType.AddFieldConfig("expenses", expensesConnectionField(groupClient))
+
+	// Receipt attachments
+	expenseWithDetailsType.AddFieldConfig("attachments", expenseAttachmentsField(groupClient))
+	mutationType.AddFieldConfig("uploadAttachment", uploadAttachmentField(groupClient))
+	mutationType.AddFieldConfig("deleteAttachment", deleteAttachmentField(groupClient))
+
+	// Expense comments
+	expenseWithDetailsType.AddFieldConfig("comments", expenseCommentsField(groupClient))
+	mutationType.AddFieldConfig("addExpenseComment", addExpenseCommentField(groupClient))
+	mutationType.AddFieldConfig("updateExpenseComment", updateExpenseCommentField(groupClient))
+	mutationType.AddFieldConfig("deleteExpenseComment", deleteExpenseCommentField(groupClient))
+
+	// Expense search
+	queryType.AddFieldConfig("searchExpenses", searchExpensesField(groupClient))
+
+	// Trash
+	queryType.AddFieldConfig("deletedExpenses", deletedExpensesField(groupClient))
+	mutationType.AddFieldConfig("restoreExpense", restoreExpenseField(groupClient))
+
+	// Expense revision history
+	queryType.AddFieldConfig("expenseHistory", expenseHistoryField(groupClient))
+	mutationType.AddFieldConfig("revertExpense", revertExpenseField(groupClient))
+
+	// Accounting journal export
+	queryType.AddFieldConfig("accountMappings", accountMappingsField(groupClient))
+	mutationType.AddFieldConfig("updateAccountMappings", updateAccountMappingsField(groupClient))
+
+	// Bank transfers
+	queryType.AddFieldConfig("bankAccounts", bankAccountsField(groupClient))
+	mutationType.AddFieldConfig("setMemberBankAccount", setMemberBankAccountField(groupClient))
+	mutationType.AddFieldConfig("deleteMemberBankAccount", deleteMemberBankAccountField(groupClient))
+
+	// Expense templates
+	queryType.AddFieldConfig("expenseTemplates", expenseTemplatesField(groupClient))
+	mutationType.AddFieldConfig("createExpenseTemplate", createExpenseTemplateField(groupClient))
+	mutationType.AddFieldConfig("updateExpenseTemplate", updateExpenseTemplateField(groupClient))
+	mutationType.AddFieldConfig("deleteExpenseTemplate", deleteExpenseTemplateField(groupClient))
+	mutationType.AddFieldConfig("addExpenseFromTemplate", addExpenseFromTemplateField(groupClient))
+
+	// Duplicate expenses
+	queryType.AddFieldConfig("duplicateExpenses", duplicateExpensesField(groupClient))
+	mutationType.AddFieldConfig("mergeExpenses", mergeExpensesField(groupClient))
+
+	// Budgets
+	queryType.AddFieldConfig("budgets", budgetsField(groupClient))
+	queryType.AddFieldConfig("budgetStatus", budgetStatusField(groupClient))
+	mutationType.AddFieldConfig("updateBudgets", updateBudgetsField(groupClient))
+
+	// Expense approval
+	queryType.AddFieldConfig("groupSettings", groupSettingsField(groupClient))
+	mutationType.AddFieldConfig("updateGroupSettings", updateGroupSettingsField(groupClient))
+	mutationType.AddFieldConfig("voteOnExpense", voteOnExpenseField(groupClient))
+
+	// Closing periods
+	queryType.AddFieldConfig("closedPeriods", closedPeriodsField(groupClient))
+	mutationType.AddFieldConfig("closePeriod", closePeriodField(groupClient))
+	mutationType.AddFieldConfig("reopenPeriod", reopenPeriodField(groupClient))
+
+	// Scoped settlements
+	queryType.AddFieldConfig("scopedSettlements", scopedSettlementsField(groupClient))
+
+	// Member participation
+	mutationType.AddFieldConfig("setMemberActivePeriod", setMemberActivePeriodField(groupClient))
+
+	// Member merges
+	queryType.AddFieldConfig("memberMerges", memberMergesField(groupClient))
+	mutationType.AddFieldConfig("mergeMembers", mergeMembersField(groupClient))
+
+	// Member profiles
+	mutationType.AddFieldConfig("updateMember", updateMemberField(groupClient))
+
+	// Households
+	queryType.AddFieldConfig("households", householdsField(groupClient))
+	mutationType.AddFieldConfig("updateHouseholds", updateHouseholdsField(groupClient))
+
 	return graphql.NewSchema(graphql.SchemaConfig{
 		Query:    queryType,
 		Mutation: mutationType,
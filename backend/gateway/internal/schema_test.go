@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeGroupClient records the requests the resolvers send to the group
+// service and answers each with an empty response. Calls to methods it does
+// not override panic through the nil embedded client.
+type fakeGroupClient struct {
+	groupv1.GroupServiceClient
+	requests []interface{}
+}
+
+func (f *fakeGroupClient) GetGroupExpenses(ctx context.Context, in *groupv1.GetGroupExpensesRequest, opts ...grpc.CallOption) (*groupv1.GetGroupExpensesResponse, error) {
+	f.requests = append(f.requests, in)
+	return &groupv1.GetGroupExpensesResponse{}, nil
+}
+
+func (f *fakeGroupClient) GetBudgetStatus(ctx context.Context, in *groupv1.GetBudgetStatusRequest, opts ...grpc.CallOption) (*groupv1.GetBudgetStatusResponse, error) {
+	f.requests = append(f.requests, in)
+	return &groupv1.GetBudgetStatusResponse{}, nil
+}
+
+func (f *fakeGroupClient) ClosePeriod(ctx context.Context, in *groupv1.ClosePeriodRequest, opts ...grpc.CallOption) (*groupv1.ClosePeriodResponse, error) {
+	f.requests = append(f.requests, in)
+	return &groupv1.ClosePeriodResponse{Period: &groupv1.ClosedPeriod{}}, nil
+}
+
+func (f *fakeGroupClient) CalculateScopedSettlements(ctx context.Context, in *groupv1.CalculateScopedSettlementsRequest, opts ...grpc.CallOption) (*groupv1.CalculateScopedSettlementsResponse, error) {
+	f.requests = append(f.requests, in)
+	return &groupv1.CalculateScopedSettlementsResponse{}, nil
+}
+
+func (f *fakeGroupClient) SetMemberActivePeriod(ctx context.Context, in *groupv1.SetMemberActivePeriodRequest, opts ...grpc.CallOption) (*groupv1.SetMemberActivePeriodResponse, error) {
+	f.requests = append(f.requests, in)
+	return &groupv1.SetMemberActivePeriodResponse{Member: &groupv1.Member{}}, nil
+}
+
+// executeQuery runs a GraphQL request against a schema backed by client and
+// fails the test on any error
+func executeQuery(t *testing.T, client groupv1.GroupServiceClient, query string) *graphql.Result {
+	t.Helper()
+	schema, err := NewSchema(client)
+	if err != nil {
+		t.Fatalf("NewSchema() error = %v", err)
+	}
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if result.HasErrors() {
+		t.Fatalf("query errors: %v", result.Errors)
+	}
+	return result
+}
+
+func TestNewSchema(t *testing.T) {
+	// graphql-go rejects invalid types, such as a scalar with only half of
+	// its parse functions, when the schema is built
+	if _, err := NewSchema(nil); err != nil {
+		t.Fatalf("NewSchema() error = %v", err)
+	}
+}
+
+func TestDateTimeArg(t *testing.T) {
+	at := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	got, ok, err := dateTimeArg(map[string]interface{}{"at": at}, "at")
+	if err != nil || !ok || !got.Equal(at) {
+		t.Errorf("dateTimeArg(time) = %v, %v, %v", got, ok, err)
+	}
+
+	_, ok, err = dateTimeArg(map[string]interface{}{}, "at")
+	if err != nil || ok {
+		t.Errorf("dateTimeArg(absent) = %v, %v", ok, err)
+	}
+
+	// A raw string means the scalar did not parse the value
+	_, _, err = dateTimeArg(map[string]interface{}{"at": "2026-04-01"}, "at")
+	if err == nil {
+		t.Error("dateTimeArg(string) error = nil, want an error")
+	}
+}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var scopedSettlementResultType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ScopedSettlementResult",
+	Fields: graphql.Fields{
+		"settlements": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(settlementType))),
+		},
+		"balances": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberBalanceType))),
+		},
+		"expenseCount": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"totalSpent": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
+func scopedSettlementsField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(scopedSettlementResultType),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"createdFrom": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+			"createdTo": &graphql.ArgumentConfig{
+				Type: dateTimeType,
+			},
+			"expenseIds": &graphql.ArgumentConfig{
+				Type: graphql.NewList(graphql.NewNonNull(graphql.ID)),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			req := &groupv1.CalculateScopedSettlementsRequest{}
+			req.GroupId, _ = p.Args["groupId"].(string)
+			createdFrom, ok, err := dateTimeArg(p.Args, "createdFrom")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.CreatedFrom = timestamppb.New(createdFrom)
+			}
+			createdTo, ok, err := dateTimeArg(p.Args, "createdTo")
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				req.CreatedTo = timestamppb.New(createdTo)
+			}
+			if expenseIds, ok := p.Args["expenseIds"].([]interface{}); ok {
+				for _, id := range expenseIds {
+					if idStr, ok := id.(string); ok {
+						req.ExpenseIds = append(req.ExpenseIds, idStr)
+					}
+				}
+			}
+
+			resp, err := groupClient.CalculateScopedSettlements(context.Background(), req)
+			if err != nil {
+				log.Printf("Error calculating scoped settlements: %v", err)
+				return nil, err
+			}
+
+			return resp, nil
+		},
+	}
+}
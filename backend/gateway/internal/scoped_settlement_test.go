@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestScopedSettlements_DateRange(t *testing.T) {
+	client := &fakeGroupClient{}
+
+	executeQuery(t, client, `{
+		scopedSettlements(groupId: "g1", createdFrom: "2026-08-10T00:00:00Z", createdTo: "2026-08-14T00:00:00Z") { __typename }
+	}`)
+
+	if len(client.requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(client.requests))
+	}
+	req := client.requests[0].(*groupv1.CalculateScopedSettlementsRequest)
+	if req.CreatedFrom == nil || req.CreatedTo == nil {
+		t.Fatalf("date range was not sent: %v", req)
+	}
+	if want := time.Date(2026, 8, 10, 0, 0, 0, 0, time.UTC); !req.CreatedFrom.AsTime().Equal(want) {
+		t.Errorf("CreatedFrom = %v, want %v", req.CreatedFrom.AsTime(), want)
+	}
+	if want := time.Date(2026, 8, 14, 0, 0, 0, 0, time.UTC); !req.CreatedTo.AsTime().Equal(want) {
+		t.Errorf("CreatedTo = %v, want %v", req.CreatedTo.AsTime(), want)
+	}
+}
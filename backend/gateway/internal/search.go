@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var textRangeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "TextRange",
+	Fields: graphql.Fields{
+		"start": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+		"end": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Int),
+		},
+	},
+})
+
+var searchHighlightType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "SearchHighlight",
+	Fields: graphql.Fields{
+		"field": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"text": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.String),
+		},
+		"ranges": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(textRangeType))),
+		},
+		"commentId": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				highlight, ok := p.Source.(*groupv1.SearchHighlight)
+				if !ok || highlight.CommentId == "" {
+					return nil, nil
+				}
+				return highlight.CommentId, nil
+			},
+		},
+	},
+})
+
+var expenseSearchResultType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ExpenseSearchResult",
+	Fields: graphql.Fields{
+		"expense": &graphql.Field{
+			Type: graphql.NewNonNull(expenseWithDetailsType),
+		},
+		"score": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.Float),
+		},
+		"highlights": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(searchHighlightType))),
+		},
+	},
+})
+
+func searchExpensesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(expenseSearchResultType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"query": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			"limit": &graphql.ArgumentConfig{
+				Type: graphql.Int,
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+			query, ok := p.Args["query"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.SearchExpensesRequest{
+				GroupId: groupId,
+				Query:   query,
+			}
+			if limit, ok := p.Args["limit"].(int); ok {
+				req.Limit = int32(limit)
+			}
+
+			resp, err := groupClient.SearchExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error searching expenses: %v", err)
+				return nil, err
+			}
+
+			return resp.Results, nil
+		},
+	}
+}
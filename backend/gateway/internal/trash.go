@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"log"
+
+	"github.com/graphql-go/graphql"
+	"github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+var deletedExpenseType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "DeletedExpense",
+	Fields: graphql.Fields{
+		"expense": &graphql.Field{
+			Type: graphql.NewNonNull(expenseWithDetailsType),
+		},
+		"deletedAt": &graphql.Field{
+			Type: graphql.NewNonNull(dateTimeType),
+		},
+		"deletedById": &graphql.Field{
+			Type: graphql.ID,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				deleted, ok := p.Source.(*groupv1.DeletedExpense)
+				if !ok || deleted.DeletedById == "" {
+					return nil, nil
+				}
+				return deleted.DeletedById, nil
+			},
+		},
+		"deletedByName": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				deleted, ok := p.Source.(*groupv1.DeletedExpense)
+				if !ok || deleted.DeletedByName == "" {
+					return nil, nil
+				}
+				return deleted.DeletedByName, nil
+			},
+		},
+		"purgeAt": &graphql.Field{
+			Type: dateTimeType,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				deleted, ok := p.Source.(*groupv1.DeletedExpense)
+				if !ok || deleted.PurgeAt == nil {
+					return nil, nil
+				}
+				return deleted.PurgeAt, nil
+			},
+		},
+	},
+})
+
+func deletedExpensesField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(deletedExpenseType))),
+		Args: graphql.FieldConfigArgument{
+			"groupId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			groupId, ok := p.Args["groupId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.ListDeletedExpensesRequest{GroupId: groupId}
+			resp, err := groupClient.ListDeletedExpenses(context.Background(), req)
+			if err != nil {
+				log.Printf("Error listing deleted expenses: %v", err)
+				return nil, err
+			}
+
+			return resp.Expenses, nil
+		},
+	}
+}
+
+func restoreExpenseField(groupClient groupv1.GroupServiceClient) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(expenseWithDetailsType),
+		Args: graphql.FieldConfigArgument{
+			"expenseId": &graphql.ArgumentConfig{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			expenseId, ok := p.Args["expenseId"].(string)
+			if !ok {
+				return nil, nil
+			}
+
+			req := &groupv1.RestoreExpenseRequest{ExpenseId: expenseId}
+			resp, err := groupClient.RestoreExpense(context.Background(), req)
+			if err != nil {
+				log.Printf("Error restoring expense: %v", err)
+				return nil, err
+			}
+
+			return resp.Expense, nil
+		},
+	}
+}
@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// maxUploadRequestSize bounds a multipart GraphQL request. The group service
+// enforces the actual per-file limit.
+const maxUploadRequestSize = 12 << 20
+
+// Upload is a file sent with a GraphQL multipart request
+type Upload struct {
+	File        multipart.File
+	Filename    string
+	ContentType string
+	Size        int64
+}
+
+var uploadType = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Upload",
+	Description: "A file sent as part of a multipart GraphQL request",
+	Serialize: func(value interface{}) interface{} {
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		if upload, ok := value.(*Upload); ok {
+			return upload
+		}
+		return nil
+	},
+	// Files only ever arrive as variables, never inline in the query
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		return nil
+	},
+})
+
+// NewMultipartHandler serves GraphQL multipart requests
+// (https://github.com/jaydenseric/graphql-multipart-request-spec) and passes
+// every other request on to next.
+func NewMultipartHandler(schema *graphql.Schema, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if r.Method != http.MethodPost || err != nil || mediaType != "multipart/form-data" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxUploadRequestSize)
+		if err := r.ParseMultipartForm(maxUploadRequestSize); err != nil {
+			http.Error(w, "Invalid multipart request", http.StatusBadRequest)
+			return
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		params, err := parseMultipartOperation(r.MultipartForm)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer closeUploads(params.VariableValues)
+
+		params.Schema = *schema
+		params.Context = r.Context()
+		result := graphql.Do(params)
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.Printf("Error writing multipart response: %v", err)
+		}
+	})
+}
+
+func parseMultipartOperation(form *multipart.Form) (graphql.Params, error) {
+	var operation struct {
+		Query         string                 `json:"query"`
+		Variables     map[string]interface{} `json:"variables"`
+		OperationName string                 `json:"operationName"`
+	}
+	if err := json.Unmarshal([]byte(firstValue(form.Value["operations"])), &operation); err != nil {
+		return graphql.Params{}, fmt.Errorf("invalid operations field: %w", err)
+	}
+	if operation.Variables == nil {
+		operation.Variables = map[string]interface{}{}
+	}
+
+	var fileMap map[string][]string
+	if err := json.Unmarshal([]byte(firstValue(form.Value["map"])), &fileMap); err != nil {
+		return graphql.Params{}, fmt.Errorf("invalid map field: %w", err)
+	}
+
+	// Files opened for earlier entries are closed when a later one fails
+	var opened []*Upload
+	fail := func(err error) (graphql.Params, error) {
+		for _, upload := range opened {
+			upload.File.Close()
+		}
+		return graphql.Params{}, err
+	}
+
+	root := map[string]interface{}{"variables": operation.Variables}
+	for key, paths := range fileMap {
+		headers := form.File[key]
+		if len(headers) == 0 {
+			return fail(fmt.Errorf("missing file %q", key))
+		}
+
+		for _, path := range paths {
+			file, err := headers[0].Open()
+			if err != nil {
+				return fail(fmt.Errorf("failed to open file %q: %w", key, err))
+			}
+
+			upload := &Upload{
+				File:        file,
+				Filename:    headers[0].Filename,
+				ContentType: headers[0].Header.Get("Content-Type"),
+				Size:        headers[0].Size,
+			}
+			opened = append(opened, upload)
+			if err := setPath(root, strings.Split(path, "."), upload); err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	return graphql.Params{
+		RequestString:  operation.Query,
+		VariableValues: operation.Variables,
+		OperationName:  operation.OperationName,
+	}, nil
+}
+
+// setPath replaces the value at an object path such as "variables.input.files.0"
+func setPath(value interface{}, path []string, upload *Upload) error {
+	if len(path) == 0 {
+		return fmt.Errorf("empty file path")
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			v[path[0]] = upload
+			return nil
+		}
+		return setPath(v[path[0]], path[1:], upload)
+	case []interface{}:
+		i, err := strconv.Atoi(path[0])
+		if err != nil || i < 0 || i >= len(v) {
+			return fmt.Errorf("invalid file path segment %q", path[0])
+		}
+		if len(path) == 1 {
+			v[i] = upload
+			return nil
+		}
+		return setPath(v[i], path[1:], upload)
+	default:
+		return fmt.Errorf("invalid file path segment %q", path[0])
+	}
+}
+
+func closeUploads(value interface{}) {
+	switch v := value.(type) {
+	case *Upload:
+		v.File.Close()
+	case map[string]interface{}:
+		for _, item := range v {
+			closeUploads(item)
+		}
+	case []interface{}:
+		for _, item := range v {
+			closeUploads(item)
+		}
+	}
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -1,6 +1,9 @@
 -- Simplified Schema for Production Deployment
 -- Only includes tables that are actually used by the application
 
+-- Extensions
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
 -- Groups table
 CREATE TABLE groups (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -16,7 +19,13 @@ CREATE TABLE members (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
     name VARCHAR(255) NOT NULL,
-    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+    email VARCHAR(254), -- NULL when not given
+    color VARCHAR(7), -- Display color as #RRGGBB
+    payment_handle VARCHAR(100), -- e.g. a PayPay ID, shown when settling up
+    joined_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    active_from TIMESTAMP WITH TIME ZONE, -- NULL: takes part from the start
+    active_until TIMESTAMP WITH TIME ZONE, -- NULL: still takes part
+    archived_at TIMESTAMP WITH TIME ZONE -- Set once removed; the row stays so expenses keep the name
 );
 
 -- Expenses table (final schema after all migrations)
@@ -25,28 +34,187 @@ CREATE TABLE expenses (
     group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
     amount BIGINT NOT NULL, -- Amount in cents (JPY)
     description TEXT NOT NULL,
+    category VARCHAR(50) NOT NULL DEFAULT '',
+    kind VARCHAR(10) NOT NULL DEFAULT 'expense' CHECK (kind IN ('expense', 'income', 'payment')), -- income: paid_by_id received the money; payment: paid_by_id settled up with the split member
     currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
-    paid_by_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    paid_by_id UUID NOT NULL REFERENCES members(id), -- Not cascaded so deleting a member cannot wipe the ledger; members are archived instead
+    approval_status VARCHAR(10) NOT NULL DEFAULT 'approved' CHECK (approval_status IN ('pending', 'approved', 'disputed')), -- Only approved expenses count towards balances
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
-    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE, -- Set while the expense is in the trash
+    deleted_by UUID REFERENCES members(id) ON DELETE SET NULL
 );
 
 -- Expense splits table (final schema after all migrations)
 CREATE TABLE expense_splits (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
-    member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    member_id UUID NOT NULL REFERENCES members(id), -- Not cascaded, like expenses.paid_by_id
     amount BIGINT NOT NULL, -- Amount owed by this member in cents (JPY)
+    vote VARCHAR(10) NOT NULL DEFAULT '' CHECK (vote IN ('', 'approve', 'dispute')), -- Empty until the member reviews a pending expense
+    voted_at TIMESTAMP WITH TIME ZONE,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     UNIQUE(expense_id, member_id)
 );
 
+-- Expense attachments table (receipt photos / PDFs; file bodies live in the blob store)
+CREATE TABLE expense_attachments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Expense comments table (discussion thread per expense)
+CREATE TABLE expense_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Expense revisions table (snapshot of an expense after every create, update or revert)
+CREATE TABLE expense_revisions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    expense_id UUID NOT NULL REFERENCES expenses(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL, -- Starts at 1 for each expense
+    amount BIGINT NOT NULL, -- Amount in cents (JPY)
+    description TEXT NOT NULL,
+    category VARCHAR(50) NOT NULL DEFAULT '',
+    kind VARCHAR(10) NOT NULL DEFAULT 'expense',
+    paid_by_id UUID NOT NULL, -- No foreign key: history outlives removed members
+    paid_by_name VARCHAR(255) NOT NULL,
+    splits JSONB NOT NULL DEFAULT '[]', -- [{"member_id", "member_name", "amount"}]
+    changed_by UUID REFERENCES members(id) ON DELETE SET NULL,
+    reverted_from INTEGER, -- Revision restored by a revert
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(expense_id, revision)
+);
+
+-- Account mappings table (accounting software account per expense category)
+CREATE TABLE account_mappings (
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL,
+    account VARCHAR(100) NOT NULL,
+    PRIMARY KEY (group_id, category)
+);
+
+-- Member bank accounts table (settlement transfer destination, encrypted by the service)
+CREATE TABLE member_bank_accounts (
+    member_id UUID PRIMARY KEY REFERENCES members(id) ON DELETE CASCADE,
+    details BYTEA NOT NULL, -- AES-GCM sealed JSON with the member ID as associated data
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Expense templates table (prefilled expenses that are entered repeatedly)
+CREATE TABLE expense_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    description TEXT NOT NULL,
+    amount BIGINT NOT NULL DEFAULT 0, -- Default amount in cents (JPY); 0 when entered each time
+    category VARCHAR(50) NOT NULL DEFAULT '',
+    kind VARCHAR(10) NOT NULL DEFAULT 'expense' CHECK (kind IN ('expense', 'income', 'payment')),
+    paid_by_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    split_mode VARCHAR(10) NOT NULL DEFAULT 'equal' CHECK (split_mode IN ('equal', 'shares')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(group_id, name)
+);
+
+-- Expense template members table (split members of a template in the order they were listed)
+CREATE TABLE expense_template_members (
+    template_id UUID NOT NULL REFERENCES expense_templates(id) ON DELETE CASCADE,
+    member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    shares INTEGER NOT NULL DEFAULT 1, -- Weight under split_mode 'shares'
+    PRIMARY KEY (template_id, member_id)
+);
+
+-- Group budgets table (spending limit for the whole group or one category)
+CREATE TABLE group_budgets (
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL DEFAULT '', -- Empty for the whole group
+    amount BIGINT NOT NULL, -- Amount in cents (JPY)
+    period VARCHAR(10) NOT NULL DEFAULT 'total' CHECK (period IN ('total', 'monthly', 'weekly')),
+    PRIMARY KEY (group_id, category)
+);
+
+-- Group settings table (one row per group that changed a default)
+CREATE TABLE group_settings (
+    group_id UUID PRIMARY KEY REFERENCES groups(id) ON DELETE CASCADE,
+    require_expense_approval BOOLEAN NOT NULL DEFAULT FALSE, -- New expenses start as pending
+    admin_member_id UUID REFERENCES members(id) ON DELETE SET NULL, -- Allowed to reopen closed periods
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Closed periods table (expenses created up to closed_until can no longer change)
+CREATE TABLE closed_periods (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    period_start TIMESTAMP WITH TIME ZONE, -- closed_until of the previous period
+    closed_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    opening_balances JSONB NOT NULL DEFAULT '[]', -- Carried forward from the previous period
+    balances JSONB NOT NULL DEFAULT '[]', -- [{member_id, member_name, balance}]
+    settlements JSONB NOT NULL DEFAULT '[]', -- [{from_member_id, from_name, to_member_id, to_name, amount}]
+    closed_by UUID REFERENCES members(id) ON DELETE SET NULL,
+    closed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    reopened_at TIMESTAMP WITH TIME ZONE,
+    reopened_by UUID REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- Member merges table (audit trail of members folded into another one; names as they were at the merge)
+CREATE TABLE member_merges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    kept_member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE,
+    kept_member_name VARCHAR(255) NOT NULL,
+    merged_member_id UUID NOT NULL REFERENCES members(id) ON DELETE CASCADE, -- Archived by the merge
+    merged_member_name VARCHAR(255) NOT NULL,
+    expense_count INTEGER NOT NULL DEFAULT 0,
+    merged_by UUID REFERENCES members(id) ON DELETE SET NULL,
+    merged_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Households table (members settling up as one unit, such as a couple or a family)
+CREATE TABLE households (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE TABLE household_members (
+    household_id UUID NOT NULL REFERENCES households(id) ON DELETE CASCADE,
+    member_id UUID PRIMARY KEY REFERENCES members(id) ON DELETE CASCADE -- One household per member
+);
+
 -- Indexes
 CREATE INDEX idx_members_group_id ON members(group_id);
 CREATE INDEX idx_expenses_group_id ON expenses(group_id);
 CREATE INDEX idx_expenses_paid_by_id ON expenses(paid_by_id);
+CREATE INDEX idx_expenses_group_id_created_at ON expenses(group_id, created_at DESC, id DESC);
+CREATE INDEX idx_expenses_group_id_category ON expenses(group_id, category);
+CREATE INDEX idx_expenses_group_id_deleted_at ON expenses(group_id, deleted_at) WHERE deleted_at IS NOT NULL;
 CREATE INDEX idx_expense_splits_expense_id ON expense_splits(expense_id);
 CREATE INDEX idx_expense_splits_member_id ON expense_splits(member_id);
+CREATE INDEX idx_expense_attachments_expense_id ON expense_attachments(expense_id);
+CREATE INDEX idx_expense_comments_expense_id ON expense_comments(expense_id);
+CREATE INDEX idx_expense_template_members_member_id ON expense_template_members(member_id);
+CREATE INDEX idx_closed_periods_group_id ON closed_periods(group_id, closed_until DESC);
+CREATE INDEX idx_member_merges_group_id ON member_merges(group_id, merged_at DESC);
+CREATE INDEX idx_households_group_id ON households(group_id);
+CREATE INDEX idx_household_members_household_id ON household_members(household_id);
+
+-- Trigram indexes for expense search (also serve ILIKE for short or Japanese queries)
+CREATE INDEX idx_expenses_description_trgm ON expenses USING GIN (description gin_trgm_ops);
+CREATE INDEX idx_expense_comments_body_trgm ON expense_comments USING GIN (body gin_trgm_ops);
+CREATE INDEX idx_members_name_trgm ON members USING GIN (name gin_trgm_ops);
 
 -- Update timestamp function
 CREATE OR REPLACE FUNCTION update_updated_at_column()
@@ -62,4 +230,13 @@ CREATE TRIGGER update_groups_updated_at BEFORE UPDATE ON groups
     FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
 
 CREATE TRIGGER update_expenses_updated_at BEFORE UPDATE ON expenses
-    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
\ No newline at end of file
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_expense_comments_updated_at BEFORE UPDATE ON expense_comments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_expense_templates_updated_at BEFORE UPDATE ON expense_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_group_settings_updated_at BEFORE UPDATE ON group_settings
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
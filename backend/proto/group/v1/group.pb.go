@@ -22,34 +22,8315 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ExpenseSortField int32
+
+const (
+	ExpenseSortField_EXPENSE_SORT_FIELD_UNSPECIFIED ExpenseSortField = 0
+	ExpenseSortField_EXPENSE_SORT_FIELD_CREATED_AT  ExpenseSortField = 1
+	ExpenseSortField_EXPENSE_SORT_FIELD_AMOUNT      ExpenseSortField = 2
+	ExpenseSortField_EXPENSE_SORT_FIELD_DESCRIPTION ExpenseSortField = 3
+)
+
+// Enum value maps for ExpenseSortField.
+var (
+	ExpenseSortField_name = map[int32]string{
+		0: "EXPENSE_SORT_FIELD_UNSPECIFIED",
+		1: "EXPENSE_SORT_FIELD_CREATED_AT",
+		2: "EXPENSE_SORT_FIELD_AMOUNT",
+		3: "EXPENSE_SORT_FIELD_DESCRIPTION",
+	}
+	ExpenseSortField_value = map[string]int32{
+		"EXPENSE_SORT_FIELD_UNSPECIFIED": 0,
+		"EXPENSE_SORT_FIELD_CREATED_AT":  1,
+		"EXPENSE_SORT_FIELD_AMOUNT":      2,
+		"EXPENSE_SORT_FIELD_DESCRIPTION": 3,
+	}
+)
+
+func (x ExpenseSortField) Enum() *ExpenseSortField {
+	p := new(ExpenseSortField)
+	*p = x
+	return p
+}
+
+func (x ExpenseSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpenseSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[0].Descriptor()
+}
+
+func (ExpenseSortField) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[0]
+}
+
+func (x ExpenseSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpenseSortField.Descriptor instead.
+func (ExpenseSortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 1
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_DESC",
+		2: "SORT_DIRECTION_ASC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_DESC":        1,
+		"SORT_DIRECTION_ASC":         2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+// An income entry is money flowing into the group, e.g. a refunded deposit.
+// The member who received it owes each participant their share, the reverse
+// of an expense.
+type ExpenseKind int32
+
+const (
+	ExpenseKind_EXPENSE_KIND_UNSPECIFIED ExpenseKind = 0 // Treated as EXPENSE_KIND_EXPENSE
+	ExpenseKind_EXPENSE_KIND_EXPENSE     ExpenseKind = 1
+	ExpenseKind_EXPENSE_KIND_INCOME      ExpenseKind = 2
+	ExpenseKind_EXPENSE_KIND_PAYMENT     ExpenseKind = 3 // Settle-up paid by payer_id to the single split member
+)
+
+// Enum value maps for ExpenseKind.
+var (
+	ExpenseKind_name = map[int32]string{
+		0: "EXPENSE_KIND_UNSPECIFIED",
+		1: "EXPENSE_KIND_EXPENSE",
+		2: "EXPENSE_KIND_INCOME",
+		3: "EXPENSE_KIND_PAYMENT",
+	}
+	ExpenseKind_value = map[string]int32{
+		"EXPENSE_KIND_UNSPECIFIED": 0,
+		"EXPENSE_KIND_EXPENSE":     1,
+		"EXPENSE_KIND_INCOME":      2,
+		"EXPENSE_KIND_PAYMENT":     3,
+	}
+)
+
+func (x ExpenseKind) Enum() *ExpenseKind {
+	p := new(ExpenseKind)
+	*p = x
+	return p
+}
+
+func (x ExpenseKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpenseKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[2].Descriptor()
+}
+
+func (ExpenseKind) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[2]
+}
+
+func (x ExpenseKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpenseKind.Descriptor instead.
+func (ExpenseKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{2}
+}
+
+// Export messages
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // Treated as EXPORT_FORMAT_CSV
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_JSON        ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[3]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{3}
+}
+
+type ExportCsvLayout int32
+
+const (
+	ExportCsvLayout_EXPORT_CSV_LAYOUT_UNSPECIFIED ExportCsvLayout = 0 // Treated as EXPORT_CSV_LAYOUT_EXPENSES
+	ExportCsvLayout_EXPORT_CSV_LAYOUT_EXPENSES    ExportCsvLayout = 1 // One row per expense, participants joined with ";"
+	ExportCsvLayout_EXPORT_CSV_LAYOUT_SPLITS      ExportCsvLayout = 2 // One row per member share of each expense
+)
+
+// Enum value maps for ExportCsvLayout.
+var (
+	ExportCsvLayout_name = map[int32]string{
+		0: "EXPORT_CSV_LAYOUT_UNSPECIFIED",
+		1: "EXPORT_CSV_LAYOUT_EXPENSES",
+		2: "EXPORT_CSV_LAYOUT_SPLITS",
+	}
+	ExportCsvLayout_value = map[string]int32{
+		"EXPORT_CSV_LAYOUT_UNSPECIFIED": 0,
+		"EXPORT_CSV_LAYOUT_EXPENSES":    1,
+		"EXPORT_CSV_LAYOUT_SPLITS":      2,
+	}
+)
+
+func (x ExportCsvLayout) Enum() *ExportCsvLayout {
+	p := new(ExportCsvLayout)
+	*p = x
+	return p
+}
+
+func (x ExportCsvLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportCsvLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportCsvLayout) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[4]
+}
+
+func (x ExportCsvLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportCsvLayout.Descriptor instead.
+func (ExportCsvLayout) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{4}
+}
+
+// Settlement report messages
+type ReportFormat int32
+
+const (
+	ReportFormat_REPORT_FORMAT_UNSPECIFIED ReportFormat = 0 // Treated as REPORT_FORMAT_HTML
+	ReportFormat_REPORT_FORMAT_HTML        ReportFormat = 1
+	ReportFormat_REPORT_FORMAT_PDF         ReportFormat = 2
+)
+
+// Enum value maps for ReportFormat.
+var (
+	ReportFormat_name = map[int32]string{
+		0: "REPORT_FORMAT_UNSPECIFIED",
+		1: "REPORT_FORMAT_HTML",
+		2: "REPORT_FORMAT_PDF",
+	}
+	ReportFormat_value = map[string]int32{
+		"REPORT_FORMAT_UNSPECIFIED": 0,
+		"REPORT_FORMAT_HTML":        1,
+		"REPORT_FORMAT_PDF":         2,
+	}
+)
+
+func (x ReportFormat) Enum() *ReportFormat {
+	p := new(ReportFormat)
+	*p = x
+	return p
+}
+
+func (x ReportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[5].Descriptor()
+}
+
+func (ReportFormat) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[5]
+}
+
+func (x ReportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportFormat.Descriptor instead.
+func (ReportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+// Accounting journal messages
+type JournalFormat int32
+
+const (
+	JournalFormat_JOURNAL_FORMAT_UNSPECIFIED  JournalFormat = 0
+	JournalFormat_JOURNAL_FORMAT_FREEE        JournalFormat = 1
+	JournalFormat_JOURNAL_FORMAT_MONEYFORWARD JournalFormat = 2
+)
+
+// Enum value maps for JournalFormat.
+var (
+	JournalFormat_name = map[int32]string{
+		0: "JOURNAL_FORMAT_UNSPECIFIED",
+		1: "JOURNAL_FORMAT_FREEE",
+		2: "JOURNAL_FORMAT_MONEYFORWARD",
+	}
+	JournalFormat_value = map[string]int32{
+		"JOURNAL_FORMAT_UNSPECIFIED":  0,
+		"JOURNAL_FORMAT_FREEE":        1,
+		"JOURNAL_FORMAT_MONEYFORWARD": 2,
+	}
+)
+
+func (x JournalFormat) Enum() *JournalFormat {
+	p := new(JournalFormat)
+	*p = x
+	return p
+}
+
+func (x JournalFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JournalFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[6].Descriptor()
+}
+
+func (JournalFormat) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[6]
+}
+
+func (x JournalFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JournalFormat.Descriptor instead.
+func (JournalFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+// Bank transfer messages
+type BankAccountType int32
+
+const (
+	BankAccountType_BANK_ACCOUNT_TYPE_UNSPECIFIED BankAccountType = 0
+	BankAccountType_BANK_ACCOUNT_TYPE_ORDINARY    BankAccountType = 1 // 普通
+	BankAccountType_BANK_ACCOUNT_TYPE_CHECKING    BankAccountType = 2 // 当座
+	BankAccountType_BANK_ACCOUNT_TYPE_SAVINGS     BankAccountType = 4 // 貯蓄
+)
+
+// Enum value maps for BankAccountType.
+var (
+	BankAccountType_name = map[int32]string{
+		0: "BANK_ACCOUNT_TYPE_UNSPECIFIED",
+		1: "BANK_ACCOUNT_TYPE_ORDINARY",
+		2: "BANK_ACCOUNT_TYPE_CHECKING",
+		4: "BANK_ACCOUNT_TYPE_SAVINGS",
+	}
+	BankAccountType_value = map[string]int32{
+		"BANK_ACCOUNT_TYPE_UNSPECIFIED": 0,
+		"BANK_ACCOUNT_TYPE_ORDINARY":    1,
+		"BANK_ACCOUNT_TYPE_CHECKING":    2,
+		"BANK_ACCOUNT_TYPE_SAVINGS":     4,
+	}
+)
+
+func (x BankAccountType) Enum() *BankAccountType {
+	p := new(BankAccountType)
+	*p = x
+	return p
+}
+
+func (x BankAccountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BankAccountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[7].Descriptor()
+}
+
+func (BankAccountType) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[7]
+}
+
+func (x BankAccountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BankAccountType.Descriptor instead.
+func (BankAccountType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{7}
+}
+
+// How the amount of an expense is divided among its split members
+type SplitMode int32
+
+const (
+	SplitMode_SPLIT_MODE_UNSPECIFIED SplitMode = 0 // Treated as SPLIT_MODE_EQUAL
+	SplitMode_SPLIT_MODE_EQUAL       SplitMode = 1
+	SplitMode_SPLIT_MODE_SHARES      SplitMode = 2 // In proportion to each member's shares, e.g. 2:1:1
+)
+
+// Enum value maps for SplitMode.
+var (
+	SplitMode_name = map[int32]string{
+		0: "SPLIT_MODE_UNSPECIFIED",
+		1: "SPLIT_MODE_EQUAL",
+		2: "SPLIT_MODE_SHARES",
+	}
+	SplitMode_value = map[string]int32{
+		"SPLIT_MODE_UNSPECIFIED": 0,
+		"SPLIT_MODE_EQUAL":       1,
+		"SPLIT_MODE_SHARES":      2,
+	}
+)
+
+func (x SplitMode) Enum() *SplitMode {
+	p := new(SplitMode)
+	*p = x
+	return p
+}
+
+func (x SplitMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SplitMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[8].Descriptor()
+}
+
+func (SplitMode) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[8]
+}
+
+func (x SplitMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SplitMode.Descriptor instead.
+func (SplitMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{8}
+}
+
+type BudgetPeriod int32
+
+const (
+	BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED BudgetPeriod = 0 // Treated as BUDGET_PERIOD_TOTAL
+	BudgetPeriod_BUDGET_PERIOD_TOTAL       BudgetPeriod = 1 // Everything the group ever spent, e.g. on a trip
+	BudgetPeriod_BUDGET_PERIOD_MONTHLY     BudgetPeriod = 2
+	BudgetPeriod_BUDGET_PERIOD_WEEKLY      BudgetPeriod = 3 // Weeks start on Monday
+)
+
+// Enum value maps for BudgetPeriod.
+var (
+	BudgetPeriod_name = map[int32]string{
+		0: "BUDGET_PERIOD_UNSPECIFIED",
+		1: "BUDGET_PERIOD_TOTAL",
+		2: "BUDGET_PERIOD_MONTHLY",
+		3: "BUDGET_PERIOD_WEEKLY",
+	}
+	BudgetPeriod_value = map[string]int32{
+		"BUDGET_PERIOD_UNSPECIFIED": 0,
+		"BUDGET_PERIOD_TOTAL":       1,
+		"BUDGET_PERIOD_MONTHLY":     2,
+		"BUDGET_PERIOD_WEEKLY":      3,
+	}
+)
+
+func (x BudgetPeriod) Enum() *BudgetPeriod {
+	p := new(BudgetPeriod)
+	*p = x
+	return p
+}
+
+func (x BudgetPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BudgetPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[9].Descriptor()
+}
+
+func (BudgetPeriod) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[9]
+}
+
+func (x BudgetPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BudgetPeriod.Descriptor instead.
+func (BudgetPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{9}
+}
+
+// Only approved expenses count towards balances and settlements
+type ApprovalStatus int32
+
+const (
+	ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED ApprovalStatus = 0 // Treated as APPROVAL_STATUS_APPROVED
+	ApprovalStatus_APPROVAL_STATUS_APPROVED    ApprovalStatus = 1 // Every split member approved, or no approval was needed
+	ApprovalStatus_APPROVAL_STATUS_PENDING     ApprovalStatus = 2 // Waiting for split members to approve
+	ApprovalStatus_APPROVAL_STATUS_DISPUTED    ApprovalStatus = 3 // A split member disputed it
+)
+
+// Enum value maps for ApprovalStatus.
+var (
+	ApprovalStatus_name = map[int32]string{
+		0: "APPROVAL_STATUS_UNSPECIFIED",
+		1: "APPROVAL_STATUS_APPROVED",
+		2: "APPROVAL_STATUS_PENDING",
+		3: "APPROVAL_STATUS_DISPUTED",
+	}
+	ApprovalStatus_value = map[string]int32{
+		"APPROVAL_STATUS_UNSPECIFIED": 0,
+		"APPROVAL_STATUS_APPROVED":    1,
+		"APPROVAL_STATUS_PENDING":     2,
+		"APPROVAL_STATUS_DISPUTED":    3,
+	}
+)
+
+func (x ApprovalStatus) Enum() *ApprovalStatus {
+	p := new(ApprovalStatus)
+	*p = x
+	return p
+}
+
+func (x ApprovalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[10].Descriptor()
+}
+
+func (ApprovalStatus) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[10]
+}
+
+func (x ApprovalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalStatus.Descriptor instead.
+func (ApprovalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{10}
+}
+
+type ApprovalVote int32
+
+const (
+	ApprovalVote_APPROVAL_VOTE_UNSPECIFIED ApprovalVote = 0
+	ApprovalVote_APPROVAL_VOTE_APPROVE     ApprovalVote = 1
+	ApprovalVote_APPROVAL_VOTE_DISPUTE     ApprovalVote = 2
+)
+
+// Enum value maps for ApprovalVote.
+var (
+	ApprovalVote_name = map[int32]string{
+		0: "APPROVAL_VOTE_UNSPECIFIED",
+		1: "APPROVAL_VOTE_APPROVE",
+		2: "APPROVAL_VOTE_DISPUTE",
+	}
+	ApprovalVote_value = map[string]int32{
+		"APPROVAL_VOTE_UNSPECIFIED": 0,
+		"APPROVAL_VOTE_APPROVE":     1,
+		"APPROVAL_VOTE_DISPUTE":     2,
+	}
+)
+
+func (x ApprovalVote) Enum() *ApprovalVote {
+	p := new(ApprovalVote)
+	*p = x
+	return p
+}
+
+func (x ApprovalVote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalVote) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_group_v1_group_proto_enumTypes[11].Descriptor()
+}
+
+func (ApprovalVote) Type() protoreflect.EnumType {
+	return &file_proto_group_v1_group_proto_enumTypes[11]
+}
+
+func (x ApprovalVote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalVote.Descriptor instead.
+func (ApprovalVote) EnumDescriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{11}
+}
+
 type Group struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Members       []*Member              `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Members       []*Member              `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`          // Unset when the member takes part from the start
+	ActiveUntil   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"`       // Unset while the member keeps taking part
+	Color         string                 `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`                                      // Display color as #RRGGBB; empty when unset
+	PaymentHandle string                 `protobuf:"bytes,8,opt,name=payment_handle,json=paymentHandle,proto3" json:"payment_handle,omitempty"` // e.g. a PayPay ID, shown when settling up
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Member) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Member) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Member) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *Member) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *Member) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+func (x *Member) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Member) GetPaymentHandle() string {
+	if x != nil {
+		return x.PaymentHandle
+	}
+	return ""
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	MemberNames   []string               `protobuf:"bytes,4,rep,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetMemberNames() []string {
+	if x != nil {
+		return x.MemberNames
+	}
+	return nil
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type UpdateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteGroupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	MemberEmail   string                 `protobuf:"bytes,3,opt,name=member_email,json=memberEmail,proto3" json:"member_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberRequest) Reset() {
+	*x = AddMemberRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberRequest) ProtoMessage() {}
+
+func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddMemberRequest) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *AddMemberRequest) GetMemberEmail() string {
+	if x != nil {
+		return x.MemberEmail
+	}
+	return ""
+}
+
+type AddMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberResponse) Reset() {
+	*x = AddMemberResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberResponse) ProtoMessage() {}
+
+func (x *AddMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// Removed members are archived: their expenses keep their name and
+// balances are unchanged
+type RemoveMemberRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	GroupId  string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	// Required while the member has a non-zero balance; the amounts hand the
+	// whole balance over to the listed members
+	Redistribution []*BalanceTransfer `protobuf:"bytes,3,rep,name=redistribution,proto3" json:"redistribution,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetRedistribution() []*BalanceTransfer {
+	if x != nil {
+		return x.Redistribution
+	}
+	return nil
+}
+
+type BalanceTransfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Positive part of the removed member's balance
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceTransfer) Reset() {
+	*x = BalanceTransfer{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceTransfer) ProtoMessage() {}
+
+func (x *BalanceTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceTransfer.ProtoReflect.Descriptor instead.
+func (*BalanceTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BalanceTransfer) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *BalanceTransfer) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Transfers     []*ExpenseWithDetails  `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"` // Payments recording the redistribution
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveMemberResponse) GetTransfers() []*ExpenseWithDetails {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+// Empty email, color and payment handle clear them
+type UpdateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Color         string                 `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	PaymentHandle string                 `protobuf:"bytes,6,opt,name=payment_handle,json=paymentHandle,proto3" json:"payment_handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateMemberRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetPaymentHandle() string {
+	if x != nil {
+		return x.PaymentHandle
+	}
+	return ""
+}
+
+type UpdateMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberResponse) Reset() {
+	*x = UpdateMemberResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberResponse) ProtoMessage() {}
+
+func (x *UpdateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateMemberResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// Expense messages
+type AddExpenseRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GroupId           string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Amount            int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PaidById          string                 `protobuf:"bytes,4,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`                              // Member ID who paid
+	SplitMemberIds    []string               `protobuf:"bytes,5,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"`            // Member IDs to split among
+	Category          string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                                // Optional, e.g. "食費"
+	Kind              ExpenseKind            `protobuf:"varint,7,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`                             // Defaults to an expense; for income paid_by_id is the member who received the money
+	SplitAmounts      []int64                `protobuf:"varint,8,rep,packed,name=split_amounts,json=splitAmounts,proto3" json:"split_amounts,omitempty"`            // Optional exact share of each split member in split_member_ids order; split equally when empty
+	AllowDuplicate    bool                   `protobuf:"varint,9,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"`             // Save even when the expense looks like one already recorded
+	SplitAmongPresent bool                   `protobuf:"varint,10,opt,name=split_among_present,json=splitAmongPresent,proto3" json:"split_among_present,omitempty"` // Split equally among the members active on the expense date; split_member_ids and split_amounts must be empty
+	SplitByHousehold  bool                   `protobuf:"varint,11,opt,name=split_by_household,json=splitByHousehold,proto3" json:"split_by_household,omitempty"`    // Split equally per household of the split members, then equally within each; split_amounts must be empty
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddExpenseRequest) Reset() {
+	*x = AddExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseRequest) ProtoMessage() {}
+
+func (x *AddExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddExpenseRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddExpenseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetSplitMemberIds() []string {
+	if x != nil {
+		return x.SplitMemberIds
+	}
+	return nil
+}
+
+func (x *AddExpenseRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AddExpenseRequest) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *AddExpenseRequest) GetSplitAmounts() []int64 {
+	if x != nil {
+		return x.SplitAmounts
+	}
+	return nil
+}
+
+func (x *AddExpenseRequest) GetAllowDuplicate() bool {
+	if x != nil {
+		return x.AllowDuplicate
+	}
+	return false
+}
+
+func (x *AddExpenseRequest) GetSplitAmongPresent() bool {
+	if x != nil {
+		return x.SplitAmongPresent
+	}
+	return false
+}
+
+func (x *AddExpenseRequest) GetSplitByHousehold() bool {
+	if x != nil {
+		return x.SplitByHousehold
+	}
+	return false
+}
+
+type AddExpenseResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Expense            *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`                                                 // Unset when the expense was not saved as a probable duplicate
+	BudgetAlerts       []*BudgetAlert         `protobuf:"bytes,2,rep,name=budget_alerts,json=budgetAlerts,proto3" json:"budget_alerts,omitempty"`                   // Budget thresholds the expense crossed
+	ProbableDuplicates []*ExpenseWithDetails  `protobuf:"bytes,3,rep,name=probable_duplicates,json=probableDuplicates,proto3" json:"probable_duplicates,omitempty"` // Recorded expenses it looks like; without allow_duplicate the expense is not saved
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddExpenseResponse) Reset() {
+	*x = AddExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseResponse) ProtoMessage() {}
+
+func (x *AddExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseResponse.ProtoReflect.Descriptor instead.
+func (*AddExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddExpenseResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *AddExpenseResponse) GetBudgetAlerts() []*BudgetAlert {
+	if x != nil {
+		return x.BudgetAlerts
+	}
+	return nil
+}
+
+func (x *AddExpenseResponse) GetProbableDuplicates() []*ExpenseWithDetails {
+	if x != nil {
+		return x.ProbableDuplicates
+	}
+	return nil
+}
+
+// Every entry is validated before anything is saved. When any entry is
+// invalid nothing is saved and errors lists the problems of every invalid
+// entry; otherwise all expenses are saved in one transaction.
+type AddExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Expenses      []*AddExpenseRequest   `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"` // group_id of the entries is ignored, at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpensesRequest) Reset() {
+	*x = AddExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpensesRequest) ProtoMessage() {}
+
+func (x *AddExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpensesRequest.ProtoReflect.Descriptor instead.
+func (*AddExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddExpensesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddExpensesRequest) GetExpenses() []*AddExpenseRequest {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type AddExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*ExpenseWithDetails  `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"` // In request order, empty when errors is set
+	Errors        []*ExpenseError        `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpensesResponse) Reset() {
+	*x = AddExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpensesResponse) ProtoMessage() {}
+
+func (x *AddExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpensesResponse.ProtoReflect.Descriptor instead.
+func (*AddExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddExpensesResponse) GetExpenses() []*ExpenseWithDetails {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *AddExpensesResponse) GetErrors() []*ExpenseError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExpenseError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // Position in AddExpensesRequest.expenses
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`  // Empty when the error is not about a single field
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseError) Reset() {
+	*x = ExpenseError{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseError) ProtoMessage() {}
+
+func (x *ExpenseError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseError.ProtoReflect.Descriptor instead.
+func (*ExpenseError) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExpenseError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ExpenseError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ExpenseError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateExpenseRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId         string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Amount            int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PaidById          string                 `protobuf:"bytes,4,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`                             // Member ID who paid
+	SplitMemberIds    []string               `protobuf:"bytes,5,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"`           // Member IDs to split among
+	Category          string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                               // Optional, e.g. "食費"
+	Kind              ExpenseKind            `protobuf:"varint,7,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`                            // Defaults to an expense; for income paid_by_id is the member who received the money
+	UpdatedBy         string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`                            // Optional member ID, recorded in the history
+	SplitAmongPresent bool                   `protobuf:"varint,9,opt,name=split_among_present,json=splitAmongPresent,proto3" json:"split_among_present,omitempty"` // Split equally among the members active on the expense date; split_member_ids must be empty
+	SplitByHousehold  bool                   `protobuf:"varint,10,opt,name=split_by_household,json=splitByHousehold,proto3" json:"split_by_household,omitempty"`   // Split equally per household of the split members, then equally within each
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseRequest) Reset() {
+	*x = UpdateExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateExpenseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetSplitMemberIds() []string {
+	if x != nil {
+		return x.SplitMemberIds
+	}
+	return nil
+}
+
+func (x *UpdateExpenseRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *UpdateExpenseRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdateExpenseRequest) GetSplitAmongPresent() bool {
+	if x != nil {
+		return x.SplitAmongPresent
+	}
+	return false
+}
+
+func (x *UpdateExpenseRequest) GetSplitByHousehold() bool {
+	if x != nil {
+		return x.SplitByHousehold
+	}
+	return false
+}
+
+type UpdateExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	BudgetAlerts  []*BudgetAlert         `protobuf:"bytes,2,rep,name=budget_alerts,json=budgetAlerts,proto3" json:"budget_alerts,omitempty"` // Budget thresholds the expense crossed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseResponse) Reset() {
+	*x = UpdateExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseResponse) ProtoMessage() {}
+
+func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateExpenseResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *UpdateExpenseResponse) GetBudgetAlerts() []*BudgetAlert {
+	if x != nil {
+		return x.BudgetAlerts
+	}
+	return nil
+}
+
+// Deleting moves the expense into the trash; see ListDeletedExpenses.
+type DeleteExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"` // Optional member ID, shown in the trash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseRequest) Reset() {
+	*x = DeleteExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *DeleteExpenseRequest) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type DeleteExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseResponse) Reset() {
+	*x = DeleteExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteExpenseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetGroupExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Filter        *ExpenseFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy        ExpenseSortField       `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=group.v1.ExpenseSortField" json:"sort_by,omitempty"`                   // Defaults to created_at
+	SortDirection SortDirection          `protobuf:"varint,4,opt,name=sort_direction,json=sortDirection,proto3,enum=group.v1.SortDirection" json:"sort_direction,omitempty"` // Defaults to descending
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                            // 0 returns every matching expense
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                          // next_page_token or one of the cursors of a previous response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupExpensesRequest) Reset() {
+	*x = GetGroupExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupExpensesRequest) ProtoMessage() {}
+
+func (x *GetGroupExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupExpensesRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetGroupExpensesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupExpensesRequest) GetFilter() *ExpenseFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetGroupExpensesRequest) GetSortBy() ExpenseSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return ExpenseSortField_EXPENSE_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetGroupExpensesRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetGroupExpensesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetGroupExpensesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetGroupExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*ExpenseWithDetails  `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	Cursors       []string               `protobuf:"bytes,3,rep,name=cursors,proto3" json:"cursors,omitempty"`                                    // Opaque cursor for each expense, usable as page_token
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupExpensesResponse) Reset() {
+	*x = GetGroupExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupExpensesResponse) ProtoMessage() {}
+
+func (x *GetGroupExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupExpensesResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetGroupExpensesResponse) GetExpenses() []*ExpenseWithDetails {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *GetGroupExpensesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetGroupExpensesResponse) GetCursors() []string {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+// Every set field narrows the result; unset fields are ignored.
+type ExpenseFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // Inclusive
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // Exclusive
+	PayerId       string                 `protobuf:"bytes,3,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	ParticipantId string                 `protobuf:"bytes,4,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Member included in the split
+	MinAmount     int64                  `protobuf:"varint,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`            // 0 = no lower bound
+	MaxAmount     int64                  `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`            // 0 = no upper bound
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Text          string                 `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"` // Case-insensitive match on the description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseFilter) Reset() {
+	*x = ExpenseFilter{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseFilter) ProtoMessage() {}
+
+func (x *ExpenseFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseFilter.ProtoReflect.Descriptor instead.
+func (*ExpenseFilter) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExpenseFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ExpenseFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ExpenseFilter) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetParticipantId() string {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ExpenseFilter) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseFilter) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ExpenseWithDetails struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId        string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	PaidById       string                 `protobuf:"bytes,5,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	PaidByName     string                 `protobuf:"bytes,6,opt,name=paid_by_name,json=paidByName,proto3" json:"paid_by_name,omitempty"`
+	SplitMembers   []*SplitMember         `protobuf:"bytes,7,rep,name=split_members,json=splitMembers,proto3" json:"split_members,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Category       string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`
+	Kind           ExpenseKind            `protobuf:"varint,10,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	ApprovalStatus ApprovalStatus         `protobuf:"varint,11,opt,name=approval_status,json=approvalStatus,proto3,enum=group.v1.ApprovalStatus" json:"approval_status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExpenseWithDetails) Reset() {
+	*x = ExpenseWithDetails{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseWithDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseWithDetails) ProtoMessage() {}
+
+func (x *ExpenseWithDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseWithDetails.ProtoReflect.Descriptor instead.
+func (*ExpenseWithDetails) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExpenseWithDetails) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseWithDetails) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetPaidByName() string {
+	if x != nil {
+		return x.PaidByName
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetSplitMembers() []*SplitMember {
+	if x != nil {
+		return x.SplitMembers
+	}
+	return nil
+}
+
+func (x *ExpenseWithDetails) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseWithDetails) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseWithDetails) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *ExpenseWithDetails) GetApprovalStatus() ApprovalStatus {
+	if x != nil {
+		return x.ApprovalStatus
+	}
+	return ApprovalStatus_APPROVAL_STATUS_UNSPECIFIED
+}
+
+type SplitMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                        // Amount owed by this member in cents (JPY)
+	Vote          ApprovalVote           `protobuf:"varint,4,opt,name=vote,proto3,enum=group.v1.ApprovalVote" json:"vote,omitempty"` // Unspecified until the member reviews a pending expense
+	VotedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=voted_at,json=votedAt,proto3" json:"voted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitMember) Reset() {
+	*x = SplitMember{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitMember) ProtoMessage() {}
+
+func (x *SplitMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitMember.ProtoReflect.Descriptor instead.
+func (*SplitMember) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SplitMember) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SplitMember) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *SplitMember) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SplitMember) GetVote() ApprovalVote {
+	if x != nil {
+		return x.Vote
+	}
+	return ApprovalVote_APPROVAL_VOTE_UNSPECIFIED
+}
+
+func (x *SplitMember) GetVotedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VotedAt
+	}
+	return nil
+}
+
+// Settlement calculation messages
+type CalculateSettlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Expenses      []*Expense             `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	ByHousehold   bool                   `protobuf:"varint,3,opt,name=by_household,json=byHousehold,proto3" json:"by_household,omitempty"` // Settle between households instead of between members
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateSettlementsRequest) Reset() {
+	*x = CalculateSettlementsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateSettlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateSettlementsRequest) ProtoMessage() {}
+
+func (x *CalculateSettlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateSettlementsRequest.ProtoReflect.Descriptor instead.
+func (*CalculateSettlementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CalculateSettlementsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CalculateSettlementsRequest) GetExpenses() []*Expense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *CalculateSettlementsRequest) GetByHousehold() bool {
+	if x != nil {
+		return x.ByHousehold
+	}
+	return false
+}
+
+type CalculateSettlementsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Settlements       []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	Balances          []*MemberBalance       `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	HouseholdBalances []*HouseholdBalance    `protobuf:"bytes,3,rep,name=household_balances,json=householdBalances,proto3" json:"household_balances,omitempty"` // Only when settling by household
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CalculateSettlementsResponse) Reset() {
+	*x = CalculateSettlementsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateSettlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateSettlementsResponse) ProtoMessage() {}
+
+func (x *CalculateSettlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateSettlementsResponse.ProtoReflect.Descriptor instead.
+func (*CalculateSettlementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CalculateSettlementsResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+func (x *CalculateSettlementsResponse) GetBalances() []*MemberBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *CalculateSettlementsResponse) GetHouseholdBalances() []*HouseholdBalance {
+	if x != nil {
+		return x.HouseholdBalances
+	}
+	return nil
+}
+
+type Expense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PayerId       string                 `protobuf:"bytes,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SplitBetween  []string               `protobuf:"bytes,5,rep,name=split_between,json=splitBetween,proto3" json:"split_between,omitempty"` // Member IDs
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind          ExpenseKind            `protobuf:"varint,7,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	SplitAmounts  []int64                `protobuf:"varint,8,rep,packed,name=split_amounts,json=splitAmounts,proto3" json:"split_amounts,omitempty"` // Share of each split_between member in order; split equally when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Expense) Reset() {
+	*x = Expense{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Expense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expense) ProtoMessage() {}
+
+func (x *Expense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
+func (*Expense) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Expense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Expense) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *Expense) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Expense) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Expense) GetSplitBetween() []string {
+	if x != nil {
+		return x.SplitBetween
+	}
+	return nil
+}
+
+func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Expense) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *Expense) GetSplitAmounts() []int64 {
+	if x != nil {
+		return x.SplitAmounts
+	}
+	return nil
+}
+
+type Settlement struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FromMemberId string                 `protobuf:"bytes,1,opt,name=from_member_id,json=fromMemberId,proto3" json:"from_member_id,omitempty"`
+	ToMemberId   string                 `protobuf:"bytes,2,opt,name=to_member_id,json=toMemberId,proto3" json:"to_member_id,omitempty"`
+	Amount       int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
+	FromName     string                 `protobuf:"bytes,4,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	ToName       string                 `protobuf:"bytes,5,opt,name=to_name,json=toName,proto3" json:"to_name,omitempty"`
+	// Set when settling by household. The member IDs are then the household's
+	// first member, who pays or receives for it, and the names the household's.
+	FromHouseholdId string `protobuf:"bytes,6,opt,name=from_household_id,json=fromHouseholdId,proto3" json:"from_household_id,omitempty"`
+	ToHouseholdId   string `protobuf:"bytes,7,opt,name=to_household_id,json=toHouseholdId,proto3" json:"to_household_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Settlement) GetFromMemberId() string {
+	if x != nil {
+		return x.FromMemberId
+	}
+	return ""
+}
+
+func (x *Settlement) GetToMemberId() string {
+	if x != nil {
+		return x.ToMemberId
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Settlement) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *Settlement) GetToName() string {
+	if x != nil {
+		return x.ToName
+	}
+	return ""
+}
+
+func (x *Settlement) GetFromHouseholdId() string {
+	if x != nil {
+		return x.FromHouseholdId
+	}
+	return ""
+}
+
+func (x *Settlement) GetToHouseholdId() string {
+	if x != nil {
+		return x.ToHouseholdId
+	}
+	return ""
+}
+
+type MemberBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // Positive = owed money, Negative = owes money
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberBalance) Reset() {
+	*x = MemberBalance{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberBalance) ProtoMessage() {}
+
+func (x *MemberBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberBalance.ProtoReflect.Descriptor instead.
+func (*MemberBalance) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemberBalance) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *MemberBalance) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *MemberBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// Attachment messages
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AttachmentMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentMetadata) Reset() {
+	*x = AttachmentMetadata{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMetadata) ProtoMessage() {}
+
+func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AttachmentMetadata) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// The first message must carry metadata, every following message a chunk of the file.
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *AttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	Metadata *AttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListExpenseAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseAttachmentsRequest) Reset() {
+	*x = ListExpenseAttachmentsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListExpenseAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpenseAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListExpenseAttachmentsRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type ListExpenseAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseAttachmentsResponse) Reset() {
+	*x = ListExpenseAttachmentsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListExpenseAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpenseAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListExpenseAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// The first message carries the attachment metadata, every following message a chunk of the file.
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Attachment); ok {
+			return x.Attachment
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Comment messages
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,2,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,4,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *Comment) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *Comment) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddExpenseCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpenseCommentRequest) Reset() {
+	*x = AddExpenseCommentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseCommentRequest) ProtoMessage() {}
+
+func (x *AddExpenseCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddExpenseCommentRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *AddExpenseCommentRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *AddExpenseCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type AddExpenseCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpenseCommentResponse) Reset() {
+	*x = AddExpenseCommentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseCommentResponse) ProtoMessage() {}
+
+func (x *AddExpenseCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddExpenseCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AddExpenseCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// Only the member who wrote a comment may edit or delete it.
+type UpdateExpenseCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseCommentRequest) Reset() {
+	*x = UpdateExpenseCommentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseCommentRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateExpenseCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseCommentRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type UpdateExpenseCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseCommentResponse) Reset() {
+	*x = UpdateExpenseCommentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseCommentResponse) ProtoMessage() {}
+
+func (x *UpdateExpenseCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateExpenseCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteExpenseCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseCommentRequest) Reset() {
+	*x = DeleteExpenseCommentRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseCommentRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteExpenseCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *DeleteExpenseCommentRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type DeleteExpenseCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseCommentResponse) Reset() {
+	*x = DeleteExpenseCommentResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseCommentResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteExpenseCommentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListExpenseCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseCommentsRequest) Reset() {
+	*x = ListExpenseCommentsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseCommentsRequest) ProtoMessage() {}
+
+func (x *ListExpenseCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpenseCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListExpenseCommentsRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type ListExpenseCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseCommentsResponse) Reset() {
+	*x = ListExpenseCommentsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseCommentsResponse) ProtoMessage() {}
+
+func (x *ListExpenseCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpenseCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListExpenseCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// Search messages
+// Matches descriptions, comments and member names, allowing small typos.
+type SearchExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"` // 0 = 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchExpensesRequest) Reset() {
+	*x = SearchExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExpensesRequest) ProtoMessage() {}
+
+func (x *SearchExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExpensesRequest.ProtoReflect.Descriptor instead.
+func (*SearchExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchExpensesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SearchExpensesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchExpensesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ExpenseSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Most relevant first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchExpensesResponse) Reset() {
+	*x = SearchExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchExpensesResponse) ProtoMessage() {}
+
+func (x *SearchExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchExpensesResponse.ProtoReflect.Descriptor instead.
+func (*SearchExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchExpensesResponse) GetResults() []*ExpenseSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExpenseSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"` // 0..1, 1 = exact match
+	Highlights    []*SearchHighlight     `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseSearchResult) Reset() {
+	*x = ExpenseSearchResult{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseSearchResult) ProtoMessage() {}
+
+func (x *ExpenseSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseSearchResult.ProtoReflect.Descriptor instead.
+func (*ExpenseSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExpenseSearchResult) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *ExpenseSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ExpenseSearchResult) GetHighlights() []*SearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // "description", "comment" or "member"
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`   // The matching description, comment body or member name
+	Ranges        []*TextRange           `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	CommentId     string                 `protobuf:"bytes,4,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // Set when field is "comment"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHighlight) ProtoMessage() {}
+
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SearchHighlight) GetRanges() []*TextRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *SearchHighlight) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+// Offsets are in characters (runes), end exclusive.
+type TextRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextRange) Reset() {
+	*x = TextRange{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRange) ProtoMessage() {}
+
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *TextRange) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TextRange) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// Trash messages
+// Deleted expenses are excluded from listings and balances until they are
+// restored, and are purged for good after the retention period.
+type DeletedExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedById   string                 `protobuf:"bytes,3,opt,name=deleted_by_id,json=deletedById,proto3" json:"deleted_by_id,omitempty"` // Empty when unknown
+	DeletedByName string                 `protobuf:"bytes,4,opt,name=deleted_by_name,json=deletedByName,proto3" json:"deleted_by_name,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // Unset when purging is disabled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedExpense) Reset() {
+	*x = DeletedExpense{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedExpense) ProtoMessage() {}
+
+func (x *DeletedExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedExpense.ProtoReflect.Descriptor instead.
+func (*DeletedExpense) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeletedExpense) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *DeletedExpense) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *DeletedExpense) GetDeletedById() string {
+	if x != nil {
+		return x.DeletedById
+	}
+	return ""
+}
+
+func (x *DeletedExpense) GetDeletedByName() string {
+	if x != nil {
+		return x.DeletedByName
+	}
+	return ""
+}
+
+func (x *DeletedExpense) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+type ListDeletedExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedExpensesRequest) Reset() {
+	*x = ListDeletedExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedExpensesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedExpensesRequest) ProtoMessage() {}
+
+func (x *ListDeletedExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListDeletedExpensesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListDeletedExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expenses      []*DeletedExpense      `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"` // Most recently deleted first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedExpensesResponse) Reset() {
+	*x = ListDeletedExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedExpensesResponse) ProtoMessage() {}
+
+func (x *ListDeletedExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListDeletedExpensesResponse) GetExpenses() []*DeletedExpense {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type RestoreExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExpenseRequest) Reset() {
+	*x = RestoreExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExpenseRequest) ProtoMessage() {}
+
+func (x *RestoreExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExpenseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RestoreExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type RestoreExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreExpenseResponse) Reset() {
+	*x = RestoreExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreExpenseResponse) ProtoMessage() {}
+
+func (x *RestoreExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreExpenseResponse.ProtoReflect.Descriptor instead.
+func (*RestoreExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RestoreExpenseResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+// Revision messages
+// Every create, update and revert of an expense stores a revision.
+type ExpenseRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"` // Starts at 1
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`     // Amount in cents (JPY)
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Kind          ExpenseKind            `protobuf:"varint,5,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	PaidById      string                 `protobuf:"bytes,6,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	PaidByName    string                 `protobuf:"bytes,7,opt,name=paid_by_name,json=paidByName,proto3" json:"paid_by_name,omitempty"`
+	SplitMembers  []*SplitMember         `protobuf:"bytes,8,rep,name=split_members,json=splitMembers,proto3" json:"split_members,omitempty"`
+	ChangedById   string                 `protobuf:"bytes,9,opt,name=changed_by_id,json=changedById,proto3" json:"changed_by_id,omitempty"` // Empty when unknown
+	ChangedByName string                 `protobuf:"bytes,10,opt,name=changed_by_name,json=changedByName,proto3" json:"changed_by_name,omitempty"`
+	RevertedFrom  int32                  `protobuf:"varint,11,opt,name=reverted_from,json=revertedFrom,proto3" json:"reverted_from,omitempty"` // Set when this revision restored an earlier one
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,13,rep,name=changes,proto3" json:"changes,omitempty"` // Compared with the previous revision, empty for the first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseRevision) Reset() {
+	*x = ExpenseRevision{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseRevision) ProtoMessage() {}
+
+func (x *ExpenseRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseRevision.ProtoReflect.Descriptor instead.
+func (*ExpenseRevision) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ExpenseRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *ExpenseRevision) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetPaidByName() string {
+	if x != nil {
+		return x.PaidByName
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetSplitMembers() []*SplitMember {
+	if x != nil {
+		return x.SplitMembers
+	}
+	return nil
+}
+
+func (x *ExpenseRevision) GetChangedById() string {
+	if x != nil {
+		return x.ChangedById
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetChangedByName() string {
+	if x != nil {
+		return x.ChangedByName
+	}
+	return ""
+}
+
+func (x *ExpenseRevision) GetRevertedFrom() int32 {
+	if x != nil {
+		return x.RevertedFrom
+	}
+	return 0
+}
+
+func (x *ExpenseRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseRevision) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // "amount", "description", "category", "kind", "paid_by" or "split"
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // Empty when a split member was added
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // Empty when a split member was removed
+	MemberId      string                 `protobuf:"bytes,4,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"` // Set when field is "split"
+	MemberName    string                 `protobuf:"bytes,5,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *FieldChange) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+type GetExpenseHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseHistoryRequest) Reset() {
+	*x = GetExpenseHistoryRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseHistoryRequest) ProtoMessage() {}
+
+func (x *GetExpenseHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetExpenseHistoryRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type GetExpenseHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ExpenseRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseHistoryResponse) Reset() {
+	*x = GetExpenseHistoryResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseHistoryResponse) ProtoMessage() {}
+
+func (x *GetExpenseHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetExpenseHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetExpenseHistoryResponse) GetRevisions() []*ExpenseRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Reverting saves the chosen revision as a new one, so it can be undone too.
+type RevertExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	RevertedBy    string                 `protobuf:"bytes,3,opt,name=reverted_by,json=revertedBy,proto3" json:"reverted_by,omitempty"` // Optional member ID, recorded in the history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertExpenseRequest) Reset() {
+	*x = RevertExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertExpenseRequest) ProtoMessage() {}
+
+func (x *RevertExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertExpenseRequest.ProtoReflect.Descriptor instead.
+func (*RevertExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RevertExpenseRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *RevertExpenseRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RevertExpenseRequest) GetRevertedBy() string {
+	if x != nil {
+		return x.RevertedBy
+	}
+	return ""
+}
+
+type RevertExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertExpenseResponse) Reset() {
+	*x = RevertExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertExpenseResponse) ProtoMessage() {}
+
+func (x *RevertExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertExpenseResponse.ProtoReflect.Descriptor instead.
+func (*RevertExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RevertExpenseResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+// CSV import messages
+// Names in the file are matched to group members ignoring case and
+// full-width/half-width differences. Rows are only saved when every row is
+// valid, all in one transaction.
+type ImportExpensesCsvRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	GroupId              string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Csv                  []byte                 `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`                                                               // UTF-8 with a header row, at most 1MB and 1000 rows
+	Mapping              *CsvColumnMapping      `protobuf:"bytes,3,opt,name=mapping,proto3" json:"mapping,omitempty"`                                                       // Unset columns use the default header names
+	ParticipantSeparator string                 `protobuf:"bytes,4,opt,name=participant_separator,json=participantSeparator,proto3" json:"participant_separator,omitempty"` // Separates names in the participants column, defaults to ";"
+	DryRun               bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                          // Report what would be imported without saving
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ImportExpensesCsvRequest) Reset() {
+	*x = ImportExpensesCsvRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExpensesCsvRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExpensesCsvRequest) ProtoMessage() {}
+
+func (x *ImportExpensesCsvRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExpensesCsvRequest.ProtoReflect.Descriptor instead.
+func (*ImportExpensesCsvRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ImportExpensesCsvRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ImportExpensesCsvRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportExpensesCsvRequest) GetMapping() *CsvColumnMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *ImportExpensesCsvRequest) GetParticipantSeparator() string {
+	if x != nil {
+		return x.ParticipantSeparator
+	}
+	return ""
+}
+
+func (x *ImportExpensesCsvRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Header names of the columns to read.
+type CsvColumnMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`               // Default "amount"; "1,200", "¥1200" and "1200円" are accepted
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`     // Default "description"
+	PaidBy        string                 `protobuf:"bytes,3,opt,name=paid_by,json=paidBy,proto3" json:"paid_by,omitempty"` // Default "paid_by", a member name
+	Participants  string                 `protobuf:"bytes,4,opt,name=participants,proto3" json:"participants,omitempty"`   // Default "participants"; empty splits among all members
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`           // Default "category", optional column
+	Date          string                 `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                   // Default "date", optional column as YYYY-MM-DD or YYYY/MM/DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvColumnMapping) Reset() {
+	*x = CsvColumnMapping{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvColumnMapping) ProtoMessage() {}
+
+func (x *CsvColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvColumnMapping.ProtoReflect.Descriptor instead.
+func (*CsvColumnMapping) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CsvColumnMapping) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetPaidBy() string {
+	if x != nil {
+		return x.PaidBy
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetParticipants() string {
+	if x != nil {
+		return x.Participants
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ImportExpensesCsvResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows      int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"` // Data rows read, excluding the header and blank lines
+	Expenses       []*ExpenseWithDetails  `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`                     // Valid rows, in file order
+	Errors         []*CsvRowError         `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	UnmatchedNames []string               `protobuf:"bytes,4,rep,name=unmatched_names,json=unmatchedNames,proto3" json:"unmatched_names,omitempty"` // Names not found in the group, as written in the file
+	Committed      bool                   `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`                                // True when the rows were saved
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportExpensesCsvResponse) Reset() {
+	*x = ImportExpensesCsvResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportExpensesCsvResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportExpensesCsvResponse) ProtoMessage() {}
+
+func (x *ImportExpensesCsvResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportExpensesCsvResponse.ProtoReflect.Descriptor instead.
+func (*ImportExpensesCsvResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportExpensesCsvResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportExpensesCsvResponse) GetExpenses() []*ExpenseWithDetails {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *ImportExpensesCsvResponse) GetErrors() []*CsvRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportExpensesCsvResponse) GetUnmatchedNames() []string {
+	if x != nil {
+		return x.UnmatchedNames
+	}
+	return nil
+}
+
+func (x *ImportExpensesCsvResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type CsvRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`    // Line number in the file, the header is line 1
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"` // Header name of the offending column, empty for the whole row
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CsvRowError) Reset() {
+	*x = CsvRowError{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvRowError) ProtoMessage() {}
+
+func (x *CsvRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvRowError.ProtoReflect.Descriptor instead.
+func (*CsvRowError) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CsvRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *CsvRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *CsvRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Splitwise import messages
+// Reads Splitwise's CSV group export: Date, Description, Category, Cost,
+// Currency and one column per member with that member's net balance change.
+// Each member column becomes a member of a new group.
+type ImportSplitwiseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupName     string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Csv           []byte                 `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`                      // The export file, at most 1MB and 1000 rows
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report what would be imported without creating the group
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSplitwiseRequest) Reset() {
+	*x = ImportSplitwiseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSplitwiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSplitwiseRequest) ProtoMessage() {}
+
+func (x *ImportSplitwiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSplitwiseRequest.ProtoReflect.Descriptor instead.
+func (*ImportSplitwiseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImportSplitwiseRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ImportSplitwiseRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ImportSplitwiseRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportSplitwiseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`                           // Unset on a dry run or when a row is invalid
+	TotalRows     int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"` // Data rows read, excluding the header, blank lines and the total balance row
+	Expenses      []*ExpenseWithDetails  `protobuf:"bytes,3,rep,name=expenses,proto3" json:"expenses,omitempty"`                     // Imported expenses and payments, in file order
+	Errors        []*CsvRowError         `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	SkippedLines  []int32                `protobuf:"varint,5,rep,packed,name=skipped_lines,json=skippedLines,proto3" json:"skipped_lines,omitempty"` // Rows that do not change any balance, e.g. a member paying for themselves
+	Balances      []*SplitwiseBalance    `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty"`
+	BalancesMatch bool                   `protobuf:"varint,7,opt,name=balances_match,json=balancesMatch,proto3" json:"balances_match,omitempty"` // True when every imported balance equals Splitwise's total balance
+	Committed     bool                   `protobuf:"varint,8,opt,name=committed,proto3" json:"committed,omitempty"`                              // True when the group and its expenses were saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSplitwiseResponse) Reset() {
+	*x = ImportSplitwiseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSplitwiseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSplitwiseResponse) ProtoMessage() {}
+
+func (x *ImportSplitwiseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSplitwiseResponse.ProtoReflect.Descriptor instead.
+func (*ImportSplitwiseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImportSplitwiseResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportSplitwiseResponse) GetExpenses() []*ExpenseWithDetails {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseResponse) GetErrors() []*CsvRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseResponse) GetSkippedLines() []int32 {
+	if x != nil {
+		return x.SkippedLines
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseResponse) GetBalances() []*SplitwiseBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *ImportSplitwiseResponse) GetBalancesMatch() bool {
+	if x != nil {
+		return x.BalancesMatch
+	}
+	return false
+}
+
+func (x *ImportSplitwiseResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type SplitwiseBalance struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MemberId         string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName       string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	ImportedBalance  int64                  `protobuf:"varint,3,opt,name=imported_balance,json=importedBalance,proto3" json:"imported_balance,omitempty"`    // Calculated from the imported expenses
+	SplitwiseBalance int64                  `protobuf:"varint,4,opt,name=splitwise_balance,json=splitwiseBalance,proto3" json:"splitwise_balance,omitempty"` // From the export's total balance row
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SplitwiseBalance) Reset() {
+	*x = SplitwiseBalance{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitwiseBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitwiseBalance) ProtoMessage() {}
+
+func (x *SplitwiseBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitwiseBalance.ProtoReflect.Descriptor instead.
+func (*SplitwiseBalance) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SplitwiseBalance) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SplitwiseBalance) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *SplitwiseBalance) GetImportedBalance() int64 {
+	if x != nil {
+		return x.ImportedBalance
+	}
+	return 0
+}
+
+func (x *SplitwiseBalance) GetSplitwiseBalance() int64 {
+	if x != nil {
+		return x.SplitwiseBalance
+	}
+	return 0
+}
+
+type ExportGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=group.v1.ExportFormat" json:"format,omitempty"`
+	CsvLayout     ExportCsvLayout        `protobuf:"varint,3,opt,name=csv_layout,json=csvLayout,proto3,enum=group.v1.ExportCsvLayout" json:"csv_layout,omitempty"` // Ignored for JSON
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGroupRequest) Reset() {
+	*x = ExportGroupRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGroupRequest) ProtoMessage() {}
+
+func (x *ExportGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGroupRequest.ProtoReflect.Descriptor instead.
+func (*ExportGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ExportGroupRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExportGroupRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportGroupRequest) GetCsvLayout() ExportCsvLayout {
+	if x != nil {
+		return x.CsvLayout
+	}
+	return ExportCsvLayout_EXPORT_CSV_LAYOUT_UNSPECIFIED
+}
+
+// The first message carries the file metadata, every following message a chunk of the file.
+type ExportGroupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ExportGroupResponse_Metadata
+	//	*ExportGroupResponse_Chunk
+	Data          isExportGroupResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGroupResponse) Reset() {
+	*x = ExportGroupResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGroupResponse) ProtoMessage() {}
+
+func (x *ExportGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGroupResponse.ProtoReflect.Descriptor instead.
+func (*ExportGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExportGroupResponse) GetData() isExportGroupResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportGroupResponse) GetMetadata() *ExportMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*ExportGroupResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *ExportGroupResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*ExportGroupResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isExportGroupResponse_Data interface {
+	isExportGroupResponse_Data()
+}
+
+type ExportGroupResponse_Metadata struct {
+	Metadata *ExportMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type ExportGroupResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ExportGroupResponse_Metadata) isExportGroupResponse_Data() {}
+
+func (*ExportGroupResponse_Chunk) isExportGroupResponse_Data() {}
+
+type ExportMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMetadata) Reset() {
+	*x = ExportMetadata{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMetadata) ProtoMessage() {}
+
+func (x *ExportMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMetadata.ProtoReflect.Descriptor instead.
+func (*ExportMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExportMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type RenderSettlementReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Format        ReportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=group.v1.ReportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderSettlementReportRequest) Reset() {
+	*x = RenderSettlementReportRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderSettlementReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderSettlementReportRequest) ProtoMessage() {}
+
+func (x *RenderSettlementReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderSettlementReportRequest.ProtoReflect.Descriptor instead.
+func (*RenderSettlementReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RenderSettlementReportRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RenderSettlementReportRequest) GetFormat() ReportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReportFormat_REPORT_FORMAT_UNSPECIFIED
+}
+
+type RenderSettlementReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderSettlementReportResponse) Reset() {
+	*x = RenderSettlementReportResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderSettlementReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderSettlementReportResponse) ProtoMessage() {}
+
+func (x *RenderSettlementReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderSettlementReportResponse.ProtoReflect.Descriptor instead.
+func (*RenderSettlementReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RenderSettlementReportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *RenderSettlementReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RenderSettlementReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// AccountMapping books expenses of a category to an account of the
+// accounting software
+type AccountMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountMapping) Reset() {
+	*x = AccountMapping{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMapping) ProtoMessage() {}
+
+func (x *AccountMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMapping.ProtoReflect.Descriptor instead.
+func (*AccountMapping) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AccountMapping) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AccountMapping) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type GetAccountMappingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountMappingsRequest) Reset() {
+	*x = GetAccountMappingsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountMappingsRequest) ProtoMessage() {}
+
+func (x *GetAccountMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountMappingsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetAccountMappingsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetAccountMappingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mappings      []*AccountMapping      `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountMappingsResponse) Reset() {
+	*x = GetAccountMappingsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountMappingsResponse) ProtoMessage() {}
+
+func (x *GetAccountMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountMappingsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetAccountMappingsResponse) GetMappings() []*AccountMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+// Replaces all mappings of the group
+type UpdateAccountMappingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Mappings      []*AccountMapping      `protobuf:"bytes,2,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountMappingsRequest) Reset() {
+	*x = UpdateAccountMappingsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountMappingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountMappingsRequest) ProtoMessage() {}
+
+func (x *UpdateAccountMappingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountMappingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountMappingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateAccountMappingsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateAccountMappingsRequest) GetMappings() []*AccountMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+type UpdateAccountMappingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mappings      []*AccountMapping      `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountMappingsResponse) Reset() {
+	*x = UpdateAccountMappingsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountMappingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountMappingsResponse) ProtoMessage() {}
+
+func (x *UpdateAccountMappingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountMappingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountMappingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UpdateAccountMappingsResponse) GetMappings() []*AccountMapping {
+	if x != nil {
+		return x.Mappings
+	}
+	return nil
+}
+
+type ExportJournalRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	GroupId            string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Format             JournalFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=group.v1.JournalFormat" json:"format,omitempty"`
+	IncludeSettlements bool                   `protobuf:"varint,3,opt,name=include_settlements,json=includeSettlements,proto3" json:"include_settlements,omitempty"` // Also book the transfers that settle the group
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExportJournalRequest) Reset() {
+	*x = ExportJournalRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalRequest) ProtoMessage() {}
+
+func (x *ExportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ExportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportJournalRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExportJournalRequest) GetFormat() JournalFormat {
+	if x != nil {
+		return x.Format
+	}
+	return JournalFormat_JOURNAL_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportJournalRequest) GetIncludeSettlements() bool {
+	if x != nil {
+		return x.IncludeSettlements
+	}
+	return false
+}
+
+type ExportJournalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,4,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJournalResponse) Reset() {
+	*x = ExportJournalResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalResponse) ProtoMessage() {}
+
+func (x *ExportJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalResponse.ProtoReflect.Descriptor instead.
+func (*ExportJournalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ExportJournalResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportJournalResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportJournalResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportJournalResponse) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+// BankAccount is where a member receives settlement transfers. Names are in
+// half-width katakana and only the last digits of the number are returned.
+type BankAccount struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MemberId           string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName         string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	BankCode           string                 `protobuf:"bytes,3,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`
+	BankName           string                 `protobuf:"bytes,4,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	BranchCode         string                 `protobuf:"bytes,5,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"`
+	BranchName         string                 `protobuf:"bytes,6,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"`
+	AccountType        BankAccountType        `protobuf:"varint,7,opt,name=account_type,json=accountType,proto3,enum=group.v1.BankAccountType" json:"account_type,omitempty"`
+	AccountNumberLast4 string                 `protobuf:"bytes,8,opt,name=account_number_last4,json=accountNumberLast4,proto3" json:"account_number_last4,omitempty"`
+	AccountHolder      string                 `protobuf:"bytes,9,opt,name=account_holder,json=accountHolder,proto3" json:"account_holder,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BankAccount) Reset() {
+	*x = BankAccount{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankAccount) ProtoMessage() {}
+
+func (x *BankAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BankAccount.ProtoReflect.Descriptor instead.
+func (*BankAccount) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *BankAccount) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *BankAccount) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *BankAccount) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *BankAccount) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *BankAccount) GetBranchCode() string {
+	if x != nil {
+		return x.BranchCode
+	}
+	return ""
+}
+
+func (x *BankAccount) GetBranchName() string {
+	if x != nil {
+		return x.BranchName
+	}
+	return ""
+}
+
+func (x *BankAccount) GetAccountType() BankAccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return BankAccountType_BANK_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *BankAccount) GetAccountNumberLast4() string {
+	if x != nil {
+		return x.AccountNumberLast4
+	}
+	return ""
+}
+
+func (x *BankAccount) GetAccountHolder() string {
+	if x != nil {
+		return x.AccountHolder
+	}
+	return ""
+}
+
+func (x *BankAccount) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetMemberBankAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	BankCode      string                 `protobuf:"bytes,3,opt,name=bank_code,json=bankCode,proto3" json:"bank_code,omitempty"`       // 4 digits
+	BankName      string                 `protobuf:"bytes,4,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`       // Optional; kana, converted to half-width
+	BranchCode    string                 `protobuf:"bytes,5,opt,name=branch_code,json=branchCode,proto3" json:"branch_code,omitempty"` // 3 digits
+	BranchName    string                 `protobuf:"bytes,6,opt,name=branch_name,json=branchName,proto3" json:"branch_name,omitempty"` // Optional; kana, converted to half-width
+	AccountType   BankAccountType        `protobuf:"varint,7,opt,name=account_type,json=accountType,proto3,enum=group.v1.BankAccountType" json:"account_type,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,8,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"` // Up to 7 digits
+	AccountHolder string                 `protobuf:"bytes,9,opt,name=account_holder,json=accountHolder,proto3" json:"account_holder,omitempty"` // Kana, converted to half-width
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberBankAccountRequest) Reset() {
+	*x = SetMemberBankAccountRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberBankAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberBankAccountRequest) ProtoMessage() {}
+
+func (x *SetMemberBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetMemberBankAccountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetBankCode() string {
+	if x != nil {
+		return x.BankCode
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetBranchCode() string {
+	if x != nil {
+		return x.BranchCode
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetBranchName() string {
+	if x != nil {
+		return x.BranchName
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetAccountType() BankAccountType {
+	if x != nil {
+		return x.AccountType
+	}
+	return BankAccountType_BANK_ACCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *SetMemberBankAccountRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *SetMemberBankAccountRequest) GetAccountHolder() string {
+	if x != nil {
+		return x.AccountHolder
+	}
+	return ""
+}
+
+type SetMemberBankAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccount   *BankAccount           `protobuf:"bytes,1,opt,name=bank_account,json=bankAccount,proto3" json:"bank_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberBankAccountResponse) Reset() {
+	*x = SetMemberBankAccountResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberBankAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberBankAccountResponse) ProtoMessage() {}
+
+func (x *SetMemberBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetMemberBankAccountResponse) GetBankAccount() *BankAccount {
+	if x != nil {
+		return x.BankAccount
+	}
+	return nil
+}
+
+type DeleteMemberBankAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemberBankAccountRequest) Reset() {
+	*x = DeleteMemberBankAccountRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberBankAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberBankAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMemberBankAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberBankAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemberBankAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DeleteMemberBankAccountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DeleteMemberBankAccountRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type DeleteMemberBankAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemberBankAccountResponse) Reset() {
+	*x = DeleteMemberBankAccountResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemberBankAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemberBankAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMemberBankAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemberBankAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMemberBankAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteMemberBankAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListBankAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankAccountsRequest) Reset() {
+	*x = ListBankAccountsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankAccountsRequest) ProtoMessage() {}
+
+func (x *ListBankAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListBankAccountsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListBankAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankAccounts  []*BankAccount         `protobuf:"bytes,1,rep,name=bank_accounts,json=bankAccounts,proto3" json:"bank_accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBankAccountsResponse) Reset() {
+	*x = ListBankAccountsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBankAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBankAccountsResponse) ProtoMessage() {}
+
+func (x *ListBankAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBankAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBankAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListBankAccountsResponse) GetBankAccounts() []*BankAccount {
+	if x != nil {
+		return x.BankAccounts
+	}
+	return nil
+}
+
+// The payer pays every member who is owed money from their own account;
+// members who owe money repay the payer separately
+type ExportZenginTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PayerMemberId string                 `protobuf:"bytes,2,opt,name=payer_member_id,json=payerMemberId,proto3" json:"payer_member_id,omitempty"`
+	RequesterCode string                 `protobuf:"bytes,3,opt,name=requester_code,json=requesterCode,proto3" json:"requester_code,omitempty"` // 10-digit code assigned by the payer's bank
+	RequesterName string                 `protobuf:"bytes,4,opt,name=requester_name,json=requesterName,proto3" json:"requester_name,omitempty"` // Defaults to the payer's account holder
+	TransferDate  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=transfer_date,json=transferDate,proto3" json:"transfer_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportZenginTransfersRequest) Reset() {
+	*x = ExportZenginTransfersRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportZenginTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportZenginTransfersRequest) ProtoMessage() {}
+
+func (x *ExportZenginTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportZenginTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ExportZenginTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ExportZenginTransfersRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersRequest) GetPayerMemberId() string {
+	if x != nil {
+		return x.PayerMemberId
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersRequest) GetRequesterCode() string {
+	if x != nil {
+		return x.RequesterCode
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersRequest) GetRequesterName() string {
+	if x != nil {
+		return x.RequesterName
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersRequest) GetTransferDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransferDate
+	}
+	return nil
+}
+
+type ExportZenginTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	TransferCount int32                  `protobuf:"varint,4,opt,name=transfer_count,json=transferCount,proto3" json:"transfer_count,omitempty"`
+	TotalAmount   int64                  `protobuf:"varint,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportZenginTransfersResponse) Reset() {
+	*x = ExportZenginTransfersResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportZenginTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportZenginTransfersResponse) ProtoMessage() {}
+
+func (x *ExportZenginTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportZenginTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ExportZenginTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ExportZenginTransfersResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportZenginTransfersResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportZenginTransfersResponse) GetTransferCount() int32 {
+	if x != nil {
+		return x.TransferCount
+	}
+	return 0
+}
+
+func (x *ExportZenginTransfersResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type TemplateMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
+	Shares        int32                  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"` // Weight under SPLIT_MODE_SHARES, 1 otherwise
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateMember) Reset() {
+	*x = TemplateMember{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateMember) ProtoMessage() {}
+
+func (x *TemplateMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateMember.ProtoReflect.Descriptor instead.
+func (*TemplateMember) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TemplateMember) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *TemplateMember) GetMemberName() string {
+	if x != nil {
+		return x.MemberName
+	}
+	return ""
+}
+
+func (x *TemplateMember) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+type ExpenseTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        int64                  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"` // Default amount in cents (JPY); 0 when entered each time
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Kind          ExpenseKind            `protobuf:"varint,7,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	PaidById      string                 `protobuf:"bytes,8,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	PaidByName    string                 `protobuf:"bytes,9,opt,name=paid_by_name,json=paidByName,proto3" json:"paid_by_name,omitempty"`
+	SplitMode     SplitMode              `protobuf:"varint,10,opt,name=split_mode,json=splitMode,proto3,enum=group.v1.SplitMode" json:"split_mode,omitempty"`
+	Members       []*TemplateMember      `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseTemplate) Reset() {
+	*x = ExpenseTemplate{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseTemplate) ProtoMessage() {}
+
+func (x *ExpenseTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseTemplate.ProtoReflect.Descriptor instead.
+func (*ExpenseTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ExpenseTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *ExpenseTemplate) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetPaidByName() string {
+	if x != nil {
+		return x.PaidByName
+	}
+	return ""
+}
+
+func (x *ExpenseTemplate) GetSplitMode() SplitMode {
+	if x != nil {
+		return x.SplitMode
+	}
+	return SplitMode_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *ExpenseTemplate) GetMembers() []*TemplateMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ExpenseTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExpenseTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateExpenseTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Unique within the group
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`    // Optional default amount in cents (JPY)
+	Category       string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"` // Optional, e.g. "食費"
+	Kind           ExpenseKind            `protobuf:"varint,6,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	PaidById       string                 `protobuf:"bytes,7,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	SplitMemberIds []string               `protobuf:"bytes,8,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"`
+	SplitMode      SplitMode              `protobuf:"varint,9,opt,name=split_mode,json=splitMode,proto3,enum=group.v1.SplitMode" json:"split_mode,omitempty"`
+	SplitShares    []int32                `protobuf:"varint,10,rep,packed,name=split_shares,json=splitShares,proto3" json:"split_shares,omitempty"` // Shares of each split member in split_member_ids order, for SPLIT_MODE_SHARES
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateExpenseTemplateRequest) Reset() {
+	*x = CreateExpenseTemplateRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseTemplateRequest) ProtoMessage() {}
+
+func (x *CreateExpenseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateExpenseTemplateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateExpenseTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateExpenseTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateExpenseTemplateRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateExpenseTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateExpenseTemplateRequest) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *CreateExpenseTemplateRequest) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *CreateExpenseTemplateRequest) GetSplitMemberIds() []string {
+	if x != nil {
+		return x.SplitMemberIds
+	}
+	return nil
+}
+
+func (x *CreateExpenseTemplateRequest) GetSplitMode() SplitMode {
+	if x != nil {
+		return x.SplitMode
+	}
+	return SplitMode_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *CreateExpenseTemplateRequest) GetSplitShares() []int32 {
+	if x != nil {
+		return x.SplitShares
+	}
+	return nil
+}
+
+type CreateExpenseTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ExpenseTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseTemplateResponse) Reset() {
+	*x = CreateExpenseTemplateResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseTemplateResponse) ProtoMessage() {}
+
+func (x *CreateExpenseTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateExpenseTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateExpenseTemplateResponse) GetTemplate() *ExpenseTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// Replaces every field of the template
+type UpdateExpenseTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId     string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount         int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category       string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Kind           ExpenseKind            `protobuf:"varint,6,opt,name=kind,proto3,enum=group.v1.ExpenseKind" json:"kind,omitempty"`
+	PaidById       string                 `protobuf:"bytes,7,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	SplitMemberIds []string               `protobuf:"bytes,8,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"`
+	SplitMode      SplitMode              `protobuf:"varint,9,opt,name=split_mode,json=splitMode,proto3,enum=group.v1.SplitMode" json:"split_mode,omitempty"`
+	SplitShares    []int32                `protobuf:"varint,10,rep,packed,name=split_shares,json=splitShares,proto3" json:"split_shares,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseTemplateRequest) Reset() {
+	*x = UpdateExpenseTemplateRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateExpenseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateExpenseTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *UpdateExpenseTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateExpenseTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateExpenseTemplateRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateExpenseTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateExpenseTemplateRequest) GetKind() ExpenseKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ExpenseKind_EXPENSE_KIND_UNSPECIFIED
+}
+
+func (x *UpdateExpenseTemplateRequest) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *UpdateExpenseTemplateRequest) GetSplitMemberIds() []string {
+	if x != nil {
+		return x.SplitMemberIds
+	}
+	return nil
+}
+
+func (x *UpdateExpenseTemplateRequest) GetSplitMode() SplitMode {
+	if x != nil {
+		return x.SplitMode
+	}
+	return SplitMode_SPLIT_MODE_UNSPECIFIED
+}
+
+func (x *UpdateExpenseTemplateRequest) GetSplitShares() []int32 {
+	if x != nil {
+		return x.SplitShares
+	}
+	return nil
+}
+
+type UpdateExpenseTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ExpenseTemplate       `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateExpenseTemplateResponse) Reset() {
+	*x = UpdateExpenseTemplateResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExpenseTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExpenseTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateExpenseTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateExpenseTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateExpenseTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateExpenseTemplateResponse) GetTemplate() *ExpenseTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteExpenseTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseTemplateRequest) Reset() {
+	*x = DeleteExpenseTemplateRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteExpenseTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteExpenseTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+type DeleteExpenseTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExpenseTemplateResponse) Reset() {
+	*x = DeleteExpenseTemplateResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExpenseTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExpenseTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteExpenseTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExpenseTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExpenseTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeleteExpenseTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListExpenseTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseTemplatesRequest) Reset() {
+	*x = ListExpenseTemplatesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseTemplatesRequest) ProtoMessage() {}
+
+func (x *ListExpenseTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExpenseTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListExpenseTemplatesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type ListExpenseTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ExpenseTemplate     `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"` // Ordered by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseTemplatesResponse) Reset() {
+	*x = ListExpenseTemplatesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseTemplatesResponse) ProtoMessage() {}
+
+func (x *ListExpenseTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExpenseTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListExpenseTemplatesResponse) GetTemplates() []*ExpenseTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// Adds an expense filled in from a template. Empty or zero fields keep the
+// template's value.
+type AddExpenseFromTemplateRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId     string                 `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Required when the template has no default amount
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PaidById       string                 `protobuf:"bytes,4,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
+	SplitMemberIds []string               `protobuf:"bytes,5,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"` // Members not in the template get 1 share
+	Category       string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	AllowDuplicate bool                   `protobuf:"varint,7,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"` // As in AddExpenseRequest
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddExpenseFromTemplateRequest) Reset() {
+	*x = AddExpenseFromTemplateRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseFromTemplateRequest) ProtoMessage() {}
+
+func (x *AddExpenseFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AddExpenseFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *AddExpenseFromTemplateRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddExpenseFromTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddExpenseFromTemplateRequest) GetPaidById() string {
+	if x != nil {
+		return x.PaidById
+	}
+	return ""
+}
+
+func (x *AddExpenseFromTemplateRequest) GetSplitMemberIds() []string {
+	if x != nil {
+		return x.SplitMemberIds
+	}
+	return nil
+}
+
+func (x *AddExpenseFromTemplateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AddExpenseFromTemplateRequest) GetAllowDuplicate() bool {
+	if x != nil {
+		return x.AllowDuplicate
+	}
+	return false
+}
+
+type AddExpenseFromTemplateResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Expense            *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`                                                 // Unset when the expense was not saved as a probable duplicate
+	BudgetAlerts       []*BudgetAlert         `protobuf:"bytes,2,rep,name=budget_alerts,json=budgetAlerts,proto3" json:"budget_alerts,omitempty"`                   // Budget thresholds the expense crossed
+	ProbableDuplicates []*ExpenseWithDetails  `protobuf:"bytes,3,rep,name=probable_duplicates,json=probableDuplicates,proto3" json:"probable_duplicates,omitempty"` // As in AddExpenseResponse
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddExpenseFromTemplateResponse) Reset() {
+	*x = AddExpenseFromTemplateResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseFromTemplateResponse) ProtoMessage() {}
+
+func (x *AddExpenseFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*AddExpenseFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AddExpenseFromTemplateResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *AddExpenseFromTemplateResponse) GetBudgetAlerts() []*BudgetAlert {
+	if x != nil {
+		return x.BudgetAlerts
+	}
+	return nil
+}
+
+func (x *AddExpenseFromTemplateResponse) GetProbableDuplicates() []*ExpenseWithDetails {
+	if x != nil {
+		return x.ProbableDuplicates
+	}
+	return nil
+}
+
+// Spending limit for the whole group or one category. Spending is expenses
+// less income such as refunds; settle-up payments do not count.
+type Budget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // Empty for the whole group
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`    // Amount in cents (JPY)
+	Period        BudgetPeriod           `protobuf:"varint,3,opt,name=period,proto3,enum=group.v1.BudgetPeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Budget) Reset() {
+	*x = Budget{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Budget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Budget) ProtoMessage() {}
+
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Budget) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Budget) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriod() BudgetPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED
+}
+
+type BudgetStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // Unset for BUDGET_PERIOD_TOTAL
+	PeriodEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`       // Exclusive; unset for BUDGET_PERIOD_TOTAL
+	Spent         int64                  `protobuf:"varint,4,opt,name=spent,proto3" json:"spent,omitempty"`
+	Remaining     int64                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`                        // Negative when over budget
+	PercentUsed   int32                  `protobuf:"varint,6,opt,name=percent_used,json=percentUsed,proto3" json:"percent_used,omitempty"` // Rounded down
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetStatus) Reset() {
+	*x = BudgetStatus{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetStatus) ProtoMessage() {}
+
+func (x *BudgetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetStatus.ProtoReflect.Descriptor instead.
+func (*BudgetStatus) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *BudgetStatus) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *BudgetStatus) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *BudgetStatus) GetPercentUsed() int32 {
+	if x != nil {
+		return x.PercentUsed
+	}
+	return 0
+}
+
+type BudgetAlert struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Budget           *Budget                `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	ThresholdPercent int32                  `protobuf:"varint,2,opt,name=threshold_percent,json=thresholdPercent,proto3" json:"threshold_percent,omitempty"` // 80 or 100
+	Spent            int64                  `protobuf:"varint,3,opt,name=spent,proto3" json:"spent,omitempty"`                                               // Spent in the period after the change
+	PeriodStart      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`                 // Unset for BUDGET_PERIOD_TOTAL
+	ExpenseId        string                 `protobuf:"bytes,5,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`                       // Expense whose change crossed the threshold
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BudgetAlert) Reset() {
+	*x = BudgetAlert{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetAlert) ProtoMessage() {}
+
+func (x *BudgetAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetAlert.ProtoReflect.Descriptor instead.
+func (*BudgetAlert) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *BudgetAlert) GetBudget() *Budget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *BudgetAlert) GetThresholdPercent() int32 {
+	if x != nil {
+		return x.ThresholdPercent
+	}
+	return 0
+}
+
+func (x *BudgetAlert) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetAlert) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BudgetAlert) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type GetBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetsRequest) Reset() {
+	*x = GetBudgetsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetsRequest) ProtoMessage() {}
+
+func (x *GetBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetBudgetsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"` // The whole group first, then by category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetsResponse) Reset() {
+	*x = GetBudgetsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetsResponse) ProtoMessage() {}
+
+func (x *GetBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+// Replaces all budgets of the group
+type UpdateBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Budgets       []*Budget              `protobuf:"bytes,2,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetsRequest) Reset() {
+	*x = UpdateBudgetsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetsRequest) ProtoMessage() {}
+
+func (x *UpdateBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *UpdateBudgetsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateBudgetsRequest) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type UpdateBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBudgetsResponse) Reset() {
+	*x = UpdateBudgetsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBudgetsResponse) ProtoMessage() {}
+
+func (x *UpdateBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UpdateBudgetsResponse) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetBudgetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // Optional, selects the period; defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusRequest) Reset() {
+	*x = GetBudgetStatusRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusRequest) ProtoMessage() {}
+
+func (x *GetBudgetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetBudgetStatusRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetBudgetStatusRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetBudgetStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*BudgetStatus        `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetStatusResponse) Reset() {
+	*x = GetBudgetStatusResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetStatusResponse) ProtoMessage() {}
+
+func (x *GetBudgetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetBudgetStatusResponse) GetStatuses() []*BudgetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// Two expenses with the same kind and amount, similar descriptions and
+// recorded within two days of each other
+type DuplicateExpensePair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"` // Recorded first
+	Duplicate     *ExpenseWithDetails    `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateExpensePair) Reset() {
+	*x = DuplicateExpensePair{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateExpensePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateExpensePair) ProtoMessage() {}
+
+func (x *DuplicateExpensePair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateExpensePair.ProtoReflect.Descriptor instead.
+func (*DuplicateExpensePair) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DuplicateExpensePair) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *DuplicateExpensePair) GetDuplicate() *ExpenseWithDetails {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+type ListDuplicateExpensesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Group) Reset() {
-	*x = Group{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[0]
+func (x *ListDuplicateExpensesRequest) Reset() {
+	*x = ListDuplicateExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) String() string {
+func (x *ListDuplicateExpensesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Group) ProtoMessage() {}
+func (*ListDuplicateExpensesRequest) ProtoMessage() {}
 
-func (x *Group) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[0]
+func (x *ListDuplicateExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,85 +8341,88 @@ func (x *Group) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Group.ProtoReflect.Descriptor instead.
-func (*Group) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ListDuplicateExpensesRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *Group) GetId() string {
+func (x *ListDuplicateExpensesRequest) GetGroupId() string {
 	if x != nil {
-		return x.Id
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *Group) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type ListDuplicateExpensesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Pairs         []*DuplicateExpensePair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // Newest duplicate first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Group) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ListDuplicateExpensesResponse) Reset() {
+	*x = ListDuplicateExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Group) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+func (x *ListDuplicateExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+func (*ListDuplicateExpensesResponse) ProtoMessage() {}
+
+func (x *ListDuplicateExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[122]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+// Deprecated: Use ListDuplicateExpensesResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *Group) GetMembers() []*Member {
+func (x *ListDuplicateExpensesResponse) GetPairs() []*DuplicateExpensePair {
 	if x != nil {
-		return x.Members
+		return x.Pairs
 	}
 	return nil
 }
 
-type Member struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Keeps one expense and moves the other, after handing its attachments and
+// comments over, to the trash
+type MergeExpensesRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	KeepExpenseId      string                 `protobuf:"bytes,1,opt,name=keep_expense_id,json=keepExpenseId,proto3" json:"keep_expense_id,omitempty"`
+	DuplicateExpenseId string                 `protobuf:"bytes,2,opt,name=duplicate_expense_id,json=duplicateExpenseId,proto3" json:"duplicate_expense_id,omitempty"`
+	MergedBy           string                 `protobuf:"bytes,3,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"` // Optional member ID, shown in the trash
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Member) Reset() {
-	*x = Member{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[1]
+func (x *MergeExpensesRequest) Reset() {
+	*x = MergeExpensesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Member) String() string {
+func (x *MergeExpensesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Member) ProtoMessage() {}
+func (*MergeExpensesRequest) ProtoMessage() {}
 
-func (x *Member) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[1]
+func (x *MergeExpensesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -149,64 +8433,99 @@ func (x *Member) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Member.ProtoReflect.Descriptor instead.
-func (*Member) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MergeExpensesRequest.ProtoReflect.Descriptor instead.
+func (*MergeExpensesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *Member) GetId() string {
+func (x *MergeExpensesRequest) GetKeepExpenseId() string {
 	if x != nil {
-		return x.Id
+		return x.KeepExpenseId
 	}
 	return ""
 }
 
-func (x *Member) GetName() string {
+func (x *MergeExpensesRequest) GetDuplicateExpenseId() string {
 	if x != nil {
-		return x.Name
+		return x.DuplicateExpenseId
 	}
 	return ""
 }
 
-func (x *Member) GetEmail() string {
+func (x *MergeExpensesRequest) GetMergedBy() string {
 	if x != nil {
-		return x.Email
+		return x.MergedBy
 	}
 	return ""
 }
 
-func (x *Member) GetJoinedAt() *timestamppb.Timestamp {
+type MergeExpensesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"` // The kept expense
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeExpensesResponse) Reset() {
+	*x = MergeExpensesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeExpensesResponse) ProtoMessage() {}
+
+func (x *MergeExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[124]
 	if x != nil {
-		return x.JoinedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeExpensesResponse.ProtoReflect.Descriptor instead.
+func (*MergeExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MergeExpensesResponse) GetExpense() *ExpenseWithDetails {
+	if x != nil {
+		return x.Expense
 	}
 	return nil
 }
 
-type CreateGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	MemberNames   []string               `protobuf:"bytes,4,rep,name=member_names,json=memberNames,proto3" json:"member_names,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GroupSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	RequireExpenseApproval bool                   `protobuf:"varint,1,opt,name=require_expense_approval,json=requireExpenseApproval,proto3" json:"require_expense_approval,omitempty"` // New and edited expenses start as pending
+	AdminMemberId          string                 `protobuf:"bytes,2,opt,name=admin_member_id,json=adminMemberId,proto3" json:"admin_member_id,omitempty"`                             // Member allowed to reopen closed periods; empty when nobody is
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[2]
+func (x *GroupSettings) Reset() {
+	*x = GroupSettings{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *GroupSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*GroupSettings) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[2]
+func (x *GroupSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,61 +8536,91 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GroupSettings.ProtoReflect.Descriptor instead.
+func (*GroupSettings) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *GroupSettings) GetRequireExpenseApproval() bool {
 	if x != nil {
-		return x.Name
+		return x.RequireExpenseApproval
 	}
-	return ""
+	return false
 }
 
-func (x *CreateGroupRequest) GetDescription() string {
+func (x *GroupSettings) GetAdminMemberId() string {
 	if x != nil {
-		return x.Description
+		return x.AdminMemberId
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetCurrency() string {
+type GetGroupSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupSettingsRequest) Reset() {
+	*x = GetGroupSettingsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupSettingsRequest) ProtoMessage() {}
+
+func (x *GetGroupSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[126]
 	if x != nil {
-		return x.Currency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateGroupRequest) GetMemberNames() []string {
+// Deprecated: Use GetGroupSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetGroupSettingsRequest) GetGroupId() string {
 	if x != nil {
-		return x.MemberNames
+		return x.GroupId
 	}
-	return nil
+	return ""
 }
 
-type CreateGroupResponse struct {
+type GetGroupSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Settings      *GroupSettings         `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupResponse) Reset() {
-	*x = CreateGroupResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[3]
+func (x *GetGroupSettingsResponse) Reset() {
+	*x = GetGroupSettingsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupResponse) String() string {
+func (x *GetGroupSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupResponse) ProtoMessage() {}
+func (*GetGroupSettingsResponse) ProtoMessage() {}
 
-func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[3]
+func (x *GetGroupSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,40 +8631,41 @@ func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
-func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetGroupSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *CreateGroupResponse) GetGroup() *Group {
+func (x *GetGroupSettingsResponse) GetSettings() *GroupSettings {
 	if x != nil {
-		return x.Group
+		return x.Settings
 	}
 	return nil
 }
 
-type GetGroupRequest struct {
+type UpdateGroupSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Settings      *GroupSettings         `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupRequest) Reset() {
-	*x = GetGroupRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[4]
+func (x *UpdateGroupSettingsRequest) Reset() {
+	*x = UpdateGroupSettingsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupRequest) String() string {
+func (x *UpdateGroupSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupRequest) ProtoMessage() {}
+func (*UpdateGroupSettingsRequest) ProtoMessage() {}
 
-func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[4]
+func (x *UpdateGroupSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,40 +8676,47 @@ func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateGroupSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetGroupRequest) GetId() string {
+func (x *UpdateGroupSettingsRequest) GetGroupId() string {
 	if x != nil {
-		return x.Id
+		return x.GroupId
 	}
 	return ""
 }
 
-type GetGroupResponse struct {
+func (x *UpdateGroupSettingsRequest) GetSettings() *GroupSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateGroupSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Settings      *GroupSettings         `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupResponse) Reset() {
-	*x = GetGroupResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[5]
+func (x *UpdateGroupSettingsResponse) Reset() {
+	*x = UpdateGroupSettingsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupResponse) String() string {
+func (x *UpdateGroupSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupResponse) ProtoMessage() {}
+func (*UpdateGroupSettingsResponse) ProtoMessage() {}
 
-func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[5]
+func (x *UpdateGroupSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,43 +8727,44 @@ func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UpdateGroupSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetGroupResponse) GetGroup() *Group {
+func (x *UpdateGroupSettingsResponse) GetSettings() *GroupSettings {
 	if x != nil {
-		return x.Group
+		return x.Settings
 	}
 	return nil
 }
 
-type UpdateGroupRequest struct {
+// A split member approves or disputes a pending or disputed expense. A vote
+// can be changed until every split member has approved.
+type VoteOnExpenseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Vote          ApprovalVote           `protobuf:"varint,3,opt,name=vote,proto3,enum=group.v1.ApprovalVote" json:"vote,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[6]
+func (x *VoteOnExpenseRequest) Reset() {
+	*x = VoteOnExpenseRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *VoteOnExpenseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*VoteOnExpenseRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[6]
+func (x *VoteOnExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,61 +8775,54 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *UpdateGroupRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use VoteOnExpenseRequest.ProtoReflect.Descriptor instead.
+func (*VoteOnExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *UpdateGroupRequest) GetName() string {
+func (x *VoteOnExpenseRequest) GetExpenseId() string {
 	if x != nil {
-		return x.Name
+		return x.ExpenseId
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetDescription() string {
+func (x *VoteOnExpenseRequest) GetMemberId() string {
 	if x != nil {
-		return x.Description
+		return x.MemberId
 	}
 	return ""
 }
 
-func (x *UpdateGroupRequest) GetCurrency() string {
+func (x *VoteOnExpenseRequest) GetVote() ApprovalVote {
 	if x != nil {
-		return x.Currency
+		return x.Vote
 	}
-	return ""
+	return ApprovalVote_APPROVAL_VOTE_UNSPECIFIED
 }
 
-type UpdateGroupResponse struct {
+type VoteOnExpenseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupResponse) Reset() {
-	*x = UpdateGroupResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[7]
+func (x *VoteOnExpenseResponse) Reset() {
+	*x = VoteOnExpenseResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupResponse) String() string {
+func (x *VoteOnExpenseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupResponse) ProtoMessage() {}
+func (*VoteOnExpenseResponse) ProtoMessage() {}
 
-func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[7]
+func (x *VoteOnExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,40 +8833,53 @@ func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use VoteOnExpenseResponse.ProtoReflect.Descriptor instead.
+func (*VoteOnExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UpdateGroupResponse) GetGroup() *Group {
+func (x *VoteOnExpenseResponse) GetExpense() *ExpenseWithDetails {
 	if x != nil {
-		return x.Group
+		return x.Expense
 	}
 	return nil
 }
 
-type DeleteGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteGroupRequest) Reset() {
-	*x = DeleteGroupRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[8]
+// A closed period freezes the expenses recorded up to closed_until. Its
+// closing balances are carried forward as the opening balances of the next
+// period.
+type ClosedPeriod struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId         string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	PeriodStart     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // closed_until of the previous period; unset for the first one
+	ClosedUntil     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closed_until,json=closedUntil,proto3" json:"closed_until,omitempty"`
+	OpeningBalances []*MemberBalance       `protobuf:"bytes,5,rep,name=opening_balances,json=openingBalances,proto3" json:"opening_balances,omitempty"` // Carried forward from the previous period
+	Balances        []*MemberBalance       `protobuf:"bytes,6,rep,name=balances,proto3" json:"balances,omitempty"`                                      // Closing balances
+	Settlements     []*Settlement          `protobuf:"bytes,7,rep,name=settlements,proto3" json:"settlements,omitempty"`                                // Settlements of the closing balances
+	ClosedBy        string                 `protobuf:"bytes,8,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	ClosedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	ReopenedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at,omitempty"` // Set once an admin reopened the period
+	ReopenedBy      string                 `protobuf:"bytes,11,opt,name=reopened_by,json=reopenedBy,proto3" json:"reopened_by,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClosedPeriod) Reset() {
+	*x = ClosedPeriod{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupRequest) String() string {
+func (x *ClosedPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupRequest) ProtoMessage() {}
+func (*ClosedPeriod) ProtoMessage() {}
 
-func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[8]
+func (x *ClosedPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,86 +8890,112 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ClosedPeriod.ProtoReflect.Descriptor instead.
+func (*ClosedPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *DeleteGroupRequest) GetId() string {
+func (x *ClosedPeriod) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteGroupResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClosedPeriod) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
 }
 
-func (x *DeleteGroupResponse) Reset() {
-	*x = DeleteGroupResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ClosedPeriod) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
 }
 
-func (x *DeleteGroupResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ClosedPeriod) GetClosedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedUntil
+	}
+	return nil
 }
 
-func (*DeleteGroupResponse) ProtoMessage() {}
+func (x *ClosedPeriod) GetOpeningBalances() []*MemberBalance {
+	if x != nil {
+		return x.OpeningBalances
+	}
+	return nil
+}
 
-func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[9]
+func (x *ClosedPeriod) GetBalances() []*MemberBalance {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Balances
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{9}
+func (x *ClosedPeriod) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
 }
 
-func (x *DeleteGroupResponse) GetSuccess() bool {
+func (x *ClosedPeriod) GetClosedBy() string {
 	if x != nil {
-		return x.Success
+		return x.ClosedBy
 	}
-	return false
+	return ""
 }
 
-type AddMemberRequest struct {
+func (x *ClosedPeriod) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *ClosedPeriod) GetReopenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReopenedAt
+	}
+	return nil
+}
+
+func (x *ClosedPeriod) GetReopenedBy() string {
+	if x != nil {
+		return x.ReopenedBy
+	}
+	return ""
+}
+
+type ClosePeriodRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
-	MemberEmail   string                 `protobuf:"bytes,3,opt,name=member_email,json=memberEmail,proto3" json:"member_email,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"` // Defaults to now
+	ClosedBy      string                 `protobuf:"bytes,3,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMemberRequest) Reset() {
-	*x = AddMemberRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[10]
+func (x *ClosePeriodRequest) Reset() {
+	*x = ClosePeriodRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMemberRequest) String() string {
+func (x *ClosePeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMemberRequest) ProtoMessage() {}
+func (*ClosePeriodRequest) ProtoMessage() {}
 
-func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[10]
+func (x *ClosePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,54 +9006,54 @@ func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
-func (*AddMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ClosePeriodRequest.ProtoReflect.Descriptor instead.
+func (*ClosePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *AddMemberRequest) GetGroupId() string {
+func (x *ClosePeriodRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *AddMemberRequest) GetMemberName() string {
+func (x *ClosePeriodRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MemberName
+		return x.Until
 	}
-	return ""
+	return nil
 }
 
-func (x *AddMemberRequest) GetMemberEmail() string {
+func (x *ClosePeriodRequest) GetClosedBy() string {
 	if x != nil {
-		return x.MemberEmail
+		return x.ClosedBy
 	}
 	return ""
 }
 
-type AddMemberResponse struct {
+type ClosePeriodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Period        *ClosedPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMemberResponse) Reset() {
-	*x = AddMemberResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[11]
+func (x *ClosePeriodResponse) Reset() {
+	*x = ClosePeriodResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMemberResponse) String() string {
+func (x *ClosePeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMemberResponse) ProtoMessage() {}
+func (*ClosePeriodResponse) ProtoMessage() {}
 
-func (x *AddMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[11]
+func (x *ClosePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,41 +9064,40 @@ func (x *AddMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMemberResponse.ProtoReflect.Descriptor instead.
-func (*AddMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ClosePeriodResponse.ProtoReflect.Descriptor instead.
+func (*ClosePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *AddMemberResponse) GetMember() *Member {
+func (x *ClosePeriodResponse) GetPeriod() *ClosedPeriod {
 	if x != nil {
-		return x.Member
+		return x.Period
 	}
 	return nil
 }
 
-type RemoveMemberRequest struct {
+type ListClosedPeriodsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMemberRequest) Reset() {
-	*x = RemoveMemberRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[12]
+func (x *ListClosedPeriodsRequest) Reset() {
+	*x = ListClosedPeriodsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMemberRequest) String() string {
+func (x *ListClosedPeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMemberRequest) ProtoMessage() {}
+func (*ListClosedPeriodsRequest) ProtoMessage() {}
 
-func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[12]
+func (x *ListClosedPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,47 +9108,40 @@ func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListClosedPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListClosedPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *RemoveMemberRequest) GetGroupId() string {
+func (x *ListClosedPeriodsRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *RemoveMemberRequest) GetMemberId() string {
-	if x != nil {
-		return x.MemberId
-	}
-	return ""
-}
-
-type RemoveMemberResponse struct {
+type ListClosedPeriodsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Periods       []*ClosedPeriod        `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"` // Newest first, reopened periods included
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMemberResponse) Reset() {
-	*x = RemoveMemberResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[13]
+func (x *ListClosedPeriodsResponse) Reset() {
+	*x = ListClosedPeriodsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMemberResponse) String() string {
+func (x *ListClosedPeriodsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMemberResponse) ProtoMessage() {}
+func (*ListClosedPeriodsResponse) ProtoMessage() {}
 
-func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[13]
+func (x *ListClosedPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,45 +9152,42 @@ func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
-func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListClosedPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListClosedPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *RemoveMemberResponse) GetSuccess() bool {
+func (x *ListClosedPeriodsResponse) GetPeriods() []*ClosedPeriod {
 	if x != nil {
-		return x.Success
+		return x.Periods
 	}
-	return false
+	return nil
 }
 
-// Expense messages
-type AddExpenseRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GroupId        string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PaidById       string                 `protobuf:"bytes,4,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`                   // Member ID who paid
-	SplitMemberIds []string               `protobuf:"bytes,5,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"` // Member IDs to split among
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Only the latest closed period can be reopened, by the group's admin
+type ReopenPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodId      string                 `protobuf:"bytes,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	ReopenedBy    string                 `protobuf:"bytes,2,opt,name=reopened_by,json=reopenedBy,proto3" json:"reopened_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddExpenseRequest) Reset() {
-	*x = AddExpenseRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[14]
+func (x *ReopenPeriodRequest) Reset() {
+	*x = ReopenPeriodRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddExpenseRequest) String() string {
+func (x *ReopenPeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExpenseRequest) ProtoMessage() {}
+func (*ReopenPeriodRequest) ProtoMessage() {}
 
-func (x *AddExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[14]
+func (x *ReopenPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,68 +9198,47 @@ func (x *AddExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExpenseRequest.ProtoReflect.Descriptor instead.
-func (*AddExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *AddExpenseRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *AddExpenseRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use ReopenPeriodRequest.ProtoReflect.Descriptor instead.
+func (*ReopenPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *AddExpenseRequest) GetDescription() string {
+func (x *ReopenPeriodRequest) GetPeriodId() string {
 	if x != nil {
-		return x.Description
+		return x.PeriodId
 	}
 	return ""
 }
 
-func (x *AddExpenseRequest) GetPaidById() string {
+func (x *ReopenPeriodRequest) GetReopenedBy() string {
 	if x != nil {
-		return x.PaidById
+		return x.ReopenedBy
 	}
 	return ""
 }
 
-func (x *AddExpenseRequest) GetSplitMemberIds() []string {
-	if x != nil {
-		return x.SplitMemberIds
-	}
-	return nil
-}
-
-type AddExpenseResponse struct {
+type ReopenPeriodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Period        *ClosedPeriod          `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddExpenseResponse) Reset() {
-	*x = AddExpenseResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[15]
+func (x *ReopenPeriodResponse) Reset() {
+	*x = ReopenPeriodResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddExpenseResponse) String() string {
+func (x *ReopenPeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExpenseResponse) ProtoMessage() {}
+func (*ReopenPeriodResponse) ProtoMessage() {}
 
-func (x *AddExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[15]
+func (x *ReopenPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,44 +9249,47 @@ func (x *AddExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExpenseResponse.ProtoReflect.Descriptor instead.
-func (*AddExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ReopenPeriodResponse.ProtoReflect.Descriptor instead.
+func (*ReopenPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *AddExpenseResponse) GetExpense() *ExpenseWithDetails {
+func (x *ReopenPeriodResponse) GetPeriod() *ClosedPeriod {
 	if x != nil {
-		return x.Expense
+		return x.Period
 	}
 	return nil
 }
 
-type UpdateExpenseRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExpenseId      string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
-	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PaidById       string                 `protobuf:"bytes,4,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`                   // Member ID who paid
-	SplitMemberIds []string               `protobuf:"bytes,5,rep,name=split_member_ids,json=splitMemberIds,proto3" json:"split_member_ids,omitempty"` // Member IDs to split among
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Scoped settlement messages
+// Settles only part of a group's saved expenses, e.g. one leg of a trip.
+// The date range and the expense IDs narrow the expenses down together;
+// without either every expense of the group counts.
+type CalculateScopedSettlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` // Inclusive
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       // Exclusive
+	ExpenseIds    []string               `protobuf:"bytes,4,rep,name=expense_ids,json=expenseIds,proto3" json:"expense_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseRequest) Reset() {
-	*x = UpdateExpenseRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[16]
+func (x *CalculateScopedSettlementsRequest) Reset() {
+	*x = CalculateScopedSettlementsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseRequest) String() string {
+func (x *CalculateScopedSettlementsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseRequest) ProtoMessage() {}
+func (*CalculateScopedSettlementsRequest) ProtoMessage() {}
 
-func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[16]
+func (x *CalculateScopedSettlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,68 +9300,64 @@ func (x *UpdateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CalculateScopedSettlementsRequest.ProtoReflect.Descriptor instead.
+func (*CalculateScopedSettlementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *UpdateExpenseRequest) GetExpenseId() string {
+func (x *CalculateScopedSettlementsRequest) GetGroupId() string {
 	if x != nil {
-		return x.ExpenseId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *UpdateExpenseRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *UpdateExpenseRequest) GetDescription() string {
+func (x *CalculateScopedSettlementsRequest) GetCreatedFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.CreatedFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateExpenseRequest) GetPaidById() string {
+func (x *CalculateScopedSettlementsRequest) GetCreatedTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PaidById
+		return x.CreatedTo
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateExpenseRequest) GetSplitMemberIds() []string {
+func (x *CalculateScopedSettlementsRequest) GetExpenseIds() []string {
 	if x != nil {
-		return x.SplitMemberIds
+		return x.ExpenseIds
 	}
 	return nil
 }
 
-type UpdateExpenseResponse struct {
+type CalculateScopedSettlementsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expense       *ExpenseWithDetails    `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense,omitempty"`
+	Settlements   []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	Balances      []*MemberBalance       `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`                              // Balances from the scoped expenses only
+	ExpenseCount  int32                  `protobuf:"varint,3,opt,name=expense_count,json=expenseCount,proto3" json:"expense_count,omitempty"` // Approved expenses in scope
+	TotalSpent    int64                  `protobuf:"varint,4,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`       // Expenses less income in scope; settle-up payments do not count
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExpenseResponse) Reset() {
-	*x = UpdateExpenseResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[17]
+func (x *CalculateScopedSettlementsResponse) Reset() {
+	*x = CalculateScopedSettlementsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExpenseResponse) String() string {
+func (x *CalculateScopedSettlementsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExpenseResponse) ProtoMessage() {}
+func (*CalculateScopedSettlementsResponse) ProtoMessage() {}
 
-func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[17]
+func (x *CalculateScopedSettlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,40 +9368,67 @@ func (x *UpdateExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExpenseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CalculateScopedSettlementsResponse.ProtoReflect.Descriptor instead.
+func (*CalculateScopedSettlementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *UpdateExpenseResponse) GetExpense() *ExpenseWithDetails {
+func (x *CalculateScopedSettlementsResponse) GetSettlements() []*Settlement {
 	if x != nil {
-		return x.Expense
+		return x.Settlements
 	}
 	return nil
 }
 
-type DeleteExpenseRequest struct {
+func (x *CalculateScopedSettlementsResponse) GetBalances() []*MemberBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *CalculateScopedSettlementsResponse) GetExpenseCount() int32 {
+	if x != nil {
+		return x.ExpenseCount
+	}
+	return 0
+}
+
+func (x *CalculateScopedSettlementsResponse) GetTotalSpent() int64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+// Member participation messages
+// Members outside their active period are left out of splits among
+// everyone present.
+type SetMemberActivePeriodRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expense_id,json=expenseId,proto3" json:"expense_id,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      string                 `protobuf:"bytes,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	ActiveFrom    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=active_from,json=activeFrom,proto3" json:"active_from,omitempty"`    // Inclusive; unset from the start
+	ActiveUntil   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=active_until,json=activeUntil,proto3" json:"active_until,omitempty"` // Inclusive; unset with no end
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseRequest) Reset() {
-	*x = DeleteExpenseRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[18]
+func (x *SetMemberActivePeriodRequest) Reset() {
+	*x = SetMemberActivePeriodRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseRequest) String() string {
+func (x *SetMemberActivePeriodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseRequest) ProtoMessage() {}
+func (*SetMemberActivePeriodRequest) ProtoMessage() {}
 
-func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[18]
+func (x *SetMemberActivePeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,40 +9439,61 @@ func (x *DeleteExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetMemberActivePeriodRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberActivePeriodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *DeleteExpenseRequest) GetExpenseId() string {
+func (x *SetMemberActivePeriodRequest) GetGroupId() string {
 	if x != nil {
-		return x.ExpenseId
+		return x.GroupId
 	}
 	return ""
 }
 
-type DeleteExpenseResponse struct {
+func (x *SetMemberActivePeriodRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *SetMemberActivePeriodRequest) GetActiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveFrom
+	}
+	return nil
+}
+
+func (x *SetMemberActivePeriodRequest) GetActiveUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return nil
+}
+
+type SetMemberActivePeriodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Member        *Member                `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteExpenseResponse) Reset() {
-	*x = DeleteExpenseResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[19]
+func (x *SetMemberActivePeriodResponse) Reset() {
+	*x = SetMemberActivePeriodResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteExpenseResponse) String() string {
+func (x *SetMemberActivePeriodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteExpenseResponse) ProtoMessage() {}
+func (*SetMemberActivePeriodResponse) ProtoMessage() {}
 
-func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[19]
+func (x *SetMemberActivePeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,40 +9504,46 @@ func (x *DeleteExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteExpenseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetMemberActivePeriodResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberActivePeriodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *DeleteExpenseResponse) GetSuccess() bool {
+func (x *SetMemberActivePeriodResponse) GetMember() *Member {
 	if x != nil {
-		return x.Success
+		return x.Member
 	}
-	return false
+	return nil
 }
 
-type GetGroupExpensesRequest struct {
+// Member merge messages
+// Merging folds a member added twice into the other one. Expenses, shares
+// and payments move to the kept member and the merged member is archived.
+type MergeMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	KeepId        string                 `protobuf:"bytes,2,opt,name=keep_id,json=keepId,proto3" json:"keep_id,omitempty"`
+	MergeId       string                 `protobuf:"bytes,3,opt,name=merge_id,json=mergeId,proto3" json:"merge_id,omitempty"`
+	MergedBy      string                 `protobuf:"bytes,4,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"` // Optional member ID, recorded in the audit trail
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupExpensesRequest) Reset() {
-	*x = GetGroupExpensesRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[20]
+func (x *MergeMembersRequest) Reset() {
+	*x = MergeMembersRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupExpensesRequest) String() string {
+func (x *MergeMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupExpensesRequest) ProtoMessage() {}
+func (*MergeMembersRequest) ProtoMessage() {}
 
-func (x *GetGroupExpensesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[20]
+func (x *MergeMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,40 +9554,61 @@ func (x *GetGroupExpensesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupExpensesRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupExpensesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MergeMembersRequest.ProtoReflect.Descriptor instead.
+func (*MergeMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *GetGroupExpensesRequest) GetGroupId() string {
+func (x *MergeMembersRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-type GetGroupExpensesResponse struct {
+func (x *MergeMembersRequest) GetKeepId() string {
+	if x != nil {
+		return x.KeepId
+	}
+	return ""
+}
+
+func (x *MergeMembersRequest) GetMergeId() string {
+	if x != nil {
+		return x.MergeId
+	}
+	return ""
+}
+
+func (x *MergeMembersRequest) GetMergedBy() string {
+	if x != nil {
+		return x.MergedBy
+	}
+	return ""
+}
+
+type MergeMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expenses      []*ExpenseWithDetails  `protobuf:"bytes,1,rep,name=expenses,proto3" json:"expenses,omitempty"`
+	Merge         *MemberMerge           `protobuf:"bytes,1,opt,name=merge,proto3" json:"merge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupExpensesResponse) Reset() {
-	*x = GetGroupExpensesResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[21]
+func (x *MergeMembersResponse) Reset() {
+	*x = MergeMembersResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupExpensesResponse) String() string {
+func (x *MergeMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupExpensesResponse) ProtoMessage() {}
+func (*MergeMembersResponse) ProtoMessage() {}
 
-func (x *GetGroupExpensesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[21]
+func (x *MergeMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,47 +9619,40 @@ func (x *GetGroupExpensesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupExpensesResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupExpensesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MergeMembersResponse.ProtoReflect.Descriptor instead.
+func (*MergeMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *GetGroupExpensesResponse) GetExpenses() []*ExpenseWithDetails {
+func (x *MergeMembersResponse) GetMerge() *MemberMerge {
 	if x != nil {
-		return x.Expenses
+		return x.Merge
 	}
 	return nil
 }
 
-type ExpenseWithDetails struct {
+type ListMemberMergesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	PaidById      string                 `protobuf:"bytes,5,opt,name=paid_by_id,json=paidById,proto3" json:"paid_by_id,omitempty"`
-	PaidByName    string                 `protobuf:"bytes,6,opt,name=paid_by_name,json=paidByName,proto3" json:"paid_by_name,omitempty"`
-	SplitMembers  []*SplitMember         `protobuf:"bytes,7,rep,name=split_members,json=splitMembers,proto3" json:"split_members,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpenseWithDetails) Reset() {
-	*x = ExpenseWithDetails{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[22]
+func (x *ListMemberMergesRequest) Reset() {
+	*x = ListMemberMergesRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpenseWithDetails) String() string {
+func (x *ListMemberMergesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpenseWithDetails) ProtoMessage() {}
+func (*ListMemberMergesRequest) ProtoMessage() {}
 
-func (x *ExpenseWithDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[22]
+func (x *ListMemberMergesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,91 +9663,92 @@ func (x *ExpenseWithDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpenseWithDetails.ProtoReflect.Descriptor instead.
-func (*ExpenseWithDetails) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ExpenseWithDetails) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListMemberMergesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberMergesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *ExpenseWithDetails) GetGroupId() string {
+func (x *ListMemberMergesRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *ExpenseWithDetails) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+type ListMemberMergesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merges        []*MemberMerge         `protobuf:"bytes,1,rep,name=merges,proto3" json:"merges,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpenseWithDetails) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *ListMemberMergesResponse) Reset() {
+	*x = ListMemberMergesResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpenseWithDetails) GetPaidById() string {
-	if x != nil {
-		return x.PaidById
-	}
-	return ""
+func (x *ListMemberMergesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ExpenseWithDetails) GetPaidByName() string {
+func (*ListMemberMergesResponse) ProtoMessage() {}
+
+func (x *ListMemberMergesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[146]
 	if x != nil {
-		return x.PaidByName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExpenseWithDetails) GetSplitMembers() []*SplitMember {
-	if x != nil {
-		return x.SplitMembers
-	}
-	return nil
+// Deprecated: Use ListMemberMergesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberMergesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *ExpenseWithDetails) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListMemberMergesResponse) GetMerges() []*MemberMerge {
 	if x != nil {
-		return x.CreatedAt
+		return x.Merges
 	}
 	return nil
 }
 
-type SplitMember struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount owed by this member in cents (JPY)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SplitMember) Reset() {
-	*x = SplitMember{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[23]
+type MemberMerge struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId          string                 `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	KeptMemberId     string                 `protobuf:"bytes,3,opt,name=kept_member_id,json=keptMemberId,proto3" json:"kept_member_id,omitempty"`
+	KeptMemberName   string                 `protobuf:"bytes,4,opt,name=kept_member_name,json=keptMemberName,proto3" json:"kept_member_name,omitempty"`
+	MergedMemberId   string                 `protobuf:"bytes,5,opt,name=merged_member_id,json=mergedMemberId,proto3" json:"merged_member_id,omitempty"`
+	MergedMemberName string                 `protobuf:"bytes,6,opt,name=merged_member_name,json=mergedMemberName,proto3" json:"merged_member_name,omitempty"`
+	ExpenseCount     int32                  `protobuf:"varint,7,opt,name=expense_count,json=expenseCount,proto3" json:"expense_count,omitempty"` // Expenses the merged member paid or shared in
+	MergedBy         string                 `protobuf:"bytes,8,opt,name=merged_by,json=mergedBy,proto3" json:"merged_by,omitempty"`
+	MergedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MemberMerge) Reset() {
+	*x = MemberMerge{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SplitMember) String() string {
+func (x *MemberMerge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SplitMember) ProtoMessage() {}
+func (*MemberMerge) ProtoMessage() {}
 
-func (x *SplitMember) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[23]
+func (x *MemberMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,108 +9759,99 @@ func (x *SplitMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SplitMember.ProtoReflect.Descriptor instead.
-func (*SplitMember) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use MemberMerge.ProtoReflect.Descriptor instead.
+func (*MemberMerge) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *SplitMember) GetMemberId() string {
+func (x *MemberMerge) GetId() string {
 	if x != nil {
-		return x.MemberId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SplitMember) GetMemberName() string {
+func (x *MemberMerge) GetGroupId() string {
 	if x != nil {
-		return x.MemberName
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *SplitMember) GetAmount() int64 {
+func (x *MemberMerge) GetKeptMemberId() string {
 	if x != nil {
-		return x.Amount
+		return x.KeptMemberId
 	}
-	return 0
-}
-
-// Settlement calculation messages
-type CalculateSettlementsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Expenses      []*Expense             `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *CalculateSettlementsRequest) Reset() {
-	*x = CalculateSettlementsRequest{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MemberMerge) GetKeptMemberName() string {
+	if x != nil {
+		return x.KeptMemberName
+	}
+	return ""
 }
 
-func (x *CalculateSettlementsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MemberMerge) GetMergedMemberId() string {
+	if x != nil {
+		return x.MergedMemberId
+	}
+	return ""
 }
 
-func (*CalculateSettlementsRequest) ProtoMessage() {}
-
-func (x *CalculateSettlementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[24]
+func (x *MemberMerge) GetMergedMemberName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MergedMemberName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CalculateSettlementsRequest.ProtoReflect.Descriptor instead.
-func (*CalculateSettlementsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{24}
+func (x *MemberMerge) GetExpenseCount() int32 {
+	if x != nil {
+		return x.ExpenseCount
+	}
+	return 0
 }
 
-func (x *CalculateSettlementsRequest) GetGroupId() string {
+func (x *MemberMerge) GetMergedBy() string {
 	if x != nil {
-		return x.GroupId
+		return x.MergedBy
 	}
 	return ""
 }
 
-func (x *CalculateSettlementsRequest) GetExpenses() []*Expense {
+func (x *MemberMerge) GetMergedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Expenses
+		return x.MergedAt
 	}
 	return nil
 }
 
-type CalculateSettlementsResponse struct {
+// Members settling up as one unit, such as a couple or a family
+type Household struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settlements   []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
-	Balances      []*MemberBalance       `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"` // A member belongs to one household at most
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateSettlementsResponse) Reset() {
-	*x = CalculateSettlementsResponse{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[25]
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateSettlementsResponse) String() string {
+func (x *Household) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateSettlementsResponse) ProtoMessage() {}
+func (*Household) ProtoMessage() {}
 
-func (x *CalculateSettlementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[25]
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,52 +9862,58 @@ func (x *CalculateSettlementsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateSettlementsResponse.ProtoReflect.Descriptor instead.
-func (*CalculateSettlementsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *CalculateSettlementsResponse) GetSettlements() []*Settlement {
+func (x *Household) GetId() string {
 	if x != nil {
-		return x.Settlements
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *CalculateSettlementsResponse) GetBalances() []*MemberBalance {
+func (x *Household) GetName() string {
 	if x != nil {
-		return x.Balances
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
 	}
 	return nil
 }
 
-type Expense struct {
+// Balance of a household, or of a member outside any household
+type HouseholdBalance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PayerId       string                 `protobuf:"bytes,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	SplitBetween  []string               `protobuf:"bytes,5,rep,name=split_between,json=splitBetween,proto3" json:"split_between,omitempty"` // Member IDs
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // Empty for a member outside any household
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Balance       int64                  `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"` // Positive = owed money, Negative = owes money
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Expense) Reset() {
-	*x = Expense{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[26]
+func (x *HouseholdBalance) Reset() {
+	*x = HouseholdBalance{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Expense) String() string {
+func (x *HouseholdBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Expense) ProtoMessage() {}
+func (*HouseholdBalance) ProtoMessage() {}
 
-func (x *Expense) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[26]
+func (x *HouseholdBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,79 +9924,61 @@ func (x *Expense) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Expense.ProtoReflect.Descriptor instead.
-func (*Expense) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *Expense) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use HouseholdBalance.ProtoReflect.Descriptor instead.
+func (*HouseholdBalance) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *Expense) GetPayerId() string {
+func (x *HouseholdBalance) GetHouseholdId() string {
 	if x != nil {
-		return x.PayerId
+		return x.HouseholdId
 	}
 	return ""
 }
 
-func (x *Expense) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Expense) GetDescription() string {
+func (x *HouseholdBalance) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Expense) GetSplitBetween() []string {
+func (x *HouseholdBalance) GetMemberIds() []string {
 	if x != nil {
-		return x.SplitBetween
+		return x.MemberIds
 	}
 	return nil
 }
 
-func (x *Expense) GetCreatedAt() *timestamppb.Timestamp {
+func (x *HouseholdBalance) GetBalance() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Balance
 	}
-	return nil
+	return 0
 }
 
-type Settlement struct {
+type GetHouseholdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromMemberId  string                 `protobuf:"bytes,1,opt,name=from_member_id,json=fromMemberId,proto3" json:"from_member_id,omitempty"`
-	ToMemberId    string                 `protobuf:"bytes,2,opt,name=to_member_id,json=toMemberId,proto3" json:"to_member_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents (JPY)
-	FromName      string                 `protobuf:"bytes,4,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
-	ToName        string                 `protobuf:"bytes,5,opt,name=to_name,json=toName,proto3" json:"to_name,omitempty"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Settlement) Reset() {
-	*x = Settlement{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[27]
+func (x *GetHouseholdsRequest) Reset() {
+	*x = GetHouseholdsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settlement) String() string {
+func (x *GetHouseholdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Settlement) ProtoMessage() {}
+func (*GetHouseholdsRequest) ProtoMessage() {}
 
-func (x *Settlement) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[27]
+func (x *GetHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,70 +9989,86 @@ func (x *Settlement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
-func (*Settlement) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use GetHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *Settlement) GetFromMemberId() string {
+func (x *GetHouseholdsRequest) GetGroupId() string {
 	if x != nil {
-		return x.FromMemberId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *Settlement) GetToMemberId() string {
-	if x != nil {
-		return x.ToMemberId
-	}
-	return ""
+type GetHouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Settlement) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *GetHouseholdsResponse) Reset() {
+	*x = GetHouseholdsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Settlement) GetFromName() string {
+func (x *GetHouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdsResponse) ProtoMessage() {}
+
+func (x *GetHouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[151]
 	if x != nil {
-		return x.FromName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Settlement) GetToName() string {
+// Deprecated: Use GetHouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*GetHouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetHouseholdsResponse) GetHouseholds() []*Household {
 	if x != nil {
-		return x.ToName
+		return x.Households
 	}
-	return ""
+	return nil
 }
 
-type MemberBalance struct {
+// Replaces all households of the group; households without an ID are new
+type UpdateHouseholdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	MemberName    string                 `protobuf:"bytes,2,opt,name=member_name,json=memberName,proto3" json:"member_name,omitempty"`
-	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"` // Positive = owed money, Negative = owes money
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Households    []*Household           `protobuf:"bytes,2,rep,name=households,proto3" json:"households,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemberBalance) Reset() {
-	*x = MemberBalance{}
-	mi := &file_proto_group_v1_group_proto_msgTypes[28]
+func (x *UpdateHouseholdsRequest) Reset() {
+	*x = UpdateHouseholdsRequest{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemberBalance) String() string {
+func (x *UpdateHouseholdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemberBalance) ProtoMessage() {}
+func (*UpdateHouseholdsRequest) ProtoMessage() {}
 
-func (x *MemberBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_v1_group_proto_msgTypes[28]
+func (x *UpdateHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1658,30 +10079,67 @@ func (x *MemberBalance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemberBalance.ProtoReflect.Descriptor instead.
-func (*MemberBalance) Descriptor() ([]byte, []int) {
-	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use UpdateHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *MemberBalance) GetMemberId() string {
+func (x *UpdateHouseholdsRequest) GetGroupId() string {
 	if x != nil {
-		return x.MemberId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *MemberBalance) GetMemberName() string {
+func (x *UpdateHouseholdsRequest) GetHouseholds() []*Household {
 	if x != nil {
-		return x.MemberName
+		return x.Households
 	}
-	return ""
+	return nil
 }
 
-func (x *MemberBalance) GetBalance() int64 {
+type UpdateHouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*Household           `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdsResponse) Reset() {
+	*x = UpdateHouseholdsResponse{}
+	mi := &file_proto_group_v1_group_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdsResponse) ProtoMessage() {}
+
+func (x *UpdateHouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_v1_group_proto_msgTypes[153]
 	if x != nil {
-		return x.Balance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_group_v1_group_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *UpdateHouseholdsResponse) GetHouseholds() []*Household {
+	if x != nil {
+		return x.Households
+	}
+	return nil
 }
 
 var File_proto_group_v1_group_proto protoreflect.FileDescriptor
@@ -1698,12 +10156,17 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12*\n" +
-	"\amembers\x18\a \x03(\v2\x10.group.v1.MemberR\amembers\"{\n" +
+	"\amembers\x18\a \x03(\v2\x10.group.v1.MemberR\amembers\"\xb4\x02\n" +
 	"\x06Member\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x127\n" +
-	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\x89\x01\n" +
+	"\tjoined_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\x12;\n" +
+	"\vactive_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activeFrom\x12=\n" +
+	"\factive_until\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vactiveUntil\x12\x14\n" +
+	"\x05color\x18\a \x01(\tR\x05color\x12%\n" +
+	"\x0epayment_handle\x18\b \x01(\tR\rpaymentHandle\"\x89\x01\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
@@ -1732,21 +10195,54 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"memberName\x12!\n" +
 	"\fmember_email\x18\x03 \x01(\tR\vmemberEmail\"=\n" +
 	"\x11AddMemberResponse\x12(\n" +
-	"\x06member\x18\x01 \x01(\v2\x10.group.v1.MemberR\x06member\"M\n" +
+	"\x06member\x18\x01 \x01(\v2\x10.group.v1.MemberR\x06member\"\x90\x01\n" +
 	"\x13RemoveMemberRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
-	"\tmember_id\x18\x02 \x01(\tR\bmemberId\"0\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12A\n" +
+	"\x0eredistribution\x18\x03 \x03(\v2\x19.group.v1.BalanceTransferR\x0eredistribution\"F\n" +
+	"\x0fBalanceTransfer\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"l\n" +
 	"\x14RemoveMemberResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb0\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12:\n" +
+	"\ttransfers\x18\x02 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\ttransfers\"\xb4\x01\n" +
+	"\x13UpdateMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05color\x18\x05 \x01(\tR\x05color\x12%\n" +
+	"\x0epayment_handle\x18\x06 \x01(\tR\rpaymentHandle\"@\n" +
+	"\x14UpdateMemberResponse\x12(\n" +
+	"\x06member\x18\x01 \x01(\v2\x10.group.v1.MemberR\x06member\"\xa3\x03\n" +
 	"\x11AddExpenseRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
 	"\n" +
 	"paid_by_id\x18\x04 \x01(\tR\bpaidById\x12(\n" +
-	"\x10split_member_ids\x18\x05 \x03(\tR\x0esplitMemberIds\"L\n" +
+	"\x10split_member_ids\x18\x05 \x03(\tR\x0esplitMemberIds\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\a \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12#\n" +
+	"\rsplit_amounts\x18\b \x03(\x03R\fsplitAmounts\x12'\n" +
+	"\x0fallow_duplicate\x18\t \x01(\bR\x0eallowDuplicate\x12.\n" +
+	"\x13split_among_present\x18\n" +
+	" \x01(\bR\x11splitAmongPresent\x12,\n" +
+	"\x12split_by_household\x18\v \x01(\bR\x10splitByHousehold\"\xd7\x01\n" +
 	"\x12AddExpenseResponse\x126\n" +
-	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"\xb7\x01\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x12:\n" +
+	"\rbudget_alerts\x18\x02 \x03(\v2\x15.group.v1.BudgetAlertR\fbudgetAlerts\x12M\n" +
+	"\x13probable_duplicates\x18\x03 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\x12probableDuplicates\"h\n" +
+	"\x12AddExpensesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x127\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x1b.group.v1.AddExpenseRequestR\bexpenses\"\x7f\n" +
+	"\x13AddExpensesResponse\x128\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\bexpenses\x12.\n" +
+	"\x06errors\x18\x02 \x03(\v2\x16.group.v1.ExpenseErrorR\x06errors\"T\n" +
+	"\fExpenseError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xfb\x02\n" +
 	"\x14UpdateExpenseRequest\x12\x1d\n" +
 	"\n" +
 	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x16\n" +
@@ -1754,18 +10250,48 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
 	"\n" +
 	"paid_by_id\x18\x04 \x01(\tR\bpaidById\x12(\n" +
-	"\x10split_member_ids\x18\x05 \x03(\tR\x0esplitMemberIds\"O\n" +
+	"\x10split_member_ids\x18\x05 \x03(\tR\x0esplitMemberIds\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\a \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x12.\n" +
+	"\x13split_among_present\x18\t \x01(\bR\x11splitAmongPresent\x12,\n" +
+	"\x12split_by_household\x18\n" +
+	" \x01(\bR\x10splitByHousehold\"\x8b\x01\n" +
 	"\x15UpdateExpenseResponse\x126\n" +
-	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"5\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x12:\n" +
+	"\rbudget_alerts\x18\x02 \x03(\v2\x15.group.v1.BudgetAlertR\fbudgetAlerts\"T\n" +
 	"\x14DeleteExpenseRequest\x12\x1d\n" +
 	"\n" +
-	"expense_id\x18\x01 \x01(\tR\texpenseId\"1\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x02 \x01(\tR\tdeletedBy\"1\n" +
 	"\x15DeleteExpenseResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x96\x02\n" +
 	"\x17GetGroupExpensesRequest\x12\x19\n" +
-	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"T\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12/\n" +
+	"\x06filter\x18\x02 \x01(\v2\x17.group.v1.ExpenseFilterR\x06filter\x123\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\x1a.group.v1.ExpenseSortFieldR\x06sortBy\x12>\n" +
+	"\x0esort_direction\x18\x04 \x01(\x0e2\x17.group.v1.SortDirectionR\rsortDirection\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x96\x01\n" +
 	"\x18GetGroupExpensesResponse\x128\n" +
-	"\bexpenses\x18\x01 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\bexpenses\"\xb0\x02\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\bexpenses\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\acursors\x18\x03 \x03(\tR\acursors\"\xb9\x02\n" +
+	"\rExpenseFilter\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x19\n" +
+	"\bpayer_id\x18\x03 \x01(\tR\apayerId\x12%\n" +
+	"\x0eparticipant_id\x18\x04 \x01(\tR\rparticipantId\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x03R\tmaxAmount\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04text\x18\b \x01(\tR\x04text\"\xba\x03\n" +
 	"\x12ExpenseWithDetails\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x16\n" +
@@ -1777,18 +10303,26 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"paidByName\x12:\n" +
 	"\rsplit_members\x18\a \x03(\v2\x15.group.v1.SplitMemberR\fsplitMembers\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"c\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\n" +
+	" \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12A\n" +
+	"\x0fapproval_status\x18\v \x01(\x0e2\x18.group.v1.ApprovalStatusR\x0eapprovalStatus\"\xc6\x01\n" +
 	"\vSplitMember\x12\x1b\n" +
 	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1f\n" +
 	"\vmember_name\x18\x02 \x01(\tR\n" +
 	"memberName\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x03R\x06amount\"g\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12*\n" +
+	"\x04vote\x18\x04 \x01(\x0e2\x16.group.v1.ApprovalVoteR\x04vote\x125\n" +
+	"\bvoted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\avotedAt\"\x8a\x01\n" +
 	"\x1bCalculateSettlementsRequest\x12\x19\n" +
 	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12-\n" +
-	"\bexpenses\x18\x02 \x03(\v2\x11.group.v1.ExpenseR\bexpenses\"\x8b\x01\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x11.group.v1.ExpenseR\bexpenses\x12!\n" +
+	"\fby_household\x18\x03 \x01(\bR\vbyHousehold\"\xd6\x01\n" +
 	"\x1cCalculateSettlementsResponse\x126\n" +
 	"\vsettlements\x18\x01 \x03(\v2\x14.group.v1.SettlementR\vsettlements\x123\n" +
-	"\bbalances\x18\x02 \x03(\v2\x17.group.v1.MemberBalanceR\bbalances\"\xce\x01\n" +
+	"\bbalances\x18\x02 \x03(\v2\x17.group.v1.MemberBalanceR\bbalances\x12I\n" +
+	"\x12household_balances\x18\x03 \x03(\v2\x1a.group.v1.HouseholdBalanceR\x11householdBalances\"\x9e\x02\n" +
 	"\aExpense\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bpayer_id\x18\x02 \x01(\tR\apayerId\x12\x16\n" +
@@ -1796,7 +10330,9 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
 	"\rsplit_between\x18\x05 \x03(\tR\fsplitBetween\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12)\n" +
+	"\x04kind\x18\a \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12#\n" +
+	"\rsplit_amounts\x18\b \x03(\x03R\fsplitAmounts\"\xf6\x01\n" +
 	"\n" +
 	"Settlement\x12$\n" +
 	"\x0efrom_member_id\x18\x01 \x01(\tR\ffromMemberId\x12 \n" +
@@ -1804,25 +10340,665 @@ const file_proto_group_v1_group_proto_rawDesc = "" +
 	"toMemberId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1b\n" +
 	"\tfrom_name\x18\x04 \x01(\tR\bfromName\x12\x17\n" +
-	"\ato_name\x18\x05 \x01(\tR\x06toName\"g\n" +
+	"\ato_name\x18\x05 \x01(\tR\x06toName\x12*\n" +
+	"\x11from_household_id\x18\x06 \x01(\tR\x0ffromHouseholdId\x12&\n" +
+	"\x0fto_household_id\x18\a \x01(\tR\rtoHouseholdId\"g\n" +
 	"\rMemberBalance\x12\x1b\n" +
 	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1f\n" +
 	"\vmember_name\x18\x02 \x01(\tR\n" +
 	"memberName\x12\x18\n" +
-	"\abalance\x18\x03 \x01(\x03R\abalance2\xf9\x06\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\"\xd5\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"s\n" +
+	"\x12AttachmentMetadata\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"u\n" +
+	"\x17UploadAttachmentRequest\x12:\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1c.group.v1.AttachmentMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"P\n" +
+	"\x18UploadAttachmentResponse\x124\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x14.group.v1.AttachmentR\n" +
+	"attachment\">\n" +
+	"\x1dListExpenseAttachmentsRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"X\n" +
+	"\x1eListExpenseAttachmentsResponse\x126\n" +
+	"\vattachments\x18\x01 \x03(\v2\x14.group.v1.AttachmentR\vattachments\"@\n" +
+	"\x19DownloadAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"t\n" +
+	"\x1aDownloadAttachmentResponse\x126\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x14.group.v1.AttachmentH\x00R\n" +
+	"attachment\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\">\n" +
+	"\x17DeleteAttachmentRequest\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\"4\n" +
+	"\x18DeleteAttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x02 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tmember_id\x18\x03 \x01(\tR\bmemberId\x12\x1f\n" +
+	"\vmember_name\x18\x04 \x01(\tR\n" +
+	"memberName\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"j\n" +
+	"\x18AddExpenseCommentRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"H\n" +
+	"\x19AddExpenseCommentResponse\x12+\n" +
+	"\acomment\x18\x01 \x01(\v2\x11.group.v1.CommentR\acomment\"m\n" +
+	"\x1bUpdateExpenseCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"K\n" +
+	"\x1cUpdateExpenseCommentResponse\x12+\n" +
+	"\acomment\x18\x01 \x01(\v2\x11.group.v1.CommentR\acomment\"Y\n" +
+	"\x1bDeleteExpenseCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\"8\n" +
+	"\x1cDeleteExpenseCommentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\x1aListExpenseCommentsRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"L\n" +
+	"\x1bListExpenseCommentsResponse\x12-\n" +
+	"\bcomments\x18\x01 \x03(\v2\x11.group.v1.CommentR\bcomments\"^\n" +
+	"\x15SearchExpensesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Q\n" +
+	"\x16SearchExpensesResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.group.v1.ExpenseSearchResultR\aresults\"\x9e\x01\n" +
+	"\x13ExpenseSearchResult\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x129\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x19.group.v1.SearchHighlightR\n" +
+	"highlights\"\x87\x01\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12+\n" +
+	"\x06ranges\x18\x03 \x03(\v2\x13.group.v1.TextRangeR\x06ranges\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x04 \x01(\tR\tcommentId\"3\n" +
+	"\tTextRange\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\x86\x02\n" +
+	"\x0eDeletedExpense\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\"\n" +
+	"\rdeleted_by_id\x18\x03 \x01(\tR\vdeletedById\x12&\n" +
+	"\x0fdeleted_by_name\x18\x04 \x01(\tR\rdeletedByName\x125\n" +
+	"\bpurge_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"7\n" +
+	"\x1aListDeletedExpensesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"S\n" +
+	"\x1bListDeletedExpensesResponse\x124\n" +
+	"\bexpenses\x18\x01 \x03(\v2\x18.group.v1.DeletedExpenseR\bexpenses\"6\n" +
+	"\x15RestoreExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"P\n" +
+	"\x16RestoreExpenseResponse\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"\x87\x04\n" +
+	"\x0fExpenseRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12\x1c\n" +
+	"\n" +
+	"paid_by_id\x18\x06 \x01(\tR\bpaidById\x12 \n" +
+	"\fpaid_by_name\x18\a \x01(\tR\n" +
+	"paidByName\x12:\n" +
+	"\rsplit_members\x18\b \x03(\v2\x15.group.v1.SplitMemberR\fsplitMembers\x12\"\n" +
+	"\rchanged_by_id\x18\t \x01(\tR\vchangedById\x12&\n" +
+	"\x0fchanged_by_name\x18\n" +
+	" \x01(\tR\rchangedByName\x12#\n" +
+	"\rreverted_from\x18\v \x01(\x05R\frevertedFrom\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12/\n" +
+	"\achanges\x18\r \x03(\v2\x15.group.v1.FieldChangeR\achanges\"\x9b\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\x12\x1b\n" +
+	"\tmember_id\x18\x04 \x01(\tR\bmemberId\x12\x1f\n" +
+	"\vmember_name\x18\x05 \x01(\tR\n" +
+	"memberName\"9\n" +
+	"\x18GetExpenseHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\"T\n" +
+	"\x19GetExpenseHistoryResponse\x127\n" +
+	"\trevisions\x18\x01 \x03(\v2\x19.group.v1.ExpenseRevisionR\trevisions\"r\n" +
+	"\x14RevertExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x1f\n" +
+	"\vreverted_by\x18\x03 \x01(\tR\n" +
+	"revertedBy\"O\n" +
+	"\x15RevertExpenseResponse\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"\xcb\x01\n" +
+	"\x18ImportExpensesCsvRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\x124\n" +
+	"\amapping\x18\x03 \x01(\v2\x1a.group.v1.CsvColumnMappingR\amapping\x123\n" +
+	"\x15participant_separator\x18\x04 \x01(\tR\x14participantSeparator\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\xb9\x01\n" +
+	"\x10CsvColumnMapping\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\tR\x06amount\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x17\n" +
+	"\apaid_by\x18\x03 \x01(\tR\x06paidBy\x12\"\n" +
+	"\fparticipants\x18\x04 \x01(\tR\fparticipants\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04date\x18\x06 \x01(\tR\x04date\"\xea\x01\n" +
+	"\x19ImportExpensesCsvResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x128\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\bexpenses\x12-\n" +
+	"\x06errors\x18\x03 \x03(\v2\x15.group.v1.CsvRowErrorR\x06errors\x12'\n" +
+	"\x0funmatched_names\x18\x04 \x03(\tR\x0eunmatchedNames\x12\x1c\n" +
+	"\tcommitted\x18\x05 \x01(\bR\tcommitted\"S\n" +
+	"\vCsvRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x84\x01\n" +
+	"\x16ImportSplitwiseRequest\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x01 \x01(\tR\tgroupName\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03csv\x18\x03 \x01(\fR\x03csv\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"\xea\x02\n" +
+	"\x17ImportSplitwiseResponse\x12%\n" +
+	"\x05group\x18\x01 \x01(\v2\x0f.group.v1.GroupR\x05group\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x128\n" +
+	"\bexpenses\x18\x03 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\bexpenses\x12-\n" +
+	"\x06errors\x18\x04 \x03(\v2\x15.group.v1.CsvRowErrorR\x06errors\x12#\n" +
+	"\rskipped_lines\x18\x05 \x03(\x05R\fskippedLines\x126\n" +
+	"\bbalances\x18\x06 \x03(\v2\x1a.group.v1.SplitwiseBalanceR\bbalances\x12%\n" +
+	"\x0ebalances_match\x18\a \x01(\bR\rbalancesMatch\x12\x1c\n" +
+	"\tcommitted\x18\b \x01(\bR\tcommitted\"\xa8\x01\n" +
+	"\x10SplitwiseBalance\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1f\n" +
+	"\vmember_name\x18\x02 \x01(\tR\n" +
+	"memberName\x12)\n" +
+	"\x10imported_balance\x18\x03 \x01(\x03R\x0fimportedBalance\x12+\n" +
+	"\x11splitwise_balance\x18\x04 \x01(\x03R\x10splitwiseBalance\"\x99\x01\n" +
+	"\x12ExportGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12.\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x16.group.v1.ExportFormatR\x06format\x128\n" +
+	"\n" +
+	"csv_layout\x18\x03 \x01(\x0e2\x19.group.v1.ExportCsvLayoutR\tcsvLayout\"m\n" +
+	"\x13ExportGroupResponse\x126\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x18.group.v1.ExportMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"P\n" +
+	"\x0eExportMetadata\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"j\n" +
+	"\x1dRenderSettlementReportRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12.\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x16.group.v1.ReportFormatR\x06format\"z\n" +
+	"\x1eRenderSettlementReportResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"F\n" +
+	"\x0eAccountMapping\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\"6\n" +
+	"\x19GetAccountMappingsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"R\n" +
+	"\x1aGetAccountMappingsResponse\x124\n" +
+	"\bmappings\x18\x01 \x03(\v2\x18.group.v1.AccountMappingR\bmappings\"o\n" +
+	"\x1cUpdateAccountMappingsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x124\n" +
+	"\bmappings\x18\x02 \x03(\v2\x18.group.v1.AccountMappingR\bmappings\"U\n" +
+	"\x1dUpdateAccountMappingsResponse\x124\n" +
+	"\bmappings\x18\x01 \x03(\v2\x18.group.v1.AccountMappingR\bmappings\"\x93\x01\n" +
+	"\x14ExportJournalRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12/\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x17.group.v1.JournalFormatR\x06format\x12/\n" +
+	"\x13include_settlements\x18\x03 \x01(\bR\x12includeSettlements\"\x92\x01\n" +
+	"\x15ExportJournalResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x1f\n" +
+	"\ventry_count\x18\x04 \x01(\x05R\n" +
+	"entryCount\"\x99\x03\n" +
+	"\vBankAccount\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1f\n" +
+	"\vmember_name\x18\x02 \x01(\tR\n" +
+	"memberName\x12\x1b\n" +
+	"\tbank_code\x18\x03 \x01(\tR\bbankCode\x12\x1b\n" +
+	"\tbank_name\x18\x04 \x01(\tR\bbankName\x12\x1f\n" +
+	"\vbranch_code\x18\x05 \x01(\tR\n" +
+	"branchCode\x12\x1f\n" +
+	"\vbranch_name\x18\x06 \x01(\tR\n" +
+	"branchName\x12<\n" +
+	"\faccount_type\x18\a \x01(\x0e2\x19.group.v1.BankAccountTypeR\vaccountType\x120\n" +
+	"\x14account_number_last4\x18\b \x01(\tR\x12accountNumberLast4\x12%\n" +
+	"\x0eaccount_holder\x18\t \x01(\tR\raccountHolder\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdd\x02\n" +
+	"\x1bSetMemberBankAccountRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12\x1b\n" +
+	"\tbank_code\x18\x03 \x01(\tR\bbankCode\x12\x1b\n" +
+	"\tbank_name\x18\x04 \x01(\tR\bbankName\x12\x1f\n" +
+	"\vbranch_code\x18\x05 \x01(\tR\n" +
+	"branchCode\x12\x1f\n" +
+	"\vbranch_name\x18\x06 \x01(\tR\n" +
+	"branchName\x12<\n" +
+	"\faccount_type\x18\a \x01(\x0e2\x19.group.v1.BankAccountTypeR\vaccountType\x12%\n" +
+	"\x0eaccount_number\x18\b \x01(\tR\raccountNumber\x12%\n" +
+	"\x0eaccount_holder\x18\t \x01(\tR\raccountHolder\"X\n" +
+	"\x1cSetMemberBankAccountResponse\x128\n" +
+	"\fbank_account\x18\x01 \x01(\v2\x15.group.v1.BankAccountR\vbankAccount\"X\n" +
+	"\x1eDeleteMemberBankAccountRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\";\n" +
+	"\x1fDeleteMemberBankAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x17ListBankAccountsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"V\n" +
+	"\x18ListBankAccountsResponse\x12:\n" +
+	"\rbank_accounts\x18\x01 \x03(\v2\x15.group.v1.BankAccountR\fbankAccounts\"\xf0\x01\n" +
+	"\x1cExportZenginTransfersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12&\n" +
+	"\x0fpayer_member_id\x18\x02 \x01(\tR\rpayerMemberId\x12%\n" +
+	"\x0erequester_code\x18\x03 \x01(\tR\rrequesterCode\x12%\n" +
+	"\x0erequester_name\x18\x04 \x01(\tR\rrequesterName\x12?\n" +
+	"\rtransfer_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ftransferDate\"\xc3\x01\n" +
+	"\x1dExportZenginTransfersResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12%\n" +
+	"\x0etransfer_count\x18\x04 \x01(\x05R\rtransferCount\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x03R\vtotalAmount\"f\n" +
+	"\x0eTemplateMember\x12\x1b\n" +
+	"\tmember_id\x18\x01 \x01(\tR\bmemberId\x12\x1f\n" +
+	"\vmember_name\x18\x02 \x01(\tR\n" +
+	"memberName\x12\x16\n" +
+	"\x06shares\x18\x03 \x01(\x05R\x06shares\"\xef\x03\n" +
+	"\x0fExpenseTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\a \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12\x1c\n" +
+	"\n" +
+	"paid_by_id\x18\b \x01(\tR\bpaidById\x12 \n" +
+	"\fpaid_by_name\x18\t \x01(\tR\n" +
+	"paidByName\x122\n" +
+	"\n" +
+	"split_mode\x18\n" +
+	" \x01(\x0e2\x13.group.v1.SplitModeR\tsplitMode\x122\n" +
+	"\amembers\x18\v \x03(\v2\x18.group.v1.TemplateMemberR\amembers\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xed\x02\n" +
+	"\x1cCreateExpenseTemplateRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\x06 \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12\x1c\n" +
+	"\n" +
+	"paid_by_id\x18\a \x01(\tR\bpaidById\x12(\n" +
+	"\x10split_member_ids\x18\b \x03(\tR\x0esplitMemberIds\x122\n" +
+	"\n" +
+	"split_mode\x18\t \x01(\x0e2\x13.group.v1.SplitModeR\tsplitMode\x12!\n" +
+	"\fsplit_shares\x18\n" +
+	" \x03(\x05R\vsplitShares\"V\n" +
+	"\x1dCreateExpenseTemplateResponse\x125\n" +
+	"\btemplate\x18\x01 \x01(\v2\x19.group.v1.ExpenseTemplateR\btemplate\"\xf3\x02\n" +
+	"\x1cUpdateExpenseTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12)\n" +
+	"\x04kind\x18\x06 \x01(\x0e2\x15.group.v1.ExpenseKindR\x04kind\x12\x1c\n" +
+	"\n" +
+	"paid_by_id\x18\a \x01(\tR\bpaidById\x12(\n" +
+	"\x10split_member_ids\x18\b \x03(\tR\x0esplitMemberIds\x122\n" +
+	"\n" +
+	"split_mode\x18\t \x01(\x0e2\x13.group.v1.SplitModeR\tsplitMode\x12!\n" +
+	"\fsplit_shares\x18\n" +
+	" \x03(\x05R\vsplitShares\"V\n" +
+	"\x1dUpdateExpenseTemplateResponse\x125\n" +
+	"\btemplate\x18\x01 \x01(\v2\x19.group.v1.ExpenseTemplateR\btemplate\"?\n" +
+	"\x1cDeleteExpenseTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\"9\n" +
+	"\x1dDeleteExpenseTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"8\n" +
+	"\x1bListExpenseTemplatesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"W\n" +
+	"\x1cListExpenseTemplatesResponse\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.group.v1.ExpenseTemplateR\ttemplates\"\x87\x02\n" +
+	"\x1dAddExpenseFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\n" +
+	"paid_by_id\x18\x04 \x01(\tR\bpaidById\x12(\n" +
+	"\x10split_member_ids\x18\x05 \x03(\tR\x0esplitMemberIds\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12'\n" +
+	"\x0fallow_duplicate\x18\a \x01(\bR\x0eallowDuplicate\"\xe3\x01\n" +
+	"\x1eAddExpenseFromTemplateResponse\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x12:\n" +
+	"\rbudget_alerts\x18\x02 \x03(\v2\x15.group.v1.BudgetAlertR\fbudgetAlerts\x12M\n" +
+	"\x13probable_duplicates\x18\x03 \x03(\v2\x1c.group.v1.ExpenseWithDetailsR\x12probableDuplicates\"l\n" +
+	"\x06Budget\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12.\n" +
+	"\x06period\x18\x03 \x01(\x0e2\x16.group.v1.BudgetPeriodR\x06period\"\x89\x02\n" +
+	"\fBudgetStatus\x12(\n" +
+	"\x06budget\x18\x01 \x01(\v2\x10.group.v1.BudgetR\x06budget\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12\x14\n" +
+	"\x05spent\x18\x04 \x01(\x03R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x03R\tremaining\x12!\n" +
+	"\fpercent_used\x18\x06 \x01(\x05R\vpercentUsed\"\xd8\x01\n" +
+	"\vBudgetAlert\x12(\n" +
+	"\x06budget\x18\x01 \x01(\v2\x10.group.v1.BudgetR\x06budget\x12+\n" +
+	"\x11threshold_percent\x18\x02 \x01(\x05R\x10thresholdPercent\x12\x14\n" +
+	"\x05spent\x18\x03 \x01(\x03R\x05spent\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x05 \x01(\tR\texpenseId\".\n" +
+	"\x11GetBudgetsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"@\n" +
+	"\x12GetBudgetsResponse\x12*\n" +
+	"\abudgets\x18\x01 \x03(\v2\x10.group.v1.BudgetR\abudgets\"]\n" +
+	"\x14UpdateBudgetsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12*\n" +
+	"\abudgets\x18\x02 \x03(\v2\x10.group.v1.BudgetR\abudgets\"C\n" +
+	"\x15UpdateBudgetsResponse\x12*\n" +
+	"\abudgets\x18\x01 \x03(\v2\x10.group.v1.BudgetR\abudgets\"_\n" +
+	"\x16GetBudgetStatusRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"M\n" +
+	"\x17GetBudgetStatusResponse\x122\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x16.group.v1.BudgetStatusR\bstatuses\"\x8a\x01\n" +
+	"\x14DuplicateExpensePair\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\x12:\n" +
+	"\tduplicate\x18\x02 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\tduplicate\"9\n" +
+	"\x1cListDuplicateExpensesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"U\n" +
+	"\x1dListDuplicateExpensesResponse\x124\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x1e.group.v1.DuplicateExpensePairR\x05pairs\"\x8d\x01\n" +
+	"\x14MergeExpensesRequest\x12&\n" +
+	"\x0fkeep_expense_id\x18\x01 \x01(\tR\rkeepExpenseId\x120\n" +
+	"\x14duplicate_expense_id\x18\x02 \x01(\tR\x12duplicateExpenseId\x12\x1b\n" +
+	"\tmerged_by\x18\x03 \x01(\tR\bmergedBy\"O\n" +
+	"\x15MergeExpensesResponse\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"q\n" +
+	"\rGroupSettings\x128\n" +
+	"\x18require_expense_approval\x18\x01 \x01(\bR\x16requireExpenseApproval\x12&\n" +
+	"\x0fadmin_member_id\x18\x02 \x01(\tR\radminMemberId\"4\n" +
+	"\x17GetGroupSettingsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"O\n" +
+	"\x18GetGroupSettingsResponse\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.group.v1.GroupSettingsR\bsettings\"l\n" +
+	"\x1aUpdateGroupSettingsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x123\n" +
+	"\bsettings\x18\x02 \x01(\v2\x17.group.v1.GroupSettingsR\bsettings\"R\n" +
+	"\x1bUpdateGroupSettingsResponse\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.group.v1.GroupSettingsR\bsettings\"~\n" +
+	"\x14VoteOnExpenseRequest\x12\x1d\n" +
+	"\n" +
+	"expense_id\x18\x01 \x01(\tR\texpenseId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12*\n" +
+	"\x04vote\x18\x03 \x01(\x0e2\x16.group.v1.ApprovalVoteR\x04vote\"O\n" +
+	"\x15VoteOnExpenseResponse\x126\n" +
+	"\aexpense\x18\x01 \x01(\v2\x1c.group.v1.ExpenseWithDetailsR\aexpense\"\x9c\x04\n" +
+	"\fClosedPeriod\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12=\n" +
+	"\fclosed_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vclosedUntil\x12B\n" +
+	"\x10opening_balances\x18\x05 \x03(\v2\x17.group.v1.MemberBalanceR\x0fopeningBalances\x123\n" +
+	"\bbalances\x18\x06 \x03(\v2\x17.group.v1.MemberBalanceR\bbalances\x126\n" +
+	"\vsettlements\x18\a \x03(\v2\x14.group.v1.SettlementR\vsettlements\x12\x1b\n" +
+	"\tclosed_by\x18\b \x01(\tR\bclosedBy\x127\n" +
+	"\tclosed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x12;\n" +
+	"\vreopened_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reopenedAt\x12\x1f\n" +
+	"\vreopened_by\x18\v \x01(\tR\n" +
+	"reopenedBy\"~\n" +
+	"\x12ClosePeriodRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1b\n" +
+	"\tclosed_by\x18\x03 \x01(\tR\bclosedBy\"E\n" +
+	"\x13ClosePeriodResponse\x12.\n" +
+	"\x06period\x18\x01 \x01(\v2\x16.group.v1.ClosedPeriodR\x06period\"5\n" +
+	"\x18ListClosedPeriodsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"M\n" +
+	"\x19ListClosedPeriodsResponse\x120\n" +
+	"\aperiods\x18\x01 \x03(\v2\x16.group.v1.ClosedPeriodR\aperiods\"S\n" +
+	"\x13ReopenPeriodRequest\x12\x1b\n" +
+	"\tperiod_id\x18\x01 \x01(\tR\bperiodId\x12\x1f\n" +
+	"\vreopened_by\x18\x02 \x01(\tR\n" +
+	"reopenedBy\"F\n" +
+	"\x14ReopenPeriodResponse\x12.\n" +
+	"\x06period\x18\x01 \x01(\v2\x16.group.v1.ClosedPeriodR\x06period\"\xd9\x01\n" +
+	"!CalculateScopedSettlementsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12=\n" +
+	"\fcreated_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1f\n" +
+	"\vexpense_ids\x18\x04 \x03(\tR\n" +
+	"expenseIds\"\xd7\x01\n" +
+	"\"CalculateScopedSettlementsResponse\x126\n" +
+	"\vsettlements\x18\x01 \x03(\v2\x14.group.v1.SettlementR\vsettlements\x123\n" +
+	"\bbalances\x18\x02 \x03(\v2\x17.group.v1.MemberBalanceR\bbalances\x12#\n" +
+	"\rexpense_count\x18\x03 \x01(\x05R\fexpenseCount\x12\x1f\n" +
+	"\vtotal_spent\x18\x04 \x01(\x03R\n" +
+	"totalSpent\"\xd2\x01\n" +
+	"\x1cSetMemberActivePeriodRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\tR\bmemberId\x12;\n" +
+	"\vactive_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"activeFrom\x12=\n" +
+	"\factive_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vactiveUntil\"I\n" +
+	"\x1dSetMemberActivePeriodResponse\x12(\n" +
+	"\x06member\x18\x01 \x01(\v2\x10.group.v1.MemberR\x06member\"\x81\x01\n" +
+	"\x13MergeMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x17\n" +
+	"\akeep_id\x18\x02 \x01(\tR\x06keepId\x12\x19\n" +
+	"\bmerge_id\x18\x03 \x01(\tR\amergeId\x12\x1b\n" +
+	"\tmerged_by\x18\x04 \x01(\tR\bmergedBy\"C\n" +
+	"\x14MergeMembersResponse\x12+\n" +
+	"\x05merge\x18\x01 \x01(\v2\x15.group.v1.MemberMergeR\x05merge\"4\n" +
+	"\x17ListMemberMergesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"I\n" +
+	"\x18ListMemberMergesResponse\x12-\n" +
+	"\x06merges\x18\x01 \x03(\v2\x15.group.v1.MemberMergeR\x06merges\"\xdb\x02\n" +
+	"\vMemberMerge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\tR\agroupId\x12$\n" +
+	"\x0ekept_member_id\x18\x03 \x01(\tR\fkeptMemberId\x12(\n" +
+	"\x10kept_member_name\x18\x04 \x01(\tR\x0ekeptMemberName\x12(\n" +
+	"\x10merged_member_id\x18\x05 \x01(\tR\x0emergedMemberId\x12,\n" +
+	"\x12merged_member_name\x18\x06 \x01(\tR\x10mergedMemberName\x12#\n" +
+	"\rexpense_count\x18\a \x01(\x05R\fexpenseCount\x12\x1b\n" +
+	"\tmerged_by\x18\b \x01(\tR\bmergedBy\x127\n" +
+	"\tmerged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"N\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\tR\tmemberIds\"\x82\x01\n" +
+	"\x10HouseholdBalance\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\tR\tmemberIds\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x03R\abalance\"1\n" +
+	"\x14GetHouseholdsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\"L\n" +
+	"\x15GetHouseholdsResponse\x123\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x13.group.v1.HouseholdR\n" +
+	"households\"i\n" +
+	"\x17UpdateHouseholdsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x123\n" +
+	"\n" +
+	"households\x18\x02 \x03(\v2\x13.group.v1.HouseholdR\n" +
+	"households\"O\n" +
+	"\x18UpdateHouseholdsResponse\x123\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x13.group.v1.HouseholdR\n" +
+	"households*\x9c\x01\n" +
+	"\x10ExpenseSortField\x12\"\n" +
+	"\x1eEXPENSE_SORT_FIELD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dEXPENSE_SORT_FIELD_CREATED_AT\x10\x01\x12\x1d\n" +
+	"\x19EXPENSE_SORT_FIELD_AMOUNT\x10\x02\x12\"\n" +
+	"\x1eEXPENSE_SORT_FIELD_DESCRIPTION\x10\x03*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x01\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x02*x\n" +
+	"\vExpenseKind\x12\x1c\n" +
+	"\x18EXPENSE_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14EXPENSE_KIND_EXPENSE\x10\x01\x12\x17\n" +
+	"\x13EXPENSE_KIND_INCOME\x10\x02\x12\x18\n" +
+	"\x14EXPENSE_KIND_PAYMENT\x10\x03*\\\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_JSON\x10\x02*r\n" +
+	"\x0fExportCsvLayout\x12!\n" +
+	"\x1dEXPORT_CSV_LAYOUT_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aEXPORT_CSV_LAYOUT_EXPENSES\x10\x01\x12\x1c\n" +
+	"\x18EXPORT_CSV_LAYOUT_SPLITS\x10\x02*\\\n" +
+	"\fReportFormat\x12\x1d\n" +
+	"\x19REPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_FORMAT_HTML\x10\x01\x12\x15\n" +
+	"\x11REPORT_FORMAT_PDF\x10\x02*j\n" +
+	"\rJournalFormat\x12\x1e\n" +
+	"\x1aJOURNAL_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14JOURNAL_FORMAT_FREEE\x10\x01\x12\x1f\n" +
+	"\x1bJOURNAL_FORMAT_MONEYFORWARD\x10\x02*\x93\x01\n" +
+	"\x0fBankAccountType\x12!\n" +
+	"\x1dBANK_ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aBANK_ACCOUNT_TYPE_ORDINARY\x10\x01\x12\x1e\n" +
+	"\x1aBANK_ACCOUNT_TYPE_CHECKING\x10\x02\x12\x1d\n" +
+	"\x19BANK_ACCOUNT_TYPE_SAVINGS\x10\x04*T\n" +
+	"\tSplitMode\x12\x1a\n" +
+	"\x16SPLIT_MODE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SPLIT_MODE_EQUAL\x10\x01\x12\x15\n" +
+	"\x11SPLIT_MODE_SHARES\x10\x02*{\n" +
+	"\fBudgetPeriod\x12\x1d\n" +
+	"\x19BUDGET_PERIOD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13BUDGET_PERIOD_TOTAL\x10\x01\x12\x19\n" +
+	"\x15BUDGET_PERIOD_MONTHLY\x10\x02\x12\x18\n" +
+	"\x14BUDGET_PERIOD_WEEKLY\x10\x03*\x8a\x01\n" +
+	"\x0eApprovalStatus\x12\x1f\n" +
+	"\x1bAPPROVAL_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_APPROVED\x10\x01\x12\x1b\n" +
+	"\x17APPROVAL_STATUS_PENDING\x10\x02\x12\x1c\n" +
+	"\x18APPROVAL_STATUS_DISPUTED\x10\x03*c\n" +
+	"\fApprovalVote\x12\x1d\n" +
+	"\x19APPROVAL_VOTE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15APPROVAL_VOTE_APPROVE\x10\x01\x12\x19\n" +
+	"\x15APPROVAL_VOTE_DISPUTE\x10\x022\x99*\n" +
 	"\fGroupService\x12J\n" +
 	"\vCreateGroup\x12\x1c.group.v1.CreateGroupRequest\x1a\x1d.group.v1.CreateGroupResponse\x12A\n" +
 	"\bGetGroup\x12\x19.group.v1.GetGroupRequest\x1a\x1a.group.v1.GetGroupResponse\x12J\n" +
 	"\vUpdateGroup\x12\x1c.group.v1.UpdateGroupRequest\x1a\x1d.group.v1.UpdateGroupResponse\x12J\n" +
 	"\vDeleteGroup\x12\x1c.group.v1.DeleteGroupRequest\x1a\x1d.group.v1.DeleteGroupResponse\x12D\n" +
 	"\tAddMember\x12\x1a.group.v1.AddMemberRequest\x1a\x1b.group.v1.AddMemberResponse\x12M\n" +
-	"\fRemoveMember\x12\x1d.group.v1.RemoveMemberRequest\x1a\x1e.group.v1.RemoveMemberResponse\x12G\n" +
+	"\fRemoveMember\x12\x1d.group.v1.RemoveMemberRequest\x1a\x1e.group.v1.RemoveMemberResponse\x12M\n" +
+	"\fUpdateMember\x12\x1d.group.v1.UpdateMemberRequest\x1a\x1e.group.v1.UpdateMemberResponse\x12G\n" +
 	"\n" +
-	"AddExpense\x12\x1b.group.v1.AddExpenseRequest\x1a\x1c.group.v1.AddExpenseResponse\x12P\n" +
+	"AddExpense\x12\x1b.group.v1.AddExpenseRequest\x1a\x1c.group.v1.AddExpenseResponse\x12J\n" +
+	"\vAddExpenses\x12\x1c.group.v1.AddExpensesRequest\x1a\x1d.group.v1.AddExpensesResponse\x12\\\n" +
+	"\x11ImportExpensesCsv\x12\".group.v1.ImportExpensesCsvRequest\x1a#.group.v1.ImportExpensesCsvResponse\x12V\n" +
+	"\x0fImportSplitwise\x12 .group.v1.ImportSplitwiseRequest\x1a!.group.v1.ImportSplitwiseResponse\x12P\n" +
 	"\rUpdateExpense\x12\x1e.group.v1.UpdateExpenseRequest\x1a\x1f.group.v1.UpdateExpenseResponse\x12P\n" +
 	"\rDeleteExpense\x12\x1e.group.v1.DeleteExpenseRequest\x1a\x1f.group.v1.DeleteExpenseResponse\x12Y\n" +
 	"\x10GetGroupExpenses\x12!.group.v1.GetGroupExpensesRequest\x1a\".group.v1.GetGroupExpensesResponse\x12e\n" +
-	"\x14CalculateSettlements\x12%.group.v1.CalculateSettlementsRequest\x1a&.group.v1.CalculateSettlementsResponseB>Z<github.com/jt-chihara/warikan/backend/proto/group/v1;groupv1b\x06proto3"
+	"\x14CalculateSettlements\x12%.group.v1.CalculateSettlementsRequest\x1a&.group.v1.CalculateSettlementsResponse\x12[\n" +
+	"\x10UploadAttachment\x12!.group.v1.UploadAttachmentRequest\x1a\".group.v1.UploadAttachmentResponse(\x01\x12k\n" +
+	"\x16ListExpenseAttachments\x12'.group.v1.ListExpenseAttachmentsRequest\x1a(.group.v1.ListExpenseAttachmentsResponse\x12a\n" +
+	"\x12DownloadAttachment\x12#.group.v1.DownloadAttachmentRequest\x1a$.group.v1.DownloadAttachmentResponse0\x01\x12Y\n" +
+	"\x10DeleteAttachment\x12!.group.v1.DeleteAttachmentRequest\x1a\".group.v1.DeleteAttachmentResponse\x12\\\n" +
+	"\x11AddExpenseComment\x12\".group.v1.AddExpenseCommentRequest\x1a#.group.v1.AddExpenseCommentResponse\x12e\n" +
+	"\x14UpdateExpenseComment\x12%.group.v1.UpdateExpenseCommentRequest\x1a&.group.v1.UpdateExpenseCommentResponse\x12e\n" +
+	"\x14DeleteExpenseComment\x12%.group.v1.DeleteExpenseCommentRequest\x1a&.group.v1.DeleteExpenseCommentResponse\x12b\n" +
+	"\x13ListExpenseComments\x12$.group.v1.ListExpenseCommentsRequest\x1a%.group.v1.ListExpenseCommentsResponse\x12S\n" +
+	"\x0eSearchExpenses\x12\x1f.group.v1.SearchExpensesRequest\x1a .group.v1.SearchExpensesResponse\x12b\n" +
+	"\x13ListDeletedExpenses\x12$.group.v1.ListDeletedExpensesRequest\x1a%.group.v1.ListDeletedExpensesResponse\x12S\n" +
+	"\x0eRestoreExpense\x12\x1f.group.v1.RestoreExpenseRequest\x1a .group.v1.RestoreExpenseResponse\x12\\\n" +
+	"\x11GetExpenseHistory\x12\".group.v1.GetExpenseHistoryRequest\x1a#.group.v1.GetExpenseHistoryResponse\x12P\n" +
+	"\rRevertExpense\x12\x1e.group.v1.RevertExpenseRequest\x1a\x1f.group.v1.RevertExpenseResponse\x12L\n" +
+	"\vExportGroup\x12\x1c.group.v1.ExportGroupRequest\x1a\x1d.group.v1.ExportGroupResponse0\x01\x12k\n" +
+	"\x16RenderSettlementReport\x12'.group.v1.RenderSettlementReportRequest\x1a(.group.v1.RenderSettlementReportResponse\x12_\n" +
+	"\x12GetAccountMappings\x12#.group.v1.GetAccountMappingsRequest\x1a$.group.v1.GetAccountMappingsResponse\x12h\n" +
+	"\x15UpdateAccountMappings\x12&.group.v1.UpdateAccountMappingsRequest\x1a'.group.v1.UpdateAccountMappingsResponse\x12P\n" +
+	"\rExportJournal\x12\x1e.group.v1.ExportJournalRequest\x1a\x1f.group.v1.ExportJournalResponse\x12e\n" +
+	"\x14SetMemberBankAccount\x12%.group.v1.SetMemberBankAccountRequest\x1a&.group.v1.SetMemberBankAccountResponse\x12n\n" +
+	"\x17DeleteMemberBankAccount\x12(.group.v1.DeleteMemberBankAccountRequest\x1a).group.v1.DeleteMemberBankAccountResponse\x12Y\n" +
+	"\x10ListBankAccounts\x12!.group.v1.ListBankAccountsRequest\x1a\".group.v1.ListBankAccountsResponse\x12h\n" +
+	"\x15ExportZenginTransfers\x12&.group.v1.ExportZenginTransfersRequest\x1a'.group.v1.ExportZenginTransfersResponse\x12h\n" +
+	"\x15CreateExpenseTemplate\x12&.group.v1.CreateExpenseTemplateRequest\x1a'.group.v1.CreateExpenseTemplateResponse\x12h\n" +
+	"\x15UpdateExpenseTemplate\x12&.group.v1.UpdateExpenseTemplateRequest\x1a'.group.v1.UpdateExpenseTemplateResponse\x12h\n" +
+	"\x15DeleteExpenseTemplate\x12&.group.v1.DeleteExpenseTemplateRequest\x1a'.group.v1.DeleteExpenseTemplateResponse\x12e\n" +
+	"\x14ListExpenseTemplates\x12%.group.v1.ListExpenseTemplatesRequest\x1a&.group.v1.ListExpenseTemplatesResponse\x12k\n" +
+	"\x16AddExpenseFromTemplate\x12'.group.v1.AddExpenseFromTemplateRequest\x1a(.group.v1.AddExpenseFromTemplateResponse\x12G\n" +
+	"\n" +
+	"GetBudgets\x12\x1b.group.v1.GetBudgetsRequest\x1a\x1c.group.v1.GetBudgetsResponse\x12P\n" +
+	"\rUpdateBudgets\x12\x1e.group.v1.UpdateBudgetsRequest\x1a\x1f.group.v1.UpdateBudgetsResponse\x12V\n" +
+	"\x0fGetBudgetStatus\x12 .group.v1.GetBudgetStatusRequest\x1a!.group.v1.GetBudgetStatusResponse\x12h\n" +
+	"\x15ListDuplicateExpenses\x12&.group.v1.ListDuplicateExpensesRequest\x1a'.group.v1.ListDuplicateExpensesResponse\x12P\n" +
+	"\rMergeExpenses\x12\x1e.group.v1.MergeExpensesRequest\x1a\x1f.group.v1.MergeExpensesResponse\x12Y\n" +
+	"\x10GetGroupSettings\x12!.group.v1.GetGroupSettingsRequest\x1a\".group.v1.GetGroupSettingsResponse\x12b\n" +
+	"\x13UpdateGroupSettings\x12$.group.v1.UpdateGroupSettingsRequest\x1a%.group.v1.UpdateGroupSettingsResponse\x12P\n" +
+	"\rVoteOnExpense\x12\x1e.group.v1.VoteOnExpenseRequest\x1a\x1f.group.v1.VoteOnExpenseResponse\x12J\n" +
+	"\vClosePeriod\x12\x1c.group.v1.ClosePeriodRequest\x1a\x1d.group.v1.ClosePeriodResponse\x12\\\n" +
+	"\x11ListClosedPeriods\x12\".group.v1.ListClosedPeriodsRequest\x1a#.group.v1.ListClosedPeriodsResponse\x12M\n" +
+	"\fReopenPeriod\x12\x1d.group.v1.ReopenPeriodRequest\x1a\x1e.group.v1.ReopenPeriodResponse\x12w\n" +
+	"\x1aCalculateScopedSettlements\x12+.group.v1.CalculateScopedSettlementsRequest\x1a,.group.v1.CalculateScopedSettlementsResponse\x12h\n" +
+	"\x15SetMemberActivePeriod\x12&.group.v1.SetMemberActivePeriodRequest\x1a'.group.v1.SetMemberActivePeriodResponse\x12M\n" +
+	"\fMergeMembers\x12\x1d.group.v1.MergeMembersRequest\x1a\x1e.group.v1.MergeMembersResponse\x12Y\n" +
+	"\x10ListMemberMerges\x12!.group.v1.ListMemberMergesRequest\x1a\".group.v1.ListMemberMergesResponse\x12P\n" +
+	"\rGetHouseholds\x12\x1e.group.v1.GetHouseholdsRequest\x1a\x1f.group.v1.GetHouseholdsResponse\x12Y\n" +
+	"\x10UpdateHouseholds\x12!.group.v1.UpdateHouseholdsRequest\x1a\".group.v1.UpdateHouseholdsResponseB>Z<github.com/jt-chihara/warikan/backend/proto/group/v1;groupv1b\x06proto3"
 
 var (
 	file_proto_group_v1_group_proto_rawDescOnce sync.Once
@@ -1836,84 +11012,447 @@ func file_proto_group_v1_group_proto_rawDescGZIP() []byte {
 	return file_proto_group_v1_group_proto_rawDescData
 }
 
-var file_proto_group_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_proto_group_v1_group_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_proto_group_v1_group_proto_msgTypes = make([]protoimpl.MessageInfo, 154)
 var file_proto_group_v1_group_proto_goTypes = []any{
-	(*Group)(nil),                        // 0: group.v1.Group
-	(*Member)(nil),                       // 1: group.v1.Member
-	(*CreateGroupRequest)(nil),           // 2: group.v1.CreateGroupRequest
-	(*CreateGroupResponse)(nil),          // 3: group.v1.CreateGroupResponse
-	(*GetGroupRequest)(nil),              // 4: group.v1.GetGroupRequest
-	(*GetGroupResponse)(nil),             // 5: group.v1.GetGroupResponse
-	(*UpdateGroupRequest)(nil),           // 6: group.v1.UpdateGroupRequest
-	(*UpdateGroupResponse)(nil),          // 7: group.v1.UpdateGroupResponse
-	(*DeleteGroupRequest)(nil),           // 8: group.v1.DeleteGroupRequest
-	(*DeleteGroupResponse)(nil),          // 9: group.v1.DeleteGroupResponse
-	(*AddMemberRequest)(nil),             // 10: group.v1.AddMemberRequest
-	(*AddMemberResponse)(nil),            // 11: group.v1.AddMemberResponse
-	(*RemoveMemberRequest)(nil),          // 12: group.v1.RemoveMemberRequest
-	(*RemoveMemberResponse)(nil),         // 13: group.v1.RemoveMemberResponse
-	(*AddExpenseRequest)(nil),            // 14: group.v1.AddExpenseRequest
-	(*AddExpenseResponse)(nil),           // 15: group.v1.AddExpenseResponse
-	(*UpdateExpenseRequest)(nil),         // 16: group.v1.UpdateExpenseRequest
-	(*UpdateExpenseResponse)(nil),        // 17: group.v1.UpdateExpenseResponse
-	(*DeleteExpenseRequest)(nil),         // 18: group.v1.DeleteExpenseRequest
-	(*DeleteExpenseResponse)(nil),        // 19: group.v1.DeleteExpenseResponse
-	(*GetGroupExpensesRequest)(nil),      // 20: group.v1.GetGroupExpensesRequest
-	(*GetGroupExpensesResponse)(nil),     // 21: group.v1.GetGroupExpensesResponse
-	(*ExpenseWithDetails)(nil),           // 22: group.v1.ExpenseWithDetails
-	(*SplitMember)(nil),                  // 23: group.v1.SplitMember
-	(*CalculateSettlementsRequest)(nil),  // 24: group.v1.CalculateSettlementsRequest
-	(*CalculateSettlementsResponse)(nil), // 25: group.v1.CalculateSettlementsResponse
-	(*Expense)(nil),                      // 26: group.v1.Expense
-	(*Settlement)(nil),                   // 27: group.v1.Settlement
-	(*MemberBalance)(nil),                // 28: group.v1.MemberBalance
-	(*timestamppb.Timestamp)(nil),        // 29: google.protobuf.Timestamp
+	(ExpenseSortField)(0),                      // 0: group.v1.ExpenseSortField
+	(SortDirection)(0),                         // 1: group.v1.SortDirection
+	(ExpenseKind)(0),                           // 2: group.v1.ExpenseKind
+	(ExportFormat)(0),                          // 3: group.v1.ExportFormat
+	(ExportCsvLayout)(0),                       // 4: group.v1.ExportCsvLayout
+	(ReportFormat)(0),                          // 5: group.v1.ReportFormat
+	(JournalFormat)(0),                         // 6: group.v1.JournalFormat
+	(BankAccountType)(0),                       // 7: group.v1.BankAccountType
+	(SplitMode)(0),                             // 8: group.v1.SplitMode
+	(BudgetPeriod)(0),                          // 9: group.v1.BudgetPeriod
+	(ApprovalStatus)(0),                        // 10: group.v1.ApprovalStatus
+	(ApprovalVote)(0),                          // 11: group.v1.ApprovalVote
+	(*Group)(nil),                              // 12: group.v1.Group
+	(*Member)(nil),                             // 13: group.v1.Member
+	(*CreateGroupRequest)(nil),                 // 14: group.v1.CreateGroupRequest
+	(*CreateGroupResponse)(nil),                // 15: group.v1.CreateGroupResponse
+	(*GetGroupRequest)(nil),                    // 16: group.v1.GetGroupRequest
+	(*GetGroupResponse)(nil),                   // 17: group.v1.GetGroupResponse
+	(*UpdateGroupRequest)(nil),                 // 18: group.v1.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),                // 19: group.v1.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),                 // 20: group.v1.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),                // 21: group.v1.DeleteGroupResponse
+	(*AddMemberRequest)(nil),                   // 22: group.v1.AddMemberRequest
+	(*AddMemberResponse)(nil),                  // 23: group.v1.AddMemberResponse
+	(*RemoveMemberRequest)(nil),                // 24: group.v1.RemoveMemberRequest
+	(*BalanceTransfer)(nil),                    // 25: group.v1.BalanceTransfer
+	(*RemoveMemberResponse)(nil),               // 26: group.v1.RemoveMemberResponse
+	(*UpdateMemberRequest)(nil),                // 27: group.v1.UpdateMemberRequest
+	(*UpdateMemberResponse)(nil),               // 28: group.v1.UpdateMemberResponse
+	(*AddExpenseRequest)(nil),                  // 29: group.v1.AddExpenseRequest
+	(*AddExpenseResponse)(nil),                 // 30: group.v1.AddExpenseResponse
+	(*AddExpensesRequest)(nil),                 // 31: group.v1.AddExpensesRequest
+	(*AddExpensesResponse)(nil),                // 32: group.v1.AddExpensesResponse
+	(*ExpenseError)(nil),                       // 33: group.v1.ExpenseError
+	(*UpdateExpenseRequest)(nil),               // 34: group.v1.UpdateExpenseRequest
+	(*UpdateExpenseResponse)(nil),              // 35: group.v1.UpdateExpenseResponse
+	(*DeleteExpenseRequest)(nil),               // 36: group.v1.DeleteExpenseRequest
+	(*DeleteExpenseResponse)(nil),              // 37: group.v1.DeleteExpenseResponse
+	(*GetGroupExpensesRequest)(nil),            // 38: group.v1.GetGroupExpensesRequest
+	(*GetGroupExpensesResponse)(nil),           // 39: group.v1.GetGroupExpensesResponse
+	(*ExpenseFilter)(nil),                      // 40: group.v1.ExpenseFilter
+	(*ExpenseWithDetails)(nil),                 // 41: group.v1.ExpenseWithDetails
+	(*SplitMember)(nil),                        // 42: group.v1.SplitMember
+	(*CalculateSettlementsRequest)(nil),        // 43: group.v1.CalculateSettlementsRequest
+	(*CalculateSettlementsResponse)(nil),       // 44: group.v1.CalculateSettlementsResponse
+	(*Expense)(nil),                            // 45: group.v1.Expense
+	(*Settlement)(nil),                         // 46: group.v1.Settlement
+	(*MemberBalance)(nil),                      // 47: group.v1.MemberBalance
+	(*Attachment)(nil),                         // 48: group.v1.Attachment
+	(*AttachmentMetadata)(nil),                 // 49: group.v1.AttachmentMetadata
+	(*UploadAttachmentRequest)(nil),            // 50: group.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),           // 51: group.v1.UploadAttachmentResponse
+	(*ListExpenseAttachmentsRequest)(nil),      // 52: group.v1.ListExpenseAttachmentsRequest
+	(*ListExpenseAttachmentsResponse)(nil),     // 53: group.v1.ListExpenseAttachmentsResponse
+	(*DownloadAttachmentRequest)(nil),          // 54: group.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),         // 55: group.v1.DownloadAttachmentResponse
+	(*DeleteAttachmentRequest)(nil),            // 56: group.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),           // 57: group.v1.DeleteAttachmentResponse
+	(*Comment)(nil),                            // 58: group.v1.Comment
+	(*AddExpenseCommentRequest)(nil),           // 59: group.v1.AddExpenseCommentRequest
+	(*AddExpenseCommentResponse)(nil),          // 60: group.v1.AddExpenseCommentResponse
+	(*UpdateExpenseCommentRequest)(nil),        // 61: group.v1.UpdateExpenseCommentRequest
+	(*UpdateExpenseCommentResponse)(nil),       // 62: group.v1.UpdateExpenseCommentResponse
+	(*DeleteExpenseCommentRequest)(nil),        // 63: group.v1.DeleteExpenseCommentRequest
+	(*DeleteExpenseCommentResponse)(nil),       // 64: group.v1.DeleteExpenseCommentResponse
+	(*ListExpenseCommentsRequest)(nil),         // 65: group.v1.ListExpenseCommentsRequest
+	(*ListExpenseCommentsResponse)(nil),        // 66: group.v1.ListExpenseCommentsResponse
+	(*SearchExpensesRequest)(nil),              // 67: group.v1.SearchExpensesRequest
+	(*SearchExpensesResponse)(nil),             // 68: group.v1.SearchExpensesResponse
+	(*ExpenseSearchResult)(nil),                // 69: group.v1.ExpenseSearchResult
+	(*SearchHighlight)(nil),                    // 70: group.v1.SearchHighlight
+	(*TextRange)(nil),                          // 71: group.v1.TextRange
+	(*DeletedExpense)(nil),                     // 72: group.v1.DeletedExpense
+	(*ListDeletedExpensesRequest)(nil),         // 73: group.v1.ListDeletedExpensesRequest
+	(*ListDeletedExpensesResponse)(nil),        // 74: group.v1.ListDeletedExpensesResponse
+	(*RestoreExpenseRequest)(nil),              // 75: group.v1.RestoreExpenseRequest
+	(*RestoreExpenseResponse)(nil),             // 76: group.v1.RestoreExpenseResponse
+	(*ExpenseRevision)(nil),                    // 77: group.v1.ExpenseRevision
+	(*FieldChange)(nil),                        // 78: group.v1.FieldChange
+	(*GetExpenseHistoryRequest)(nil),           // 79: group.v1.GetExpenseHistoryRequest
+	(*GetExpenseHistoryResponse)(nil),          // 80: group.v1.GetExpenseHistoryResponse
+	(*RevertExpenseRequest)(nil),               // 81: group.v1.RevertExpenseRequest
+	(*RevertExpenseResponse)(nil),              // 82: group.v1.RevertExpenseResponse
+	(*ImportExpensesCsvRequest)(nil),           // 83: group.v1.ImportExpensesCsvRequest
+	(*CsvColumnMapping)(nil),                   // 84: group.v1.CsvColumnMapping
+	(*ImportExpensesCsvResponse)(nil),          // 85: group.v1.ImportExpensesCsvResponse
+	(*CsvRowError)(nil),                        // 86: group.v1.CsvRowError
+	(*ImportSplitwiseRequest)(nil),             // 87: group.v1.ImportSplitwiseRequest
+	(*ImportSplitwiseResponse)(nil),            // 88: group.v1.ImportSplitwiseResponse
+	(*SplitwiseBalance)(nil),                   // 89: group.v1.SplitwiseBalance
+	(*ExportGroupRequest)(nil),                 // 90: group.v1.ExportGroupRequest
+	(*ExportGroupResponse)(nil),                // 91: group.v1.ExportGroupResponse
+	(*ExportMetadata)(nil),                     // 92: group.v1.ExportMetadata
+	(*RenderSettlementReportRequest)(nil),      // 93: group.v1.RenderSettlementReportRequest
+	(*RenderSettlementReportResponse)(nil),     // 94: group.v1.RenderSettlementReportResponse
+	(*AccountMapping)(nil),                     // 95: group.v1.AccountMapping
+	(*GetAccountMappingsRequest)(nil),          // 96: group.v1.GetAccountMappingsRequest
+	(*GetAccountMappingsResponse)(nil),         // 97: group.v1.GetAccountMappingsResponse
+	(*UpdateAccountMappingsRequest)(nil),       // 98: group.v1.UpdateAccountMappingsRequest
+	(*UpdateAccountMappingsResponse)(nil),      // 99: group.v1.UpdateAccountMappingsResponse
+	(*ExportJournalRequest)(nil),               // 100: group.v1.ExportJournalRequest
+	(*ExportJournalResponse)(nil),              // 101: group.v1.ExportJournalResponse
+	(*BankAccount)(nil),                        // 102: group.v1.BankAccount
+	(*SetMemberBankAccountRequest)(nil),        // 103: group.v1.SetMemberBankAccountRequest
+	(*SetMemberBankAccountResponse)(nil),       // 104: group.v1.SetMemberBankAccountResponse
+	(*DeleteMemberBankAccountRequest)(nil),     // 105: group.v1.DeleteMemberBankAccountRequest
+	(*DeleteMemberBankAccountResponse)(nil),    // 106: group.v1.DeleteMemberBankAccountResponse
+	(*ListBankAccountsRequest)(nil),            // 107: group.v1.ListBankAccountsRequest
+	(*ListBankAccountsResponse)(nil),           // 108: group.v1.ListBankAccountsResponse
+	(*ExportZenginTransfersRequest)(nil),       // 109: group.v1.ExportZenginTransfersRequest
+	(*ExportZenginTransfersResponse)(nil),      // 110: group.v1.ExportZenginTransfersResponse
+	(*TemplateMember)(nil),                     // 111: group.v1.TemplateMember
+	(*ExpenseTemplate)(nil),                    // 112: group.v1.ExpenseTemplate
+	(*CreateExpenseTemplateRequest)(nil),       // 113: group.v1.CreateExpenseTemplateRequest
+	(*CreateExpenseTemplateResponse)(nil),      // 114: group.v1.CreateExpenseTemplateResponse
+	(*UpdateExpenseTemplateRequest)(nil),       // 115: group.v1.UpdateExpenseTemplateRequest
+	(*UpdateExpenseTemplateResponse)(nil),      // 116: group.v1.UpdateExpenseTemplateResponse
+	(*DeleteExpenseTemplateRequest)(nil),       // 117: group.v1.DeleteExpenseTemplateRequest
+	(*DeleteExpenseTemplateResponse)(nil),      // 118: group.v1.DeleteExpenseTemplateResponse
+	(*ListExpenseTemplatesRequest)(nil),        // 119: group.v1.ListExpenseTemplatesRequest
+	(*ListExpenseTemplatesResponse)(nil),       // 120: group.v1.ListExpenseTemplatesResponse
+	(*AddExpenseFromTemplateRequest)(nil),      // 121: group.v1.AddExpenseFromTemplateRequest
+	(*AddExpenseFromTemplateResponse)(nil),     // 122: group.v1.AddExpenseFromTemplateResponse
+	(*Budget)(nil),                             // 123: group.v1.Budget
+	(*BudgetStatus)(nil),                       // 124: group.v1.BudgetStatus
+	(*BudgetAlert)(nil),                        // 125: group.v1.BudgetAlert
+	(*GetBudgetsRequest)(nil),                  // 126: group.v1.GetBudgetsRequest
+	(*GetBudgetsResponse)(nil),                 // 127: group.v1.GetBudgetsResponse
+	(*UpdateBudgetsRequest)(nil),               // 128: group.v1.UpdateBudgetsRequest
+	(*UpdateBudgetsResponse)(nil),              // 129: group.v1.UpdateBudgetsResponse
+	(*GetBudgetStatusRequest)(nil),             // 130: group.v1.GetBudgetStatusRequest
+	(*GetBudgetStatusResponse)(nil),            // 131: group.v1.GetBudgetStatusResponse
+	(*DuplicateExpensePair)(nil),               // 132: group.v1.DuplicateExpensePair
+	(*ListDuplicateExpensesRequest)(nil),       // 133: group.v1.ListDuplicateExpensesRequest
+	(*ListDuplicateExpensesResponse)(nil),      // 134: group.v1.ListDuplicateExpensesResponse
+	(*MergeExpensesRequest)(nil),               // 135: group.v1.MergeExpensesRequest
+	(*MergeExpensesResponse)(nil),              // 136: group.v1.MergeExpensesResponse
+	(*GroupSettings)(nil),                      // 137: group.v1.GroupSettings
+	(*GetGroupSettingsRequest)(nil),            // 138: group.v1.GetGroupSettingsRequest
+	(*GetGroupSettingsResponse)(nil),           // 139: group.v1.GetGroupSettingsResponse
+	(*UpdateGroupSettingsRequest)(nil),         // 140: group.v1.UpdateGroupSettingsRequest
+	(*UpdateGroupSettingsResponse)(nil),        // 141: group.v1.UpdateGroupSettingsResponse
+	(*VoteOnExpenseRequest)(nil),               // 142: group.v1.VoteOnExpenseRequest
+	(*VoteOnExpenseResponse)(nil),              // 143: group.v1.VoteOnExpenseResponse
+	(*ClosedPeriod)(nil),                       // 144: group.v1.ClosedPeriod
+	(*ClosePeriodRequest)(nil),                 // 145: group.v1.ClosePeriodRequest
+	(*ClosePeriodResponse)(nil),                // 146: group.v1.ClosePeriodResponse
+	(*ListClosedPeriodsRequest)(nil),           // 147: group.v1.ListClosedPeriodsRequest
+	(*ListClosedPeriodsResponse)(nil),          // 148: group.v1.ListClosedPeriodsResponse
+	(*ReopenPeriodRequest)(nil),                // 149: group.v1.ReopenPeriodRequest
+	(*ReopenPeriodResponse)(nil),               // 150: group.v1.ReopenPeriodResponse
+	(*CalculateScopedSettlementsRequest)(nil),  // 151: group.v1.CalculateScopedSettlementsRequest
+	(*CalculateScopedSettlementsResponse)(nil), // 152: group.v1.CalculateScopedSettlementsResponse
+	(*SetMemberActivePeriodRequest)(nil),       // 153: group.v1.SetMemberActivePeriodRequest
+	(*SetMemberActivePeriodResponse)(nil),      // 154: group.v1.SetMemberActivePeriodResponse
+	(*MergeMembersRequest)(nil),                // 155: group.v1.MergeMembersRequest
+	(*MergeMembersResponse)(nil),               // 156: group.v1.MergeMembersResponse
+	(*ListMemberMergesRequest)(nil),            // 157: group.v1.ListMemberMergesRequest
+	(*ListMemberMergesResponse)(nil),           // 158: group.v1.ListMemberMergesResponse
+	(*MemberMerge)(nil),                        // 159: group.v1.MemberMerge
+	(*Household)(nil),                          // 160: group.v1.Household
+	(*HouseholdBalance)(nil),                   // 161: group.v1.HouseholdBalance
+	(*GetHouseholdsRequest)(nil),               // 162: group.v1.GetHouseholdsRequest
+	(*GetHouseholdsResponse)(nil),              // 163: group.v1.GetHouseholdsResponse
+	(*UpdateHouseholdsRequest)(nil),            // 164: group.v1.UpdateHouseholdsRequest
+	(*UpdateHouseholdsResponse)(nil),           // 165: group.v1.UpdateHouseholdsResponse
+	(*timestamppb.Timestamp)(nil),              // 166: google.protobuf.Timestamp
 }
 var file_proto_group_v1_group_proto_depIdxs = []int32{
-	29, // 0: group.v1.Group.created_at:type_name -> google.protobuf.Timestamp
-	29, // 1: group.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: group.v1.Group.members:type_name -> group.v1.Member
-	29, // 3: group.v1.Member.joined_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: group.v1.CreateGroupResponse.group:type_name -> group.v1.Group
-	0,  // 5: group.v1.GetGroupResponse.group:type_name -> group.v1.Group
-	0,  // 6: group.v1.UpdateGroupResponse.group:type_name -> group.v1.Group
-	1,  // 7: group.v1.AddMemberResponse.member:type_name -> group.v1.Member
-	22, // 8: group.v1.AddExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
-	22, // 9: group.v1.UpdateExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
-	22, // 10: group.v1.GetGroupExpensesResponse.expenses:type_name -> group.v1.ExpenseWithDetails
-	23, // 11: group.v1.ExpenseWithDetails.split_members:type_name -> group.v1.SplitMember
-	29, // 12: group.v1.ExpenseWithDetails.created_at:type_name -> google.protobuf.Timestamp
-	26, // 13: group.v1.CalculateSettlementsRequest.expenses:type_name -> group.v1.Expense
-	27, // 14: group.v1.CalculateSettlementsResponse.settlements:type_name -> group.v1.Settlement
-	28, // 15: group.v1.CalculateSettlementsResponse.balances:type_name -> group.v1.MemberBalance
-	29, // 16: group.v1.Expense.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 17: group.v1.GroupService.CreateGroup:input_type -> group.v1.CreateGroupRequest
-	4,  // 18: group.v1.GroupService.GetGroup:input_type -> group.v1.GetGroupRequest
-	6,  // 19: group.v1.GroupService.UpdateGroup:input_type -> group.v1.UpdateGroupRequest
-	8,  // 20: group.v1.GroupService.DeleteGroup:input_type -> group.v1.DeleteGroupRequest
-	10, // 21: group.v1.GroupService.AddMember:input_type -> group.v1.AddMemberRequest
-	12, // 22: group.v1.GroupService.RemoveMember:input_type -> group.v1.RemoveMemberRequest
-	14, // 23: group.v1.GroupService.AddExpense:input_type -> group.v1.AddExpenseRequest
-	16, // 24: group.v1.GroupService.UpdateExpense:input_type -> group.v1.UpdateExpenseRequest
-	18, // 25: group.v1.GroupService.DeleteExpense:input_type -> group.v1.DeleteExpenseRequest
-	20, // 26: group.v1.GroupService.GetGroupExpenses:input_type -> group.v1.GetGroupExpensesRequest
-	24, // 27: group.v1.GroupService.CalculateSettlements:input_type -> group.v1.CalculateSettlementsRequest
-	3,  // 28: group.v1.GroupService.CreateGroup:output_type -> group.v1.CreateGroupResponse
-	5,  // 29: group.v1.GroupService.GetGroup:output_type -> group.v1.GetGroupResponse
-	7,  // 30: group.v1.GroupService.UpdateGroup:output_type -> group.v1.UpdateGroupResponse
-	9,  // 31: group.v1.GroupService.DeleteGroup:output_type -> group.v1.DeleteGroupResponse
-	11, // 32: group.v1.GroupService.AddMember:output_type -> group.v1.AddMemberResponse
-	13, // 33: group.v1.GroupService.RemoveMember:output_type -> group.v1.RemoveMemberResponse
-	15, // 34: group.v1.GroupService.AddExpense:output_type -> group.v1.AddExpenseResponse
-	17, // 35: group.v1.GroupService.UpdateExpense:output_type -> group.v1.UpdateExpenseResponse
-	19, // 36: group.v1.GroupService.DeleteExpense:output_type -> group.v1.DeleteExpenseResponse
-	21, // 37: group.v1.GroupService.GetGroupExpenses:output_type -> group.v1.GetGroupExpensesResponse
-	25, // 38: group.v1.GroupService.CalculateSettlements:output_type -> group.v1.CalculateSettlementsResponse
-	28, // [28:39] is the sub-list for method output_type
-	17, // [17:28] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	166, // 0: group.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	166, // 1: group.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 2: group.v1.Group.members:type_name -> group.v1.Member
+	166, // 3: group.v1.Member.joined_at:type_name -> google.protobuf.Timestamp
+	166, // 4: group.v1.Member.active_from:type_name -> google.protobuf.Timestamp
+	166, // 5: group.v1.Member.active_until:type_name -> google.protobuf.Timestamp
+	12,  // 6: group.v1.CreateGroupResponse.group:type_name -> group.v1.Group
+	12,  // 7: group.v1.GetGroupResponse.group:type_name -> group.v1.Group
+	12,  // 8: group.v1.UpdateGroupResponse.group:type_name -> group.v1.Group
+	13,  // 9: group.v1.AddMemberResponse.member:type_name -> group.v1.Member
+	25,  // 10: group.v1.RemoveMemberRequest.redistribution:type_name -> group.v1.BalanceTransfer
+	41,  // 11: group.v1.RemoveMemberResponse.transfers:type_name -> group.v1.ExpenseWithDetails
+	13,  // 12: group.v1.UpdateMemberResponse.member:type_name -> group.v1.Member
+	2,   // 13: group.v1.AddExpenseRequest.kind:type_name -> group.v1.ExpenseKind
+	41,  // 14: group.v1.AddExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	125, // 15: group.v1.AddExpenseResponse.budget_alerts:type_name -> group.v1.BudgetAlert
+	41,  // 16: group.v1.AddExpenseResponse.probable_duplicates:type_name -> group.v1.ExpenseWithDetails
+	29,  // 17: group.v1.AddExpensesRequest.expenses:type_name -> group.v1.AddExpenseRequest
+	41,  // 18: group.v1.AddExpensesResponse.expenses:type_name -> group.v1.ExpenseWithDetails
+	33,  // 19: group.v1.AddExpensesResponse.errors:type_name -> group.v1.ExpenseError
+	2,   // 20: group.v1.UpdateExpenseRequest.kind:type_name -> group.v1.ExpenseKind
+	41,  // 21: group.v1.UpdateExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	125, // 22: group.v1.UpdateExpenseResponse.budget_alerts:type_name -> group.v1.BudgetAlert
+	40,  // 23: group.v1.GetGroupExpensesRequest.filter:type_name -> group.v1.ExpenseFilter
+	0,   // 24: group.v1.GetGroupExpensesRequest.sort_by:type_name -> group.v1.ExpenseSortField
+	1,   // 25: group.v1.GetGroupExpensesRequest.sort_direction:type_name -> group.v1.SortDirection
+	41,  // 26: group.v1.GetGroupExpensesResponse.expenses:type_name -> group.v1.ExpenseWithDetails
+	166, // 27: group.v1.ExpenseFilter.created_from:type_name -> google.protobuf.Timestamp
+	166, // 28: group.v1.ExpenseFilter.created_to:type_name -> google.protobuf.Timestamp
+	42,  // 29: group.v1.ExpenseWithDetails.split_members:type_name -> group.v1.SplitMember
+	166, // 30: group.v1.ExpenseWithDetails.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 31: group.v1.ExpenseWithDetails.kind:type_name -> group.v1.ExpenseKind
+	10,  // 32: group.v1.ExpenseWithDetails.approval_status:type_name -> group.v1.ApprovalStatus
+	11,  // 33: group.v1.SplitMember.vote:type_name -> group.v1.ApprovalVote
+	166, // 34: group.v1.SplitMember.voted_at:type_name -> google.protobuf.Timestamp
+	45,  // 35: group.v1.CalculateSettlementsRequest.expenses:type_name -> group.v1.Expense
+	46,  // 36: group.v1.CalculateSettlementsResponse.settlements:type_name -> group.v1.Settlement
+	47,  // 37: group.v1.CalculateSettlementsResponse.balances:type_name -> group.v1.MemberBalance
+	161, // 38: group.v1.CalculateSettlementsResponse.household_balances:type_name -> group.v1.HouseholdBalance
+	166, // 39: group.v1.Expense.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 40: group.v1.Expense.kind:type_name -> group.v1.ExpenseKind
+	166, // 41: group.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	49,  // 42: group.v1.UploadAttachmentRequest.metadata:type_name -> group.v1.AttachmentMetadata
+	48,  // 43: group.v1.UploadAttachmentResponse.attachment:type_name -> group.v1.Attachment
+	48,  // 44: group.v1.ListExpenseAttachmentsResponse.attachments:type_name -> group.v1.Attachment
+	48,  // 45: group.v1.DownloadAttachmentResponse.attachment:type_name -> group.v1.Attachment
+	166, // 46: group.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	166, // 47: group.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	58,  // 48: group.v1.AddExpenseCommentResponse.comment:type_name -> group.v1.Comment
+	58,  // 49: group.v1.UpdateExpenseCommentResponse.comment:type_name -> group.v1.Comment
+	58,  // 50: group.v1.ListExpenseCommentsResponse.comments:type_name -> group.v1.Comment
+	69,  // 51: group.v1.SearchExpensesResponse.results:type_name -> group.v1.ExpenseSearchResult
+	41,  // 52: group.v1.ExpenseSearchResult.expense:type_name -> group.v1.ExpenseWithDetails
+	70,  // 53: group.v1.ExpenseSearchResult.highlights:type_name -> group.v1.SearchHighlight
+	71,  // 54: group.v1.SearchHighlight.ranges:type_name -> group.v1.TextRange
+	41,  // 55: group.v1.DeletedExpense.expense:type_name -> group.v1.ExpenseWithDetails
+	166, // 56: group.v1.DeletedExpense.deleted_at:type_name -> google.protobuf.Timestamp
+	166, // 57: group.v1.DeletedExpense.purge_at:type_name -> google.protobuf.Timestamp
+	72,  // 58: group.v1.ListDeletedExpensesResponse.expenses:type_name -> group.v1.DeletedExpense
+	41,  // 59: group.v1.RestoreExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	2,   // 60: group.v1.ExpenseRevision.kind:type_name -> group.v1.ExpenseKind
+	42,  // 61: group.v1.ExpenseRevision.split_members:type_name -> group.v1.SplitMember
+	166, // 62: group.v1.ExpenseRevision.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 63: group.v1.ExpenseRevision.changes:type_name -> group.v1.FieldChange
+	77,  // 64: group.v1.GetExpenseHistoryResponse.revisions:type_name -> group.v1.ExpenseRevision
+	41,  // 65: group.v1.RevertExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	84,  // 66: group.v1.ImportExpensesCsvRequest.mapping:type_name -> group.v1.CsvColumnMapping
+	41,  // 67: group.v1.ImportExpensesCsvResponse.expenses:type_name -> group.v1.ExpenseWithDetails
+	86,  // 68: group.v1.ImportExpensesCsvResponse.errors:type_name -> group.v1.CsvRowError
+	12,  // 69: group.v1.ImportSplitwiseResponse.group:type_name -> group.v1.Group
+	41,  // 70: group.v1.ImportSplitwiseResponse.expenses:type_name -> group.v1.ExpenseWithDetails
+	86,  // 71: group.v1.ImportSplitwiseResponse.errors:type_name -> group.v1.CsvRowError
+	89,  // 72: group.v1.ImportSplitwiseResponse.balances:type_name -> group.v1.SplitwiseBalance
+	3,   // 73: group.v1.ExportGroupRequest.format:type_name -> group.v1.ExportFormat
+	4,   // 74: group.v1.ExportGroupRequest.csv_layout:type_name -> group.v1.ExportCsvLayout
+	92,  // 75: group.v1.ExportGroupResponse.metadata:type_name -> group.v1.ExportMetadata
+	5,   // 76: group.v1.RenderSettlementReportRequest.format:type_name -> group.v1.ReportFormat
+	95,  // 77: group.v1.GetAccountMappingsResponse.mappings:type_name -> group.v1.AccountMapping
+	95,  // 78: group.v1.UpdateAccountMappingsRequest.mappings:type_name -> group.v1.AccountMapping
+	95,  // 79: group.v1.UpdateAccountMappingsResponse.mappings:type_name -> group.v1.AccountMapping
+	6,   // 80: group.v1.ExportJournalRequest.format:type_name -> group.v1.JournalFormat
+	7,   // 81: group.v1.BankAccount.account_type:type_name -> group.v1.BankAccountType
+	166, // 82: group.v1.BankAccount.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 83: group.v1.SetMemberBankAccountRequest.account_type:type_name -> group.v1.BankAccountType
+	102, // 84: group.v1.SetMemberBankAccountResponse.bank_account:type_name -> group.v1.BankAccount
+	102, // 85: group.v1.ListBankAccountsResponse.bank_accounts:type_name -> group.v1.BankAccount
+	166, // 86: group.v1.ExportZenginTransfersRequest.transfer_date:type_name -> google.protobuf.Timestamp
+	2,   // 87: group.v1.ExpenseTemplate.kind:type_name -> group.v1.ExpenseKind
+	8,   // 88: group.v1.ExpenseTemplate.split_mode:type_name -> group.v1.SplitMode
+	111, // 89: group.v1.ExpenseTemplate.members:type_name -> group.v1.TemplateMember
+	166, // 90: group.v1.ExpenseTemplate.created_at:type_name -> google.protobuf.Timestamp
+	166, // 91: group.v1.ExpenseTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 92: group.v1.CreateExpenseTemplateRequest.kind:type_name -> group.v1.ExpenseKind
+	8,   // 93: group.v1.CreateExpenseTemplateRequest.split_mode:type_name -> group.v1.SplitMode
+	112, // 94: group.v1.CreateExpenseTemplateResponse.template:type_name -> group.v1.ExpenseTemplate
+	2,   // 95: group.v1.UpdateExpenseTemplateRequest.kind:type_name -> group.v1.ExpenseKind
+	8,   // 96: group.v1.UpdateExpenseTemplateRequest.split_mode:type_name -> group.v1.SplitMode
+	112, // 97: group.v1.UpdateExpenseTemplateResponse.template:type_name -> group.v1.ExpenseTemplate
+	112, // 98: group.v1.ListExpenseTemplatesResponse.templates:type_name -> group.v1.ExpenseTemplate
+	41,  // 99: group.v1.AddExpenseFromTemplateResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	125, // 100: group.v1.AddExpenseFromTemplateResponse.budget_alerts:type_name -> group.v1.BudgetAlert
+	41,  // 101: group.v1.AddExpenseFromTemplateResponse.probable_duplicates:type_name -> group.v1.ExpenseWithDetails
+	9,   // 102: group.v1.Budget.period:type_name -> group.v1.BudgetPeriod
+	123, // 103: group.v1.BudgetStatus.budget:type_name -> group.v1.Budget
+	166, // 104: group.v1.BudgetStatus.period_start:type_name -> google.protobuf.Timestamp
+	166, // 105: group.v1.BudgetStatus.period_end:type_name -> google.protobuf.Timestamp
+	123, // 106: group.v1.BudgetAlert.budget:type_name -> group.v1.Budget
+	166, // 107: group.v1.BudgetAlert.period_start:type_name -> google.protobuf.Timestamp
+	123, // 108: group.v1.GetBudgetsResponse.budgets:type_name -> group.v1.Budget
+	123, // 109: group.v1.UpdateBudgetsRequest.budgets:type_name -> group.v1.Budget
+	123, // 110: group.v1.UpdateBudgetsResponse.budgets:type_name -> group.v1.Budget
+	166, // 111: group.v1.GetBudgetStatusRequest.at:type_name -> google.protobuf.Timestamp
+	124, // 112: group.v1.GetBudgetStatusResponse.statuses:type_name -> group.v1.BudgetStatus
+	41,  // 113: group.v1.DuplicateExpensePair.expense:type_name -> group.v1.ExpenseWithDetails
+	41,  // 114: group.v1.DuplicateExpensePair.duplicate:type_name -> group.v1.ExpenseWithDetails
+	132, // 115: group.v1.ListDuplicateExpensesResponse.pairs:type_name -> group.v1.DuplicateExpensePair
+	41,  // 116: group.v1.MergeExpensesResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	137, // 117: group.v1.GetGroupSettingsResponse.settings:type_name -> group.v1.GroupSettings
+	137, // 118: group.v1.UpdateGroupSettingsRequest.settings:type_name -> group.v1.GroupSettings
+	137, // 119: group.v1.UpdateGroupSettingsResponse.settings:type_name -> group.v1.GroupSettings
+	11,  // 120: group.v1.VoteOnExpenseRequest.vote:type_name -> group.v1.ApprovalVote
+	41,  // 121: group.v1.VoteOnExpenseResponse.expense:type_name -> group.v1.ExpenseWithDetails
+	166, // 122: group.v1.ClosedPeriod.period_start:type_name -> google.protobuf.Timestamp
+	166, // 123: group.v1.ClosedPeriod.closed_until:type_name -> google.protobuf.Timestamp
+	47,  // 124: group.v1.ClosedPeriod.opening_balances:type_name -> group.v1.MemberBalance
+	47,  // 125: group.v1.ClosedPeriod.balances:type_name -> group.v1.MemberBalance
+	46,  // 126: group.v1.ClosedPeriod.settlements:type_name -> group.v1.Settlement
+	166, // 127: group.v1.ClosedPeriod.closed_at:type_name -> google.protobuf.Timestamp
+	166, // 128: group.v1.ClosedPeriod.reopened_at:type_name -> google.protobuf.Timestamp
+	166, // 129: group.v1.ClosePeriodRequest.until:type_name -> google.protobuf.Timestamp
+	144, // 130: group.v1.ClosePeriodResponse.period:type_name -> group.v1.ClosedPeriod
+	144, // 131: group.v1.ListClosedPeriodsResponse.periods:type_name -> group.v1.ClosedPeriod
+	144, // 132: group.v1.ReopenPeriodResponse.period:type_name -> group.v1.ClosedPeriod
+	166, // 133: group.v1.CalculateScopedSettlementsRequest.created_from:type_name -> google.protobuf.Timestamp
+	166, // 134: group.v1.CalculateScopedSettlementsRequest.created_to:type_name -> google.protobuf.Timestamp
+	46,  // 135: group.v1.CalculateScopedSettlementsResponse.settlements:type_name -> group.v1.Settlement
+	47,  // 136: group.v1.CalculateScopedSettlementsResponse.balances:type_name -> group.v1.MemberBalance
+	166, // 137: group.v1.SetMemberActivePeriodRequest.active_from:type_name -> google.protobuf.Timestamp
+	166, // 138: group.v1.SetMemberActivePeriodRequest.active_until:type_name -> google.protobuf.Timestamp
+	13,  // 139: group.v1.SetMemberActivePeriodResponse.member:type_name -> group.v1.Member
+	159, // 140: group.v1.MergeMembersResponse.merge:type_name -> group.v1.MemberMerge
+	159, // 141: group.v1.ListMemberMergesResponse.merges:type_name -> group.v1.MemberMerge
+	166, // 142: group.v1.MemberMerge.merged_at:type_name -> google.protobuf.Timestamp
+	160, // 143: group.v1.GetHouseholdsResponse.households:type_name -> group.v1.Household
+	160, // 144: group.v1.UpdateHouseholdsRequest.households:type_name -> group.v1.Household
+	160, // 145: group.v1.UpdateHouseholdsResponse.households:type_name -> group.v1.Household
+	14,  // 146: group.v1.GroupService.CreateGroup:input_type -> group.v1.CreateGroupRequest
+	16,  // 147: group.v1.GroupService.GetGroup:input_type -> group.v1.GetGroupRequest
+	18,  // 148: group.v1.GroupService.UpdateGroup:input_type -> group.v1.UpdateGroupRequest
+	20,  // 149: group.v1.GroupService.DeleteGroup:input_type -> group.v1.DeleteGroupRequest
+	22,  // 150: group.v1.GroupService.AddMember:input_type -> group.v1.AddMemberRequest
+	24,  // 151: group.v1.GroupService.RemoveMember:input_type -> group.v1.RemoveMemberRequest
+	27,  // 152: group.v1.GroupService.UpdateMember:input_type -> group.v1.UpdateMemberRequest
+	29,  // 153: group.v1.GroupService.AddExpense:input_type -> group.v1.AddExpenseRequest
+	31,  // 154: group.v1.GroupService.AddExpenses:input_type -> group.v1.AddExpensesRequest
+	83,  // 155: group.v1.GroupService.ImportExpensesCsv:input_type -> group.v1.ImportExpensesCsvRequest
+	87,  // 156: group.v1.GroupService.ImportSplitwise:input_type -> group.v1.ImportSplitwiseRequest
+	34,  // 157: group.v1.GroupService.UpdateExpense:input_type -> group.v1.UpdateExpenseRequest
+	36,  // 158: group.v1.GroupService.DeleteExpense:input_type -> group.v1.DeleteExpenseRequest
+	38,  // 159: group.v1.GroupService.GetGroupExpenses:input_type -> group.v1.GetGroupExpensesRequest
+	43,  // 160: group.v1.GroupService.CalculateSettlements:input_type -> group.v1.CalculateSettlementsRequest
+	50,  // 161: group.v1.GroupService.UploadAttachment:input_type -> group.v1.UploadAttachmentRequest
+	52,  // 162: group.v1.GroupService.ListExpenseAttachments:input_type -> group.v1.ListExpenseAttachmentsRequest
+	54,  // 163: group.v1.GroupService.DownloadAttachment:input_type -> group.v1.DownloadAttachmentRequest
+	56,  // 164: group.v1.GroupService.DeleteAttachment:input_type -> group.v1.DeleteAttachmentRequest
+	59,  // 165: group.v1.GroupService.AddExpenseComment:input_type -> group.v1.AddExpenseCommentRequest
+	61,  // 166: group.v1.GroupService.UpdateExpenseComment:input_type -> group.v1.UpdateExpenseCommentRequest
+	63,  // 167: group.v1.GroupService.DeleteExpenseComment:input_type -> group.v1.DeleteExpenseCommentRequest
+	65,  // 168: group.v1.GroupService.ListExpenseComments:input_type -> group.v1.ListExpenseCommentsRequest
+	67,  // 169: group.v1.GroupService.SearchExpenses:input_type -> group.v1.SearchExpensesRequest
+	73,  // 170: group.v1.GroupService.ListDeletedExpenses:input_type -> group.v1.ListDeletedExpensesRequest
+	75,  // 171: group.v1.GroupService.RestoreExpense:input_type -> group.v1.RestoreExpenseRequest
+	79,  // 172: group.v1.GroupService.GetExpenseHistory:input_type -> group.v1.GetExpenseHistoryRequest
+	81,  // 173: group.v1.GroupService.RevertExpense:input_type -> group.v1.RevertExpenseRequest
+	90,  // 174: group.v1.GroupService.ExportGroup:input_type -> group.v1.ExportGroupRequest
+	93,  // 175: group.v1.GroupService.RenderSettlementReport:input_type -> group.v1.RenderSettlementReportRequest
+	96,  // 176: group.v1.GroupService.GetAccountMappings:input_type -> group.v1.GetAccountMappingsRequest
+	98,  // 177: group.v1.GroupService.UpdateAccountMappings:input_type -> group.v1.UpdateAccountMappingsRequest
+	100, // 178: group.v1.GroupService.ExportJournal:input_type -> group.v1.ExportJournalRequest
+	103, // 179: group.v1.GroupService.SetMemberBankAccount:input_type -> group.v1.SetMemberBankAccountRequest
+	105, // 180: group.v1.GroupService.DeleteMemberBankAccount:input_type -> group.v1.DeleteMemberBankAccountRequest
+	107, // 181: group.v1.GroupService.ListBankAccounts:input_type -> group.v1.ListBankAccountsRequest
+	109, // 182: group.v1.GroupService.ExportZenginTransfers:input_type -> group.v1.ExportZenginTransfersRequest
+	113, // 183: group.v1.GroupService.CreateExpenseTemplate:input_type -> group.v1.CreateExpenseTemplateRequest
+	115, // 184: group.v1.GroupService.UpdateExpenseTemplate:input_type -> group.v1.UpdateExpenseTemplateRequest
+	117, // 185: group.v1.GroupService.DeleteExpenseTemplate:input_type -> group.v1.DeleteExpenseTemplateRequest
+	119, // 186: group.v1.GroupService.ListExpenseTemplates:input_type -> group.v1.ListExpenseTemplatesRequest
+	121, // 187: group.v1.GroupService.AddExpenseFromTemplate:input_type -> group.v1.AddExpenseFromTemplateRequest
+	126, // 188: group.v1.GroupService.GetBudgets:input_type -> group.v1.GetBudgetsRequest
+	128, // 189: group.v1.GroupService.UpdateBudgets:input_type -> group.v1.UpdateBudgetsRequest
+	130, // 190: group.v1.GroupService.GetBudgetStatus:input_type -> group.v1.GetBudgetStatusRequest
+	133, // 191: group.v1.GroupService.ListDuplicateExpenses:input_type -> group.v1.ListDuplicateExpensesRequest
+	135, // 192: group.v1.GroupService.MergeExpenses:input_type -> group.v1.MergeExpensesRequest
+	138, // 193: group.v1.GroupService.GetGroupSettings:input_type -> group.v1.GetGroupSettingsRequest
+	140, // 194: group.v1.GroupService.UpdateGroupSettings:input_type -> group.v1.UpdateGroupSettingsRequest
+	142, // 195: group.v1.GroupService.VoteOnExpense:input_type -> group.v1.VoteOnExpenseRequest
+	145, // 196: group.v1.GroupService.ClosePeriod:input_type -> group.v1.ClosePeriodRequest
+	147, // 197: group.v1.GroupService.ListClosedPeriods:input_type -> group.v1.ListClosedPeriodsRequest
+	149, // 198: group.v1.GroupService.ReopenPeriod:input_type -> group.v1.ReopenPeriodRequest
+	151, // 199: group.v1.GroupService.CalculateScopedSettlements:input_type -> group.v1.CalculateScopedSettlementsRequest
+	153, // 200: group.v1.GroupService.SetMemberActivePeriod:input_type -> group.v1.SetMemberActivePeriodRequest
+	155, // 201: group.v1.GroupService.MergeMembers:input_type -> group.v1.MergeMembersRequest
+	157, // 202: group.v1.GroupService.ListMemberMerges:input_type -> group.v1.ListMemberMergesRequest
+	162, // 203: group.v1.GroupService.GetHouseholds:input_type -> group.v1.GetHouseholdsRequest
+	164, // 204: group.v1.GroupService.UpdateHouseholds:input_type -> group.v1.UpdateHouseholdsRequest
+	15,  // 205: group.v1.GroupService.CreateGroup:output_type -> group.v1.CreateGroupResponse
+	17,  // 206: group.v1.GroupService.GetGroup:output_type -> group.v1.GetGroupResponse
+	19,  // 207: group.v1.GroupService.UpdateGroup:output_type -> group.v1.UpdateGroupResponse
+	21,  // 208: group.v1.GroupService.DeleteGroup:output_type -> group.v1.DeleteGroupResponse
+	23,  // 209: group.v1.GroupService.AddMember:output_type -> group.v1.AddMemberResponse
+	26,  // 210: group.v1.GroupService.RemoveMember:output_type -> group.v1.RemoveMemberResponse
+	28,  // 211: group.v1.GroupService.UpdateMember:output_type -> group.v1.UpdateMemberResponse
+	30,  // 212: group.v1.GroupService.AddExpense:output_type -> group.v1.AddExpenseResponse
+	32,  // 213: group.v1.GroupService.AddExpenses:output_type -> group.v1.AddExpensesResponse
+	85,  // 214: group.v1.GroupService.ImportExpensesCsv:output_type -> group.v1.ImportExpensesCsvResponse
+	88,  // 215: group.v1.GroupService.ImportSplitwise:output_type -> group.v1.ImportSplitwiseResponse
+	35,  // 216: group.v1.GroupService.UpdateExpense:output_type -> group.v1.UpdateExpenseResponse
+	37,  // 217: group.v1.GroupService.DeleteExpense:output_type -> group.v1.DeleteExpenseResponse
+	39,  // 218: group.v1.GroupService.GetGroupExpenses:output_type -> group.v1.GetGroupExpensesResponse
+	44,  // 219: group.v1.GroupService.CalculateSettlements:output_type -> group.v1.CalculateSettlementsResponse
+	51,  // 220: group.v1.GroupService.UploadAttachment:output_type -> group.v1.UploadAttachmentResponse
+	53,  // 221: group.v1.GroupService.ListExpenseAttachments:output_type -> group.v1.ListExpenseAttachmentsResponse
+	55,  // 222: group.v1.GroupService.DownloadAttachment:output_type -> group.v1.DownloadAttachmentResponse
+	57,  // 223: group.v1.GroupService.DeleteAttachment:output_type -> group.v1.DeleteAttachmentResponse
+	60,  // 224: group.v1.GroupService.AddExpenseComment:output_type -> group.v1.AddExpenseCommentResponse
+	62,  // 225: group.v1.GroupService.UpdateExpenseComment:output_type -> group.v1.UpdateExpenseCommentResponse
+	64,  // 226: group.v1.GroupService.DeleteExpenseComment:output_type -> group.v1.DeleteExpenseCommentResponse
+	66,  // 227: group.v1.GroupService.ListExpenseComments:output_type -> group.v1.ListExpenseCommentsResponse
+	68,  // 228: group.v1.GroupService.SearchExpenses:output_type -> group.v1.SearchExpensesResponse
+	74,  // 229: group.v1.GroupService.ListDeletedExpenses:output_type -> group.v1.ListDeletedExpensesResponse
+	76,  // 230: group.v1.GroupService.RestoreExpense:output_type -> group.v1.RestoreExpenseResponse
+	80,  // 231: group.v1.GroupService.GetExpenseHistory:output_type -> group.v1.GetExpenseHistoryResponse
+	82,  // 232: group.v1.GroupService.RevertExpense:output_type -> group.v1.RevertExpenseResponse
+	91,  // 233: group.v1.GroupService.ExportGroup:output_type -> group.v1.ExportGroupResponse
+	94,  // 234: group.v1.GroupService.RenderSettlementReport:output_type -> group.v1.RenderSettlementReportResponse
+	97,  // 235: group.v1.GroupService.GetAccountMappings:output_type -> group.v1.GetAccountMappingsResponse
+	99,  // 236: group.v1.GroupService.UpdateAccountMappings:output_type -> group.v1.UpdateAccountMappingsResponse
+	101, // 237: group.v1.GroupService.ExportJournal:output_type -> group.v1.ExportJournalResponse
+	104, // 238: group.v1.GroupService.SetMemberBankAccount:output_type -> group.v1.SetMemberBankAccountResponse
+	106, // 239: group.v1.GroupService.DeleteMemberBankAccount:output_type -> group.v1.DeleteMemberBankAccountResponse
+	108, // 240: group.v1.GroupService.ListBankAccounts:output_type -> group.v1.ListBankAccountsResponse
+	110, // 241: group.v1.GroupService.ExportZenginTransfers:output_type -> group.v1.ExportZenginTransfersResponse
+	114, // 242: group.v1.GroupService.CreateExpenseTemplate:output_type -> group.v1.CreateExpenseTemplateResponse
+	116, // 243: group.v1.GroupService.UpdateExpenseTemplate:output_type -> group.v1.UpdateExpenseTemplateResponse
+	118, // 244: group.v1.GroupService.DeleteExpenseTemplate:output_type -> group.v1.DeleteExpenseTemplateResponse
+	120, // 245: group.v1.GroupService.ListExpenseTemplates:output_type -> group.v1.ListExpenseTemplatesResponse
+	122, // 246: group.v1.GroupService.AddExpenseFromTemplate:output_type -> group.v1.AddExpenseFromTemplateResponse
+	127, // 247: group.v1.GroupService.GetBudgets:output_type -> group.v1.GetBudgetsResponse
+	129, // 248: group.v1.GroupService.UpdateBudgets:output_type -> group.v1.UpdateBudgetsResponse
+	131, // 249: group.v1.GroupService.GetBudgetStatus:output_type -> group.v1.GetBudgetStatusResponse
+	134, // 250: group.v1.GroupService.ListDuplicateExpenses:output_type -> group.v1.ListDuplicateExpensesResponse
+	136, // 251: group.v1.GroupService.MergeExpenses:output_type -> group.v1.MergeExpensesResponse
+	139, // 252: group.v1.GroupService.GetGroupSettings:output_type -> group.v1.GetGroupSettingsResponse
+	141, // 253: group.v1.GroupService.UpdateGroupSettings:output_type -> group.v1.UpdateGroupSettingsResponse
+	143, // 254: group.v1.GroupService.VoteOnExpense:output_type -> group.v1.VoteOnExpenseResponse
+	146, // 255: group.v1.GroupService.ClosePeriod:output_type -> group.v1.ClosePeriodResponse
+	148, // 256: group.v1.GroupService.ListClosedPeriods:output_type -> group.v1.ListClosedPeriodsResponse
+	150, // 257: group.v1.GroupService.ReopenPeriod:output_type -> group.v1.ReopenPeriodResponse
+	152, // 258: group.v1.GroupService.CalculateScopedSettlements:output_type -> group.v1.CalculateScopedSettlementsResponse
+	154, // 259: group.v1.GroupService.SetMemberActivePeriod:output_type -> group.v1.SetMemberActivePeriodResponse
+	156, // 260: group.v1.GroupService.MergeMembers:output_type -> group.v1.MergeMembersResponse
+	158, // 261: group.v1.GroupService.ListMemberMerges:output_type -> group.v1.ListMemberMergesResponse
+	163, // 262: group.v1.GroupService.GetHouseholds:output_type -> group.v1.GetHouseholdsResponse
+	165, // 263: group.v1.GroupService.UpdateHouseholds:output_type -> group.v1.UpdateHouseholdsResponse
+	205, // [205:264] is the sub-list for method output_type
+	146, // [146:205] is the sub-list for method input_type
+	146, // [146:146] is the sub-list for extension type_name
+	146, // [146:146] is the sub-list for extension extendee
+	0,   // [0:146] is the sub-list for field type_name
 }
 
 func init() { file_proto_group_v1_group_proto_init() }
@@ -1921,18 +11460,31 @@ func file_proto_group_v1_group_proto_init() {
 	if File_proto_group_v1_group_proto != nil {
 		return
 	}
+	file_proto_group_v1_group_proto_msgTypes[38].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_proto_group_v1_group_proto_msgTypes[43].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_proto_group_v1_group_proto_msgTypes[79].OneofWrappers = []any{
+		(*ExportGroupResponse_Metadata)(nil),
+		(*ExportGroupResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_group_v1_group_proto_rawDesc), len(file_proto_group_v1_group_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   29,
+			NumEnums:      12,
+			NumMessages:   154,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_group_v1_group_proto_goTypes,
 		DependencyIndexes: file_proto_group_v1_group_proto_depIdxs,
+		EnumInfos:         file_proto_group_v1_group_proto_enumTypes,
 		MessageInfos:      file_proto_group_v1_group_proto_msgTypes,
 	}.Build()
 	File_proto_group_v1_group_proto = out.File
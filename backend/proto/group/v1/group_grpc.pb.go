@@ -19,17 +19,65 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GroupService_CreateGroup_FullMethodName          = "/group.v1.GroupService/CreateGroup"
-	GroupService_GetGroup_FullMethodName             = "/group.v1.GroupService/GetGroup"
-	GroupService_UpdateGroup_FullMethodName          = "/group.v1.GroupService/UpdateGroup"
-	GroupService_DeleteGroup_FullMethodName          = "/group.v1.GroupService/DeleteGroup"
-	GroupService_AddMember_FullMethodName            = "/group.v1.GroupService/AddMember"
-	GroupService_RemoveMember_FullMethodName         = "/group.v1.GroupService/RemoveMember"
-	GroupService_AddExpense_FullMethodName           = "/group.v1.GroupService/AddExpense"
-	GroupService_UpdateExpense_FullMethodName        = "/group.v1.GroupService/UpdateExpense"
-	GroupService_DeleteExpense_FullMethodName        = "/group.v1.GroupService/DeleteExpense"
-	GroupService_GetGroupExpenses_FullMethodName     = "/group.v1.GroupService/GetGroupExpenses"
-	GroupService_CalculateSettlements_FullMethodName = "/group.v1.GroupService/CalculateSettlements"
+	GroupService_CreateGroup_FullMethodName                = "/group.v1.GroupService/CreateGroup"
+	GroupService_GetGroup_FullMethodName                   = "/group.v1.GroupService/GetGroup"
+	GroupService_UpdateGroup_FullMethodName                = "/group.v1.GroupService/UpdateGroup"
+	GroupService_DeleteGroup_FullMethodName                = "/group.v1.GroupService/DeleteGroup"
+	GroupService_AddMember_FullMethodName                  = "/group.v1.GroupService/AddMember"
+	GroupService_RemoveMember_FullMethodName               = "/group.v1.GroupService/RemoveMember"
+	GroupService_UpdateMember_FullMethodName               = "/group.v1.GroupService/UpdateMember"
+	GroupService_AddExpense_FullMethodName                 = "/group.v1.GroupService/AddExpense"
+	GroupService_AddExpenses_FullMethodName                = "/group.v1.GroupService/AddExpenses"
+	GroupService_ImportExpensesCsv_FullMethodName          = "/group.v1.GroupService/ImportExpensesCsv"
+	GroupService_ImportSplitwise_FullMethodName            = "/group.v1.GroupService/ImportSplitwise"
+	GroupService_UpdateExpense_FullMethodName              = "/group.v1.GroupService/UpdateExpense"
+	GroupService_DeleteExpense_FullMethodName              = "/group.v1.GroupService/DeleteExpense"
+	GroupService_GetGroupExpenses_FullMethodName           = "/group.v1.GroupService/GetGroupExpenses"
+	GroupService_CalculateSettlements_FullMethodName       = "/group.v1.GroupService/CalculateSettlements"
+	GroupService_UploadAttachment_FullMethodName           = "/group.v1.GroupService/UploadAttachment"
+	GroupService_ListExpenseAttachments_FullMethodName     = "/group.v1.GroupService/ListExpenseAttachments"
+	GroupService_DownloadAttachment_FullMethodName         = "/group.v1.GroupService/DownloadAttachment"
+	GroupService_DeleteAttachment_FullMethodName           = "/group.v1.GroupService/DeleteAttachment"
+	GroupService_AddExpenseComment_FullMethodName          = "/group.v1.GroupService/AddExpenseComment"
+	GroupService_UpdateExpenseComment_FullMethodName       = "/group.v1.GroupService/UpdateExpenseComment"
+	GroupService_DeleteExpenseComment_FullMethodName       = "/group.v1.GroupService/DeleteExpenseComment"
+	GroupService_ListExpenseComments_FullMethodName        = "/group.v1.GroupService/ListExpenseComments"
+	GroupService_SearchExpenses_FullMethodName             = "/group.v1.GroupService/SearchExpenses"
+	GroupService_ListDeletedExpenses_FullMethodName        = "/group.v1.GroupService/ListDeletedExpenses"
+	GroupService_RestoreExpense_FullMethodName             = "/group.v1.GroupService/RestoreExpense"
+	GroupService_GetExpenseHistory_FullMethodName          = "/group.v1.GroupService/GetExpenseHistory"
+	GroupService_RevertExpense_FullMethodName              = "/group.v1.GroupService/RevertExpense"
+	GroupService_ExportGroup_FullMethodName                = "/group.v1.GroupService/ExportGroup"
+	GroupService_RenderSettlementReport_FullMethodName     = "/group.v1.GroupService/RenderSettlementReport"
+	GroupService_GetAccountMappings_FullMethodName         = "/group.v1.GroupService/GetAccountMappings"
+	GroupService_UpdateAccountMappings_FullMethodName      = "/group.v1.GroupService/UpdateAccountMappings"
+	GroupService_ExportJournal_FullMethodName              = "/group.v1.GroupService/ExportJournal"
+	GroupService_SetMemberBankAccount_FullMethodName       = "/group.v1.GroupService/SetMemberBankAccount"
+	GroupService_DeleteMemberBankAccount_FullMethodName    = "/group.v1.GroupService/DeleteMemberBankAccount"
+	GroupService_ListBankAccounts_FullMethodName           = "/group.v1.GroupService/ListBankAccounts"
+	GroupService_ExportZenginTransfers_FullMethodName      = "/group.v1.GroupService/ExportZenginTransfers"
+	GroupService_CreateExpenseTemplate_FullMethodName      = "/group.v1.GroupService/CreateExpenseTemplate"
+	GroupService_UpdateExpenseTemplate_FullMethodName      = "/group.v1.GroupService/UpdateExpenseTemplate"
+	GroupService_DeleteExpenseTemplate_FullMethodName      = "/group.v1.GroupService/DeleteExpenseTemplate"
+	GroupService_ListExpenseTemplates_FullMethodName       = "/group.v1.GroupService/ListExpenseTemplates"
+	GroupService_AddExpenseFromTemplate_FullMethodName     = "/group.v1.GroupService/AddExpenseFromTemplate"
+	GroupService_GetBudgets_FullMethodName                 = "/group.v1.GroupService/GetBudgets"
+	GroupService_UpdateBudgets_FullMethodName              = "/group.v1.GroupService/UpdateBudgets"
+	GroupService_GetBudgetStatus_FullMethodName            = "/group.v1.GroupService/GetBudgetStatus"
+	GroupService_ListDuplicateExpenses_FullMethodName      = "/group.v1.GroupService/ListDuplicateExpenses"
+	GroupService_MergeExpenses_FullMethodName              = "/group.v1.GroupService/MergeExpenses"
+	GroupService_GetGroupSettings_FullMethodName           = "/group.v1.GroupService/GetGroupSettings"
+	GroupService_UpdateGroupSettings_FullMethodName        = "/group.v1.GroupService/UpdateGroupSettings"
+	GroupService_VoteOnExpense_FullMethodName              = "/group.v1.GroupService/VoteOnExpense"
+	GroupService_ClosePeriod_FullMethodName                = "/group.v1.GroupService/ClosePeriod"
+	GroupService_ListClosedPeriods_FullMethodName          = "/group.v1.GroupService/ListClosedPeriods"
+	GroupService_ReopenPeriod_FullMethodName               = "/group.v1.GroupService/ReopenPeriod"
+	GroupService_CalculateScopedSettlements_FullMethodName = "/group.v1.GroupService/CalculateScopedSettlements"
+	GroupService_SetMemberActivePeriod_FullMethodName      = "/group.v1.GroupService/SetMemberActivePeriod"
+	GroupService_MergeMembers_FullMethodName               = "/group.v1.GroupService/MergeMembers"
+	GroupService_ListMemberMerges_FullMethodName           = "/group.v1.GroupService/ListMemberMerges"
+	GroupService_GetHouseholds_FullMethodName              = "/group.v1.GroupService/GetHouseholds"
+	GroupService_UpdateHouseholds_FullMethodName           = "/group.v1.GroupService/UpdateHouseholds"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -42,11 +90,59 @@ type GroupServiceClient interface {
 	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
 	AddMember(ctx context.Context, in *AddMemberRequest, opts ...grpc.CallOption) (*AddMemberResponse, error)
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error)
 	AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*AddExpenseResponse, error)
+	AddExpenses(ctx context.Context, in *AddExpensesRequest, opts ...grpc.CallOption) (*AddExpensesResponse, error)
+	ImportExpensesCsv(ctx context.Context, in *ImportExpensesCsvRequest, opts ...grpc.CallOption) (*ImportExpensesCsvResponse, error)
+	ImportSplitwise(ctx context.Context, in *ImportSplitwiseRequest, opts ...grpc.CallOption) (*ImportSplitwiseResponse, error)
 	UpdateExpense(ctx context.Context, in *UpdateExpenseRequest, opts ...grpc.CallOption) (*UpdateExpenseResponse, error)
 	DeleteExpense(ctx context.Context, in *DeleteExpenseRequest, opts ...grpc.CallOption) (*DeleteExpenseResponse, error)
 	GetGroupExpenses(ctx context.Context, in *GetGroupExpensesRequest, opts ...grpc.CallOption) (*GetGroupExpensesResponse, error)
 	CalculateSettlements(ctx context.Context, in *CalculateSettlementsRequest, opts ...grpc.CallOption) (*CalculateSettlementsResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	ListExpenseAttachments(ctx context.Context, in *ListExpenseAttachmentsRequest, opts ...grpc.CallOption) (*ListExpenseAttachmentsResponse, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	AddExpenseComment(ctx context.Context, in *AddExpenseCommentRequest, opts ...grpc.CallOption) (*AddExpenseCommentResponse, error)
+	UpdateExpenseComment(ctx context.Context, in *UpdateExpenseCommentRequest, opts ...grpc.CallOption) (*UpdateExpenseCommentResponse, error)
+	DeleteExpenseComment(ctx context.Context, in *DeleteExpenseCommentRequest, opts ...grpc.CallOption) (*DeleteExpenseCommentResponse, error)
+	ListExpenseComments(ctx context.Context, in *ListExpenseCommentsRequest, opts ...grpc.CallOption) (*ListExpenseCommentsResponse, error)
+	SearchExpenses(ctx context.Context, in *SearchExpensesRequest, opts ...grpc.CallOption) (*SearchExpensesResponse, error)
+	ListDeletedExpenses(ctx context.Context, in *ListDeletedExpensesRequest, opts ...grpc.CallOption) (*ListDeletedExpensesResponse, error)
+	RestoreExpense(ctx context.Context, in *RestoreExpenseRequest, opts ...grpc.CallOption) (*RestoreExpenseResponse, error)
+	GetExpenseHistory(ctx context.Context, in *GetExpenseHistoryRequest, opts ...grpc.CallOption) (*GetExpenseHistoryResponse, error)
+	RevertExpense(ctx context.Context, in *RevertExpenseRequest, opts ...grpc.CallOption) (*RevertExpenseResponse, error)
+	ExportGroup(ctx context.Context, in *ExportGroupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGroupResponse], error)
+	RenderSettlementReport(ctx context.Context, in *RenderSettlementReportRequest, opts ...grpc.CallOption) (*RenderSettlementReportResponse, error)
+	GetAccountMappings(ctx context.Context, in *GetAccountMappingsRequest, opts ...grpc.CallOption) (*GetAccountMappingsResponse, error)
+	UpdateAccountMappings(ctx context.Context, in *UpdateAccountMappingsRequest, opts ...grpc.CallOption) (*UpdateAccountMappingsResponse, error)
+	ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (*ExportJournalResponse, error)
+	SetMemberBankAccount(ctx context.Context, in *SetMemberBankAccountRequest, opts ...grpc.CallOption) (*SetMemberBankAccountResponse, error)
+	DeleteMemberBankAccount(ctx context.Context, in *DeleteMemberBankAccountRequest, opts ...grpc.CallOption) (*DeleteMemberBankAccountResponse, error)
+	ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error)
+	ExportZenginTransfers(ctx context.Context, in *ExportZenginTransfersRequest, opts ...grpc.CallOption) (*ExportZenginTransfersResponse, error)
+	CreateExpenseTemplate(ctx context.Context, in *CreateExpenseTemplateRequest, opts ...grpc.CallOption) (*CreateExpenseTemplateResponse, error)
+	UpdateExpenseTemplate(ctx context.Context, in *UpdateExpenseTemplateRequest, opts ...grpc.CallOption) (*UpdateExpenseTemplateResponse, error)
+	DeleteExpenseTemplate(ctx context.Context, in *DeleteExpenseTemplateRequest, opts ...grpc.CallOption) (*DeleteExpenseTemplateResponse, error)
+	ListExpenseTemplates(ctx context.Context, in *ListExpenseTemplatesRequest, opts ...grpc.CallOption) (*ListExpenseTemplatesResponse, error)
+	AddExpenseFromTemplate(ctx context.Context, in *AddExpenseFromTemplateRequest, opts ...grpc.CallOption) (*AddExpenseFromTemplateResponse, error)
+	GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*GetBudgetsResponse, error)
+	UpdateBudgets(ctx context.Context, in *UpdateBudgetsRequest, opts ...grpc.CallOption) (*UpdateBudgetsResponse, error)
+	GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error)
+	ListDuplicateExpenses(ctx context.Context, in *ListDuplicateExpensesRequest, opts ...grpc.CallOption) (*ListDuplicateExpensesResponse, error)
+	MergeExpenses(ctx context.Context, in *MergeExpensesRequest, opts ...grpc.CallOption) (*MergeExpensesResponse, error)
+	GetGroupSettings(ctx context.Context, in *GetGroupSettingsRequest, opts ...grpc.CallOption) (*GetGroupSettingsResponse, error)
+	UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...grpc.CallOption) (*UpdateGroupSettingsResponse, error)
+	VoteOnExpense(ctx context.Context, in *VoteOnExpenseRequest, opts ...grpc.CallOption) (*VoteOnExpenseResponse, error)
+	ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error)
+	ListClosedPeriods(ctx context.Context, in *ListClosedPeriodsRequest, opts ...grpc.CallOption) (*ListClosedPeriodsResponse, error)
+	ReopenPeriod(ctx context.Context, in *ReopenPeriodRequest, opts ...grpc.CallOption) (*ReopenPeriodResponse, error)
+	CalculateScopedSettlements(ctx context.Context, in *CalculateScopedSettlementsRequest, opts ...grpc.CallOption) (*CalculateScopedSettlementsResponse, error)
+	SetMemberActivePeriod(ctx context.Context, in *SetMemberActivePeriodRequest, opts ...grpc.CallOption) (*SetMemberActivePeriodResponse, error)
+	MergeMembers(ctx context.Context, in *MergeMembersRequest, opts ...grpc.CallOption) (*MergeMembersResponse, error)
+	ListMemberMerges(ctx context.Context, in *ListMemberMergesRequest, opts ...grpc.CallOption) (*ListMemberMergesResponse, error)
+	GetHouseholds(ctx context.Context, in *GetHouseholdsRequest, opts ...grpc.CallOption) (*GetHouseholdsResponse, error)
+	UpdateHouseholds(ctx context.Context, in *UpdateHouseholdsRequest, opts ...grpc.CallOption) (*UpdateHouseholdsResponse, error)
 }
 
 type groupServiceClient struct {
@@ -117,6 +213,16 @@ func (c *groupServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberR
 	return out, nil
 }
 
+func (c *groupServiceClient) UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) AddExpense(ctx context.Context, in *AddExpenseRequest, opts ...grpc.CallOption) (*AddExpenseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddExpenseResponse)
@@ -127,6 +233,36 @@ func (c *groupServiceClient) AddExpense(ctx context.Context, in *AddExpenseReque
 	return out, nil
 }
 
+func (c *groupServiceClient) AddExpenses(ctx context.Context, in *AddExpensesRequest, opts ...grpc.CallOption) (*AddExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddExpensesResponse)
+	err := c.cc.Invoke(ctx, GroupService_AddExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ImportExpensesCsv(ctx context.Context, in *ImportExpensesCsvRequest, opts ...grpc.CallOption) (*ImportExpensesCsvResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportExpensesCsvResponse)
+	err := c.cc.Invoke(ctx, GroupService_ImportExpensesCsv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ImportSplitwise(ctx context.Context, in *ImportSplitwiseRequest, opts ...grpc.CallOption) (*ImportSplitwiseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSplitwiseResponse)
+	err := c.cc.Invoke(ctx, GroupService_ImportSplitwise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) UpdateExpense(ctx context.Context, in *UpdateExpenseRequest, opts ...grpc.CallOption) (*UpdateExpenseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateExpenseResponse)
@@ -167,279 +303,1771 @@ func (c *groupServiceClient) CalculateSettlements(ctx context.Context, in *Calcu
 	return out, nil
 }
 
-// GroupServiceServer is the server API for GroupService service.
-// All implementations must embed UnimplementedGroupServiceServer
-// for forward compatibility.
-type GroupServiceServer interface {
-	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
-	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
-	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
-	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
-	AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error)
-	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
-	AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error)
-	UpdateExpense(context.Context, *UpdateExpenseRequest) (*UpdateExpenseResponse, error)
-	DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error)
-	GetGroupExpenses(context.Context, *GetGroupExpensesRequest) (*GetGroupExpensesResponse, error)
-	CalculateSettlements(context.Context, *CalculateSettlementsRequest) (*CalculateSettlementsResponse, error)
-	mustEmbedUnimplementedGroupServiceServer()
+func (c *groupServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroupService_ServiceDesc.Streams[0], GroupService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
 }
 
-// UnimplementedGroupServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedGroupServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
 
-func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+func (c *groupServiceClient) ListExpenseAttachments(ctx context.Context, in *ListExpenseAttachmentsRequest, opts ...grpc.CallOption) (*ListExpenseAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpenseAttachmentsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListExpenseAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGroupServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+
+func (c *groupServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroupService_ServiceDesc.Streams[1], GroupService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *groupServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGroupServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+func (c *groupServiceClient) AddExpenseComment(ctx context.Context, in *AddExpenseCommentRequest, opts ...grpc.CallOption) (*AddExpenseCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddExpenseCommentResponse)
+	err := c.cc.Invoke(ctx, GroupService_AddExpenseComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGroupServiceServer) AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+
+func (c *groupServiceClient) UpdateExpenseComment(ctx context.Context, in *UpdateExpenseCommentRequest, opts ...grpc.CallOption) (*UpdateExpenseCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExpenseCommentResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateExpenseComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGroupServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+
+func (c *groupServiceClient) DeleteExpenseComment(ctx context.Context, in *DeleteExpenseCommentRequest, opts ...grpc.CallOption) (*DeleteExpenseCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExpenseCommentResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteExpenseComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGroupServiceServer) AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddExpense not implemented")
+
+func (c *groupServiceClient) ListExpenseComments(ctx context.Context, in *ListExpenseCommentsRequest, opts ...grpc.CallOption) (*ListExpenseCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpenseCommentsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListExpenseComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) SearchExpenses(ctx context.Context, in *SearchExpensesRequest, opts ...grpc.CallOption) (*SearchExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchExpensesResponse)
+	err := c.cc.Invoke(ctx, GroupService_SearchExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListDeletedExpenses(ctx context.Context, in *ListDeletedExpensesRequest, opts ...grpc.CallOption) (*ListDeletedExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedExpensesResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListDeletedExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RestoreExpense(ctx context.Context, in *RestoreExpenseRequest, opts ...grpc.CallOption) (*RestoreExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreExpenseResponse)
+	err := c.cc.Invoke(ctx, GroupService_RestoreExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetExpenseHistory(ctx context.Context, in *GetExpenseHistoryRequest, opts ...grpc.CallOption) (*GetExpenseHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExpenseHistoryResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetExpenseHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RevertExpense(ctx context.Context, in *RevertExpenseRequest, opts ...grpc.CallOption) (*RevertExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertExpenseResponse)
+	err := c.cc.Invoke(ctx, GroupService_RevertExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ExportGroup(ctx context.Context, in *ExportGroupRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportGroupResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GroupService_ServiceDesc.Streams[2], GroupService_ExportGroup_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportGroupRequest, ExportGroupResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_ExportGroupClient = grpc.ServerStreamingClient[ExportGroupResponse]
+
+func (c *groupServiceClient) RenderSettlementReport(ctx context.Context, in *RenderSettlementReportRequest, opts ...grpc.CallOption) (*RenderSettlementReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderSettlementReportResponse)
+	err := c.cc.Invoke(ctx, GroupService_RenderSettlementReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetAccountMappings(ctx context.Context, in *GetAccountMappingsRequest, opts ...grpc.CallOption) (*GetAccountMappingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountMappingsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetAccountMappings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateAccountMappings(ctx context.Context, in *UpdateAccountMappingsRequest, opts ...grpc.CallOption) (*UpdateAccountMappingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountMappingsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateAccountMappings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (*ExportJournalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportJournalResponse)
+	err := c.cc.Invoke(ctx, GroupService_ExportJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) SetMemberBankAccount(ctx context.Context, in *SetMemberBankAccountRequest, opts ...grpc.CallOption) (*SetMemberBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberBankAccountResponse)
+	err := c.cc.Invoke(ctx, GroupService_SetMemberBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DeleteMemberBankAccount(ctx context.Context, in *DeleteMemberBankAccountRequest, opts ...grpc.CallOption) (*DeleteMemberBankAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMemberBankAccountResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteMemberBankAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListBankAccounts(ctx context.Context, in *ListBankAccountsRequest, opts ...grpc.CallOption) (*ListBankAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBankAccountsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListBankAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ExportZenginTransfers(ctx context.Context, in *ExportZenginTransfersRequest, opts ...grpc.CallOption) (*ExportZenginTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportZenginTransfersResponse)
+	err := c.cc.Invoke(ctx, GroupService_ExportZenginTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) CreateExpenseTemplate(ctx context.Context, in *CreateExpenseTemplateRequest, opts ...grpc.CallOption) (*CreateExpenseTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateExpenseTemplateResponse)
+	err := c.cc.Invoke(ctx, GroupService_CreateExpenseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateExpenseTemplate(ctx context.Context, in *UpdateExpenseTemplateRequest, opts ...grpc.CallOption) (*UpdateExpenseTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExpenseTemplateResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateExpenseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) DeleteExpenseTemplate(ctx context.Context, in *DeleteExpenseTemplateRequest, opts ...grpc.CallOption) (*DeleteExpenseTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteExpenseTemplateResponse)
+	err := c.cc.Invoke(ctx, GroupService_DeleteExpenseTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListExpenseTemplates(ctx context.Context, in *ListExpenseTemplatesRequest, opts ...grpc.CallOption) (*ListExpenseTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpenseTemplatesResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListExpenseTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) AddExpenseFromTemplate(ctx context.Context, in *AddExpenseFromTemplateRequest, opts ...grpc.CallOption) (*AddExpenseFromTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddExpenseFromTemplateResponse)
+	err := c.cc.Invoke(ctx, GroupService_AddExpenseFromTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*GetBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateBudgets(ctx context.Context, in *UpdateBudgetsRequest, opts ...grpc.CallOption) (*UpdateBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBudgetsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetBudgetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListDuplicateExpenses(ctx context.Context, in *ListDuplicateExpensesRequest, opts ...grpc.CallOption) (*ListDuplicateExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateExpensesResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListDuplicateExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) MergeExpenses(ctx context.Context, in *MergeExpensesRequest, opts ...grpc.CallOption) (*MergeExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeExpensesResponse)
+	err := c.cc.Invoke(ctx, GroupService_MergeExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetGroupSettings(ctx context.Context, in *GetGroupSettingsRequest, opts ...grpc.CallOption) (*GetGroupSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupSettingsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateGroupSettings(ctx context.Context, in *UpdateGroupSettingsRequest, opts ...grpc.CallOption) (*UpdateGroupSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupSettingsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroupSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) VoteOnExpense(ctx context.Context, in *VoteOnExpenseRequest, opts ...grpc.CallOption) (*VoteOnExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteOnExpenseResponse)
+	err := c.cc.Invoke(ctx, GroupService_VoteOnExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ClosePeriod(ctx context.Context, in *ClosePeriodRequest, opts ...grpc.CallOption) (*ClosePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePeriodResponse)
+	err := c.cc.Invoke(ctx, GroupService_ClosePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListClosedPeriods(ctx context.Context, in *ListClosedPeriodsRequest, opts ...grpc.CallOption) (*ListClosedPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClosedPeriodsResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListClosedPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ReopenPeriod(ctx context.Context, in *ReopenPeriodRequest, opts ...grpc.CallOption) (*ReopenPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenPeriodResponse)
+	err := c.cc.Invoke(ctx, GroupService_ReopenPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) CalculateScopedSettlements(ctx context.Context, in *CalculateScopedSettlementsRequest, opts ...grpc.CallOption) (*CalculateScopedSettlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateScopedSettlementsResponse)
+	err := c.cc.Invoke(ctx, GroupService_CalculateScopedSettlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) SetMemberActivePeriod(ctx context.Context, in *SetMemberActivePeriodRequest, opts ...grpc.CallOption) (*SetMemberActivePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberActivePeriodResponse)
+	err := c.cc.Invoke(ctx, GroupService_SetMemberActivePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) MergeMembers(ctx context.Context, in *MergeMembersRequest, opts ...grpc.CallOption) (*MergeMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeMembersResponse)
+	err := c.cc.Invoke(ctx, GroupService_MergeMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListMemberMerges(ctx context.Context, in *ListMemberMergesRequest, opts ...grpc.CallOption) (*ListMemberMergesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemberMergesResponse)
+	err := c.cc.Invoke(ctx, GroupService_ListMemberMerges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetHouseholds(ctx context.Context, in *GetHouseholdsRequest, opts ...grpc.CallOption) (*GetHouseholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHouseholdsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetHouseholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateHouseholds(ctx context.Context, in *UpdateHouseholdsRequest, opts ...grpc.CallOption) (*UpdateHouseholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateHouseholdsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateHouseholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupServiceServer is the server API for GroupService service.
+// All implementations must embed UnimplementedGroupServiceServer
+// for forward compatibility.
+type GroupServiceServer interface {
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error)
+	AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error)
+	AddExpenses(context.Context, *AddExpensesRequest) (*AddExpensesResponse, error)
+	ImportExpensesCsv(context.Context, *ImportExpensesCsvRequest) (*ImportExpensesCsvResponse, error)
+	ImportSplitwise(context.Context, *ImportSplitwiseRequest) (*ImportSplitwiseResponse, error)
+	UpdateExpense(context.Context, *UpdateExpenseRequest) (*UpdateExpenseResponse, error)
+	DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error)
+	GetGroupExpenses(context.Context, *GetGroupExpensesRequest) (*GetGroupExpensesResponse, error)
+	CalculateSettlements(context.Context, *CalculateSettlementsRequest) (*CalculateSettlementsResponse, error)
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	ListExpenseAttachments(context.Context, *ListExpenseAttachmentsRequest) (*ListExpenseAttachmentsResponse, error)
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	AddExpenseComment(context.Context, *AddExpenseCommentRequest) (*AddExpenseCommentResponse, error)
+	UpdateExpenseComment(context.Context, *UpdateExpenseCommentRequest) (*UpdateExpenseCommentResponse, error)
+	DeleteExpenseComment(context.Context, *DeleteExpenseCommentRequest) (*DeleteExpenseCommentResponse, error)
+	ListExpenseComments(context.Context, *ListExpenseCommentsRequest) (*ListExpenseCommentsResponse, error)
+	SearchExpenses(context.Context, *SearchExpensesRequest) (*SearchExpensesResponse, error)
+	ListDeletedExpenses(context.Context, *ListDeletedExpensesRequest) (*ListDeletedExpensesResponse, error)
+	RestoreExpense(context.Context, *RestoreExpenseRequest) (*RestoreExpenseResponse, error)
+	GetExpenseHistory(context.Context, *GetExpenseHistoryRequest) (*GetExpenseHistoryResponse, error)
+	RevertExpense(context.Context, *RevertExpenseRequest) (*RevertExpenseResponse, error)
+	ExportGroup(*ExportGroupRequest, grpc.ServerStreamingServer[ExportGroupResponse]) error
+	RenderSettlementReport(context.Context, *RenderSettlementReportRequest) (*RenderSettlementReportResponse, error)
+	GetAccountMappings(context.Context, *GetAccountMappingsRequest) (*GetAccountMappingsResponse, error)
+	UpdateAccountMappings(context.Context, *UpdateAccountMappingsRequest) (*UpdateAccountMappingsResponse, error)
+	ExportJournal(context.Context, *ExportJournalRequest) (*ExportJournalResponse, error)
+	SetMemberBankAccount(context.Context, *SetMemberBankAccountRequest) (*SetMemberBankAccountResponse, error)
+	DeleteMemberBankAccount(context.Context, *DeleteMemberBankAccountRequest) (*DeleteMemberBankAccountResponse, error)
+	ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error)
+	ExportZenginTransfers(context.Context, *ExportZenginTransfersRequest) (*ExportZenginTransfersResponse, error)
+	CreateExpenseTemplate(context.Context, *CreateExpenseTemplateRequest) (*CreateExpenseTemplateResponse, error)
+	UpdateExpenseTemplate(context.Context, *UpdateExpenseTemplateRequest) (*UpdateExpenseTemplateResponse, error)
+	DeleteExpenseTemplate(context.Context, *DeleteExpenseTemplateRequest) (*DeleteExpenseTemplateResponse, error)
+	ListExpenseTemplates(context.Context, *ListExpenseTemplatesRequest) (*ListExpenseTemplatesResponse, error)
+	AddExpenseFromTemplate(context.Context, *AddExpenseFromTemplateRequest) (*AddExpenseFromTemplateResponse, error)
+	GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error)
+	UpdateBudgets(context.Context, *UpdateBudgetsRequest) (*UpdateBudgetsResponse, error)
+	GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error)
+	ListDuplicateExpenses(context.Context, *ListDuplicateExpensesRequest) (*ListDuplicateExpensesResponse, error)
+	MergeExpenses(context.Context, *MergeExpensesRequest) (*MergeExpensesResponse, error)
+	GetGroupSettings(context.Context, *GetGroupSettingsRequest) (*GetGroupSettingsResponse, error)
+	UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*UpdateGroupSettingsResponse, error)
+	VoteOnExpense(context.Context, *VoteOnExpenseRequest) (*VoteOnExpenseResponse, error)
+	ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error)
+	ListClosedPeriods(context.Context, *ListClosedPeriodsRequest) (*ListClosedPeriodsResponse, error)
+	ReopenPeriod(context.Context, *ReopenPeriodRequest) (*ReopenPeriodResponse, error)
+	CalculateScopedSettlements(context.Context, *CalculateScopedSettlementsRequest) (*CalculateScopedSettlementsResponse, error)
+	SetMemberActivePeriod(context.Context, *SetMemberActivePeriodRequest) (*SetMemberActivePeriodResponse, error)
+	MergeMembers(context.Context, *MergeMembersRequest) (*MergeMembersResponse, error)
+	ListMemberMerges(context.Context, *ListMemberMergesRequest) (*ListMemberMergesResponse, error)
+	GetHouseholds(context.Context, *GetHouseholdsRequest) (*GetHouseholdsResponse, error)
+	UpdateHouseholds(context.Context, *UpdateHouseholdsRequest) (*UpdateHouseholdsResponse, error)
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+// UnimplementedGroupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupServiceServer struct{}
+
+func (UnimplementedGroupServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) AddMember(context.Context, *AddMemberRequest) (*AddMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedGroupServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
+}
+func (UnimplementedGroupServiceServer) AddExpense(context.Context, *AddExpenseRequest) (*AddExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) AddExpenses(context.Context, *AddExpensesRequest) (*AddExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) ImportExpensesCsv(context.Context, *ImportExpensesCsvRequest) (*ImportExpensesCsvResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExpensesCsv not implemented")
+}
+func (UnimplementedGroupServiceServer) ImportSplitwise(context.Context, *ImportSplitwiseRequest) (*ImportSplitwiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSplitwise not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateExpense(context.Context, *UpdateExpenseRequest) (*UpdateExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupExpenses(context.Context, *GetGroupExpensesRequest) (*GetGroupExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) CalculateSettlements(context.Context, *CalculateSettlementsRequest) (*CalculateSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateSettlements not implemented")
+}
+func (UnimplementedGroupServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedGroupServiceServer) ListExpenseAttachments(context.Context, *ListExpenseAttachmentsRequest) (*ListExpenseAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpenseAttachments not implemented")
+}
+func (UnimplementedGroupServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedGroupServiceServer) AddExpenseComment(context.Context, *AddExpenseCommentRequest) (*AddExpenseCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpenseComment not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateExpenseComment(context.Context, *UpdateExpenseCommentRequest) (*UpdateExpenseCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExpenseComment not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteExpenseComment(context.Context, *DeleteExpenseCommentRequest) (*DeleteExpenseCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpenseComment not implemented")
+}
+func (UnimplementedGroupServiceServer) ListExpenseComments(context.Context, *ListExpenseCommentsRequest) (*ListExpenseCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpenseComments not implemented")
+}
+func (UnimplementedGroupServiceServer) SearchExpenses(context.Context, *SearchExpensesRequest) (*SearchExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) ListDeletedExpenses(context.Context, *ListDeletedExpensesRequest) (*ListDeletedExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) RestoreExpense(context.Context, *RestoreExpenseRequest) (*RestoreExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) GetExpenseHistory(context.Context, *GetExpenseHistoryRequest) (*GetExpenseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenseHistory not implemented")
+}
+func (UnimplementedGroupServiceServer) RevertExpense(context.Context, *RevertExpenseRequest) (*RevertExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) ExportGroup(*ExportGroupRequest, grpc.ServerStreamingServer[ExportGroupResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportGroup not implemented")
+}
+func (UnimplementedGroupServiceServer) RenderSettlementReport(context.Context, *RenderSettlementReportRequest) (*RenderSettlementReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderSettlementReport not implemented")
+}
+func (UnimplementedGroupServiceServer) GetAccountMappings(context.Context, *GetAccountMappingsRequest) (*GetAccountMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountMappings not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateAccountMappings(context.Context, *UpdateAccountMappingsRequest) (*UpdateAccountMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountMappings not implemented")
+}
+func (UnimplementedGroupServiceServer) ExportJournal(context.Context, *ExportJournalRequest) (*ExportJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportJournal not implemented")
+}
+func (UnimplementedGroupServiceServer) SetMemberBankAccount(context.Context, *SetMemberBankAccountRequest) (*SetMemberBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberBankAccount not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteMemberBankAccount(context.Context, *DeleteMemberBankAccountRequest) (*DeleteMemberBankAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemberBankAccount not implemented")
+}
+func (UnimplementedGroupServiceServer) ListBankAccounts(context.Context, *ListBankAccountsRequest) (*ListBankAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBankAccounts not implemented")
+}
+func (UnimplementedGroupServiceServer) ExportZenginTransfers(context.Context, *ExportZenginTransfersRequest) (*ExportZenginTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportZenginTransfers not implemented")
+}
+func (UnimplementedGroupServiceServer) CreateExpenseTemplate(context.Context, *CreateExpenseTemplateRequest) (*CreateExpenseTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExpenseTemplate not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateExpenseTemplate(context.Context, *UpdateExpenseTemplateRequest) (*UpdateExpenseTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExpenseTemplate not implemented")
+}
+func (UnimplementedGroupServiceServer) DeleteExpenseTemplate(context.Context, *DeleteExpenseTemplateRequest) (*DeleteExpenseTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpenseTemplate not implemented")
+}
+func (UnimplementedGroupServiceServer) ListExpenseTemplates(context.Context, *ListExpenseTemplatesRequest) (*ListExpenseTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpenseTemplates not implemented")
+}
+func (UnimplementedGroupServiceServer) AddExpenseFromTemplate(context.Context, *AddExpenseFromTemplateRequest) (*AddExpenseFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpenseFromTemplate not implemented")
+}
+func (UnimplementedGroupServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgets not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateBudgets(context.Context, *UpdateBudgetsRequest) (*UpdateBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBudgets not implemented")
+}
+func (UnimplementedGroupServiceServer) GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetStatus not implemented")
+}
+func (UnimplementedGroupServiceServer) ListDuplicateExpenses(context.Context, *ListDuplicateExpensesRequest) (*ListDuplicateExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) MergeExpenses(context.Context, *MergeExpensesRequest) (*MergeExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeExpenses not implemented")
+}
+func (UnimplementedGroupServiceServer) GetGroupSettings(context.Context, *GetGroupSettingsRequest) (*GetGroupSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupSettings not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroupSettings(context.Context, *UpdateGroupSettingsRequest) (*UpdateGroupSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupSettings not implemented")
+}
+func (UnimplementedGroupServiceServer) VoteOnExpense(context.Context, *VoteOnExpenseRequest) (*VoteOnExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteOnExpense not implemented")
+}
+func (UnimplementedGroupServiceServer) ClosePeriod(context.Context, *ClosePeriodRequest) (*ClosePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedGroupServiceServer) ListClosedPeriods(context.Context, *ListClosedPeriodsRequest) (*ListClosedPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClosedPeriods not implemented")
+}
+func (UnimplementedGroupServiceServer) ReopenPeriod(context.Context, *ReopenPeriodRequest) (*ReopenPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenPeriod not implemented")
+}
+func (UnimplementedGroupServiceServer) CalculateScopedSettlements(context.Context, *CalculateScopedSettlementsRequest) (*CalculateScopedSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateScopedSettlements not implemented")
+}
+func (UnimplementedGroupServiceServer) SetMemberActivePeriod(context.Context, *SetMemberActivePeriodRequest) (*SetMemberActivePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberActivePeriod not implemented")
+}
+func (UnimplementedGroupServiceServer) MergeMembers(context.Context, *MergeMembersRequest) (*MergeMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) ListMemberMerges(context.Context, *ListMemberMergesRequest) (*ListMemberMergesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemberMerges not implemented")
+}
+func (UnimplementedGroupServiceServer) GetHouseholds(context.Context, *GetHouseholdsRequest) (*GetHouseholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouseholds not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateHouseholds(context.Context, *UpdateHouseholdsRequest) (*UpdateHouseholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHouseholds not implemented")
+}
+func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
+func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupServiceServer will
+// result in compilation errors.
+type UnsafeGroupServiceServer interface {
+	mustEmbedUnimplementedGroupServiceServer()
+}
+
+func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupService_ServiceDesc, srv)
+}
+
+func _GroupService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddMember(ctx, req.(*AddMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddExpense(ctx, req.(*AddExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddExpenses(ctx, req.(*AddExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ImportExpensesCsv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExpensesCsvRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ImportExpensesCsv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ImportExpensesCsv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ImportExpensesCsv(ctx, req.(*ImportExpensesCsvRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ImportSplitwise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSplitwiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ImportSplitwise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ImportSplitwise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ImportSplitwise(ctx, req.(*ImportSplitwiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateExpense(ctx, req.(*UpdateExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteExpense(ctx, req.(*DeleteExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetGroupExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupExpenses(ctx, req.(*GetGroupExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_CalculateSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CalculateSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CalculateSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CalculateSettlements(ctx, req.(*CalculateSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GroupServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _GroupService_ListExpenseAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpenseAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListExpenseAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListExpenseAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListExpenseAttachments(ctx, req.(*ListExpenseAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroupServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _GroupService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddExpenseComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddExpenseComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddExpenseComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddExpenseComment(ctx, req.(*AddExpenseCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateExpenseComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpenseCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateExpenseComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateExpenseComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateExpenseComment(ctx, req.(*UpdateExpenseCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteExpenseComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteExpenseComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteExpenseComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteExpenseComment(ctx, req.(*DeleteExpenseCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListExpenseComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpenseCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListExpenseComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListExpenseComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListExpenseComments(ctx, req.(*ListExpenseCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_SearchExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).SearchExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_SearchExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).SearchExpenses(ctx, req.(*SearchExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListDeletedExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListDeletedExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListDeletedExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListDeletedExpenses(ctx, req.(*ListDeletedExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RestoreExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RestoreExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RestoreExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RestoreExpense(ctx, req.(*RestoreExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetExpenseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpenseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetExpenseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetExpenseHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetExpenseHistory(ctx, req.(*GetExpenseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RevertExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RevertExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RevertExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RevertExpense(ctx, req.(*RevertExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ExportGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportGroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GroupServiceServer).ExportGroup(m, &grpc.GenericServerStream[ExportGroupRequest, ExportGroupResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GroupService_ExportGroupServer = grpc.ServerStreamingServer[ExportGroupResponse]
+
+func _GroupService_RenderSettlementReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderSettlementReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RenderSettlementReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_RenderSettlementReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RenderSettlementReport(ctx, req.(*RenderSettlementReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetAccountMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetAccountMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetAccountMappings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetAccountMappings(ctx, req.(*GetAccountMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateAccountMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateAccountMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateAccountMappings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateAccountMappings(ctx, req.(*UpdateAccountMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ExportJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ExportJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ExportJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ExportJournal(ctx, req.(*ExportJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_SetMemberBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).SetMemberBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_SetMemberBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).SetMemberBankAccount(ctx, req.(*SetMemberBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteMemberBankAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemberBankAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteMemberBankAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteMemberBankAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteMemberBankAccount(ctx, req.(*DeleteMemberBankAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListBankAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBankAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListBankAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListBankAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListBankAccounts(ctx, req.(*ListBankAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ExportZenginTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportZenginTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ExportZenginTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ExportZenginTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ExportZenginTransfers(ctx, req.(*ExportZenginTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_CreateExpenseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpenseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CreateExpenseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CreateExpenseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CreateExpenseTemplate(ctx, req.(*CreateExpenseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateExpenseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExpenseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateExpenseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateExpenseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateExpenseTemplate(ctx, req.(*UpdateExpenseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_DeleteExpenseTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExpenseTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).DeleteExpenseTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_DeleteExpenseTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).DeleteExpenseTemplate(ctx, req.(*DeleteExpenseTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListExpenseTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpenseTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListExpenseTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListExpenseTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListExpenseTemplates(ctx, req.(*ListExpenseTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddExpenseFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddExpenseFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_AddExpenseFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddExpenseFromTemplate(ctx, req.(*AddExpenseFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGroupServiceServer) UpdateExpense(context.Context, *UpdateExpenseRequest) (*UpdateExpenseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExpense not implemented")
+
+func _GroupService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGroupServiceServer) DeleteExpense(context.Context, *DeleteExpenseRequest) (*DeleteExpenseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpense not implemented")
+
+func _GroupService_UpdateBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateBudgets(ctx, req.(*UpdateBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGroupServiceServer) GetGroupExpenses(context.Context, *GetGroupExpensesRequest) (*GetGroupExpensesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupExpenses not implemented")
+
+func _GroupService_GetBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetBudgetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetBudgetStatus(ctx, req.(*GetBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGroupServiceServer) CalculateSettlements(context.Context, *CalculateSettlementsRequest) (*CalculateSettlementsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateSettlements not implemented")
+
+func _GroupService_ListDuplicateExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListDuplicateExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_ListDuplicateExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListDuplicateExpenses(ctx, req.(*ListDuplicateExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
-func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GroupServiceServer will
-// result in compilation errors.
-type UnsafeGroupServiceServer interface {
-	mustEmbedUnimplementedGroupServiceServer()
+func _GroupService_MergeExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeExpensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).MergeExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_MergeExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).MergeExpenses(ctx, req.(*MergeExpensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGroupServiceServer(s grpc.ServiceRegistrar, srv GroupServiceServer) {
-	// If the following call pancis, it indicates UnimplementedGroupServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _GroupService_GetGroupSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&GroupService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupSettings(ctx, req.(*GetGroupSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGroupRequest)
+func _GroupService_UpdateGroupSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).CreateGroup(ctx, in)
+		return srv.(GroupServiceServer).UpdateGroupSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_CreateGroup_FullMethodName,
+		FullMethod: GroupService_UpdateGroupSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+		return srv.(GroupServiceServer).UpdateGroupSettings(ctx, req.(*UpdateGroupSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupRequest)
+func _GroupService_VoteOnExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteOnExpenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroup(ctx, in)
+		return srv.(GroupServiceServer).VoteOnExpense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroup_FullMethodName,
+		FullMethod: GroupService_VoteOnExpense_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+		return srv.(GroupServiceServer).VoteOnExpense(ctx, req.(*VoteOnExpenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGroupRequest)
+func _GroupService_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePeriodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).UpdateGroup(ctx, in)
+		return srv.(GroupServiceServer).ClosePeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_UpdateGroup_FullMethodName,
+		FullMethod: GroupService_ClosePeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+		return srv.(GroupServiceServer).ClosePeriod(ctx, req.(*ClosePeriodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteGroupRequest)
+func _GroupService_ListClosedPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClosedPeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).DeleteGroup(ctx, in)
+		return srv.(GroupServiceServer).ListClosedPeriods(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_DeleteGroup_FullMethodName,
+		FullMethod: GroupService_ListClosedPeriods_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+		return srv.(GroupServiceServer).ListClosedPeriods(ctx, req.(*ListClosedPeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMemberRequest)
+func _GroupService_ReopenPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenPeriodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).AddMember(ctx, in)
+		return srv.(GroupServiceServer).ReopenPeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_AddMember_FullMethodName,
+		FullMethod: GroupService_ReopenPeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).AddMember(ctx, req.(*AddMemberRequest))
+		return srv.(GroupServiceServer).ReopenPeriod(ctx, req.(*ReopenPeriodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMemberRequest)
+func _GroupService_CalculateScopedSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateScopedSettlementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).RemoveMember(ctx, in)
+		return srv.(GroupServiceServer).CalculateScopedSettlements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_RemoveMember_FullMethodName,
+		FullMethod: GroupService_CalculateScopedSettlements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+		return srv.(GroupServiceServer).CalculateScopedSettlements(ctx, req.(*CalculateScopedSettlementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_AddExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddExpenseRequest)
+func _GroupService_SetMemberActivePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberActivePeriodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).AddExpense(ctx, in)
+		return srv.(GroupServiceServer).SetMemberActivePeriod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_AddExpense_FullMethodName,
+		FullMethod: GroupService_SetMemberActivePeriod_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).AddExpense(ctx, req.(*AddExpenseRequest))
+		return srv.(GroupServiceServer).SetMemberActivePeriod(ctx, req.(*SetMemberActivePeriodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_UpdateExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExpenseRequest)
+func _GroupService_MergeMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).UpdateExpense(ctx, in)
+		return srv.(GroupServiceServer).MergeMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_UpdateExpense_FullMethodName,
+		FullMethod: GroupService_MergeMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).UpdateExpense(ctx, req.(*UpdateExpenseRequest))
+		return srv.(GroupServiceServer).MergeMembers(ctx, req.(*MergeMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_DeleteExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteExpenseRequest)
+func _GroupService_ListMemberMerges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberMergesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).DeleteExpense(ctx, in)
+		return srv.(GroupServiceServer).ListMemberMerges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_DeleteExpense_FullMethodName,
+		FullMethod: GroupService_ListMemberMerges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).DeleteExpense(ctx, req.(*DeleteExpenseRequest))
+		return srv.(GroupServiceServer).ListMemberMerges(ctx, req.(*ListMemberMergesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_GetGroupExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGroupExpensesRequest)
+func _GroupService_GetHouseholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHouseholdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).GetGroupExpenses(ctx, in)
+		return srv.(GroupServiceServer).GetHouseholds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_GetGroupExpenses_FullMethodName,
+		FullMethod: GroupService_GetHouseholds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).GetGroupExpenses(ctx, req.(*GetGroupExpensesRequest))
+		return srv.(GroupServiceServer).GetHouseholds(ctx, req.(*GetHouseholdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GroupService_CalculateSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateSettlementsRequest)
+func _GroupService_UpdateHouseholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHouseholdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GroupServiceServer).CalculateSettlements(ctx, in)
+		return srv.(GroupServiceServer).UpdateHouseholds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GroupService_CalculateSettlements_FullMethodName,
+		FullMethod: GroupService_UpdateHouseholds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GroupServiceServer).CalculateSettlements(ctx, req.(*CalculateSettlementsRequest))
+		return srv.(GroupServiceServer).UpdateHouseholds(ctx, req.(*UpdateHouseholdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -475,10 +2103,26 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMember",
 			Handler:    _GroupService_RemoveMember_Handler,
 		},
+		{
+			MethodName: "UpdateMember",
+			Handler:    _GroupService_UpdateMember_Handler,
+		},
 		{
 			MethodName: "AddExpense",
 			Handler:    _GroupService_AddExpense_Handler,
 		},
+		{
+			MethodName: "AddExpenses",
+			Handler:    _GroupService_AddExpenses_Handler,
+		},
+		{
+			MethodName: "ImportExpensesCsv",
+			Handler:    _GroupService_ImportExpensesCsv_Handler,
+		},
+		{
+			MethodName: "ImportSplitwise",
+			Handler:    _GroupService_ImportSplitwise_Handler,
+		},
 		{
 			MethodName: "UpdateExpense",
 			Handler:    _GroupService_UpdateExpense_Handler,
@@ -495,7 +2139,187 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateSettlements",
 			Handler:    _GroupService_CalculateSettlements_Handler,
 		},
+		{
+			MethodName: "ListExpenseAttachments",
+			Handler:    _GroupService_ListExpenseAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _GroupService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "AddExpenseComment",
+			Handler:    _GroupService_AddExpenseComment_Handler,
+		},
+		{
+			MethodName: "UpdateExpenseComment",
+			Handler:    _GroupService_UpdateExpenseComment_Handler,
+		},
+		{
+			MethodName: "DeleteExpenseComment",
+			Handler:    _GroupService_DeleteExpenseComment_Handler,
+		},
+		{
+			MethodName: "ListExpenseComments",
+			Handler:    _GroupService_ListExpenseComments_Handler,
+		},
+		{
+			MethodName: "SearchExpenses",
+			Handler:    _GroupService_SearchExpenses_Handler,
+		},
+		{
+			MethodName: "ListDeletedExpenses",
+			Handler:    _GroupService_ListDeletedExpenses_Handler,
+		},
+		{
+			MethodName: "RestoreExpense",
+			Handler:    _GroupService_RestoreExpense_Handler,
+		},
+		{
+			MethodName: "GetExpenseHistory",
+			Handler:    _GroupService_GetExpenseHistory_Handler,
+		},
+		{
+			MethodName: "RevertExpense",
+			Handler:    _GroupService_RevertExpense_Handler,
+		},
+		{
+			MethodName: "RenderSettlementReport",
+			Handler:    _GroupService_RenderSettlementReport_Handler,
+		},
+		{
+			MethodName: "GetAccountMappings",
+			Handler:    _GroupService_GetAccountMappings_Handler,
+		},
+		{
+			MethodName: "UpdateAccountMappings",
+			Handler:    _GroupService_UpdateAccountMappings_Handler,
+		},
+		{
+			MethodName: "ExportJournal",
+			Handler:    _GroupService_ExportJournal_Handler,
+		},
+		{
+			MethodName: "SetMemberBankAccount",
+			Handler:    _GroupService_SetMemberBankAccount_Handler,
+		},
+		{
+			MethodName: "DeleteMemberBankAccount",
+			Handler:    _GroupService_DeleteMemberBankAccount_Handler,
+		},
+		{
+			MethodName: "ListBankAccounts",
+			Handler:    _GroupService_ListBankAccounts_Handler,
+		},
+		{
+			MethodName: "ExportZenginTransfers",
+			Handler:    _GroupService_ExportZenginTransfers_Handler,
+		},
+		{
+			MethodName: "CreateExpenseTemplate",
+			Handler:    _GroupService_CreateExpenseTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateExpenseTemplate",
+			Handler:    _GroupService_UpdateExpenseTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteExpenseTemplate",
+			Handler:    _GroupService_DeleteExpenseTemplate_Handler,
+		},
+		{
+			MethodName: "ListExpenseTemplates",
+			Handler:    _GroupService_ListExpenseTemplates_Handler,
+		},
+		{
+			MethodName: "AddExpenseFromTemplate",
+			Handler:    _GroupService_AddExpenseFromTemplate_Handler,
+		},
+		{
+			MethodName: "GetBudgets",
+			Handler:    _GroupService_GetBudgets_Handler,
+		},
+		{
+			MethodName: "UpdateBudgets",
+			Handler:    _GroupService_UpdateBudgets_Handler,
+		},
+		{
+			MethodName: "GetBudgetStatus",
+			Handler:    _GroupService_GetBudgetStatus_Handler,
+		},
+		{
+			MethodName: "ListDuplicateExpenses",
+			Handler:    _GroupService_ListDuplicateExpenses_Handler,
+		},
+		{
+			MethodName: "MergeExpenses",
+			Handler:    _GroupService_MergeExpenses_Handler,
+		},
+		{
+			MethodName: "GetGroupSettings",
+			Handler:    _GroupService_GetGroupSettings_Handler,
+		},
+		{
+			MethodName: "UpdateGroupSettings",
+			Handler:    _GroupService_UpdateGroupSettings_Handler,
+		},
+		{
+			MethodName: "VoteOnExpense",
+			Handler:    _GroupService_VoteOnExpense_Handler,
+		},
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _GroupService_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ListClosedPeriods",
+			Handler:    _GroupService_ListClosedPeriods_Handler,
+		},
+		{
+			MethodName: "ReopenPeriod",
+			Handler:    _GroupService_ReopenPeriod_Handler,
+		},
+		{
+			MethodName: "CalculateScopedSettlements",
+			Handler:    _GroupService_CalculateScopedSettlements_Handler,
+		},
+		{
+			MethodName: "SetMemberActivePeriod",
+			Handler:    _GroupService_SetMemberActivePeriod_Handler,
+		},
+		{
+			MethodName: "MergeMembers",
+			Handler:    _GroupService_MergeMembers_Handler,
+		},
+		{
+			MethodName: "ListMemberMerges",
+			Handler:    _GroupService_ListMemberMerges_Handler,
+		},
+		{
+			MethodName: "GetHouseholds",
+			Handler:    _GroupService_GetHouseholds_Handler,
+		},
+		{
+			MethodName: "UpdateHouseholds",
+			Handler:    _GroupService_UpdateHouseholds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _GroupService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _GroupService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportGroup",
+			Handler:       _GroupService_ExportGroup_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/group/v1/group.proto",
 }
@@ -0,0 +1,102 @@
+// Command importcsv imports expenses from a CSV file into a group through the
+// group service.
+//
+//	go run ./cmd/importcsv -group <group ID> -file expenses.csv -dry-run
+//
+// Payer and participant names are matched to the group's members. Run with
+// -dry-run first to see unmatched names and invalid rows; without it all rows
+// are saved at once, or none if any row is invalid.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func main() {
+	defaultAddr := os.Getenv("GROUP_SERVICE_URL")
+	if defaultAddr == "" {
+		defaultAddr = "localhost:50051"
+	}
+
+	addr := flag.String("addr", defaultAddr, "group service address")
+	groupID := flag.String("group", "", "ID of the group to import into (required)")
+	file := flag.String("file", "", "CSV file with a header row (required)")
+	dryRun := flag.Bool("dry-run", false, "report what would be imported without saving")
+	separator := flag.String("separator", "", `separator between names in the participants column (default ";")`)
+	mapping := &groupv1.CsvColumnMapping{}
+	flag.StringVar(&mapping.Amount, "amount-column", "", `header of the amount column (default "amount")`)
+	flag.StringVar(&mapping.Description, "description-column", "", `header of the description column (default "description")`)
+	flag.StringVar(&mapping.PaidBy, "paid-by-column", "", `header of the payer column (default "paid_by")`)
+	flag.StringVar(&mapping.Participants, "participants-column", "", `header of the participants column (default "participants")`)
+	flag.StringVar(&mapping.Category, "category-column", "", `header of the optional category column (default "category")`)
+	flag.StringVar(&mapping.Date, "date-column", "", `header of the optional date column (default "date")`)
+	flag.Parse()
+
+	if *groupID == "" || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", *file, err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to group service: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	resp, err := groupv1.NewGroupServiceClient(conn).ImportExpensesCsv(ctx, &groupv1.ImportExpensesCsvRequest{
+		GroupId:              *groupID,
+		Csv:                  data,
+		Mapping:              mapping,
+		ParticipantSeparator: *separator,
+		DryRun:               *dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	if len(resp.UnmatchedNames) > 0 {
+		fmt.Println("Names not found in the group:")
+		for _, name := range resp.UnmatchedNames {
+			fmt.Printf("  %s\n", name)
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		fmt.Println("Invalid rows:")
+		for _, rowErr := range resp.Errors {
+			if rowErr.Column != "" {
+				fmt.Printf("  line %d, %s: %s\n", rowErr.Line, rowErr.Column, rowErr.Message)
+			} else {
+				fmt.Printf("  line %d: %s\n", rowErr.Line, rowErr.Message)
+			}
+		}
+	}
+
+	switch {
+	case resp.Committed:
+		fmt.Printf("Imported %d of %d rows.\n", len(resp.Expenses), resp.TotalRows)
+	case len(resp.Errors) > 0:
+		fmt.Printf("%d of %d rows are valid; nothing was imported.\n", len(resp.Expenses), resp.TotalRows)
+		os.Exit(1)
+	default:
+		fmt.Printf("Dry run: all %d rows are valid and would be imported.\n", resp.TotalRows)
+	}
+}
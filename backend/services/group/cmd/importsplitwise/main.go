@@ -0,0 +1,104 @@
+// Command importsplitwise creates a group from a Splitwise group export
+// through the group service.
+//
+//	go run ./cmd/importsplitwise -name "沖縄旅行" -file splitwise.csv -dry-run
+//
+// Each member column of the export becomes a member of the new group and each
+// row an expense or settle-up payment with the members' exact shares. The
+// balances calculated from the imported expenses are compared with the
+// export's total balance row; the group is only created when they match.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func main() {
+	defaultAddr := os.Getenv("GROUP_SERVICE_URL")
+	if defaultAddr == "" {
+		defaultAddr = "localhost:50051"
+	}
+
+	addr := flag.String("addr", defaultAddr, "group service address")
+	name := flag.String("name", "", "name of the group to create (required)")
+	description := flag.String("description", "", "description of the group")
+	file := flag.String("file", "", "Splitwise CSV export of the group (required)")
+	dryRun := flag.Bool("dry-run", false, "verify the export without creating the group")
+	flag.Parse()
+
+	if *name == "" || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", *file, err)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to group service: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	resp, err := groupv1.NewGroupServiceClient(conn).ImportSplitwise(ctx, &groupv1.ImportSplitwiseRequest{
+		GroupName:   *name,
+		Description: *description,
+		Csv:         data,
+		DryRun:      *dryRun,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	if len(resp.Errors) > 0 {
+		fmt.Println("Invalid rows:")
+		for _, rowErr := range resp.Errors {
+			if rowErr.Column != "" {
+				fmt.Printf("  line %d, %s: %s\n", rowErr.Line, rowErr.Column, rowErr.Message)
+			} else {
+				fmt.Printf("  line %d: %s\n", rowErr.Line, rowErr.Message)
+			}
+		}
+	}
+
+	if len(resp.SkippedLines) > 0 {
+		fmt.Printf("Skipped %d rows that change no balance: lines %v\n", len(resp.SkippedLines), resp.SkippedLines)
+	}
+
+	fmt.Println("Balances (imported / Splitwise):")
+	for _, balance := range resp.Balances {
+		mark := "ok"
+		if balance.ImportedBalance != balance.SplitwiseBalance {
+			mark = "MISMATCH"
+		}
+		fmt.Printf("  %-20s %10d %10d  %s\n", balance.MemberName, balance.ImportedBalance, balance.SplitwiseBalance, mark)
+	}
+
+	switch {
+	case resp.Committed:
+		fmt.Printf("Created group %s with %d expenses.\n", resp.Group.Id, len(resp.Expenses))
+	case len(resp.Errors) > 0:
+		fmt.Printf("%d of %d rows are invalid; nothing was imported.\n", len(resp.Errors), resp.TotalRows)
+		os.Exit(1)
+	case !resp.BalancesMatch:
+		fmt.Println("The imported balances do not match Splitwise's; nothing was imported.")
+		os.Exit(1)
+	default:
+		fmt.Printf("Dry run: all %d rows are valid and the balances match.\n", resp.TotalRows)
+	}
+}
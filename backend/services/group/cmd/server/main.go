@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
@@ -13,7 +17,9 @@ import (
 	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
 	"github.com/jt-chihara/warikan/services/group/internal/handler"
 	"github.com/jt-chihara/warikan/services/group/internal/repository"
+	"github.com/jt-chihara/warikan/services/group/internal/secret"
 	"github.com/jt-chihara/warikan/services/group/internal/service"
+	"github.com/jt-chihara/warikan/services/group/internal/storage"
 )
 
 func main() {
@@ -42,9 +48,37 @@ func main() {
 	// Initialize layers
 	groupRepo := repository.NewGroupRepository(db)
 	expenseRepo := repository.NewExpenseRepository(db)
-	groupService := service.NewGroupService(groupRepo, expenseRepo)
+
+	blobStore, err := newBlobStore()
+	if err != nil {
+		log.Fatalf("Failed to configure blob store: %v", err)
+	}
+
+	trashRetention, err := trashRetention()
+	if err != nil {
+		log.Fatalf("Failed to configure trash retention: %v", err)
+	}
+
+	opts := []service.Option{
+		service.WithBlobStore(blobStore),
+		service.WithTrashRetention(trashRetention),
+		service.WithBudgetAlertNotifier(logBudgetAlertNotifier{}),
+	}
+	if key := os.Getenv("BANK_ACCOUNT_KEY"); key != "" {
+		cipher, err := secret.NewCipherFromBase64(key)
+		if err != nil {
+			log.Fatalf("Failed to configure BANK_ACCOUNT_KEY: %v", err)
+		}
+		opts = append(opts, service.WithBankAccountCipher(cipher))
+	}
+
+	groupService := service.NewGroupService(groupRepo, expenseRepo, opts...)
 	groupHandler := handler.NewGroupHandler(groupService)
 
+	if trashRetention > 0 {
+		go purgeTrashPeriodically(groupService, time.Hour)
+	}
+
 	// gRPC server setup
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -66,3 +100,70 @@ func main() {
 		log.Fatalf("Failed to serve: %v", err)
 	}
 }
+
+// newBlobStore configures where receipt attachments are stored
+func newBlobStore() (storage.BlobStore, error) {
+	switch os.Getenv("BLOB_STORE") {
+	case "s3":
+		return storage.NewS3BlobStore(storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		})
+	case "", "local":
+		dir := os.Getenv("BLOB_STORE_DIR")
+		if dir == "" {
+			dir = "./data/attachments"
+		}
+		return storage.NewLocalBlobStore(dir)
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", os.Getenv("BLOB_STORE"))
+	}
+}
+
+// trashRetention reads how long deleted expenses stay in the trash from
+// TRASH_RETENTION_DAYS (default 30, 0 keeps them forever)
+func trashRetention() (time.Duration, error) {
+	value := os.Getenv("TRASH_RETENTION_DAYS")
+	if value == "" {
+		return 30 * 24 * time.Hour, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("invalid TRASH_RETENTION_DAYS %q", value)
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// purgeTrashPeriodically removes expenses whose retention has expired, once
+// at startup and then at every interval
+func purgeTrashPeriodically(groupService *service.GroupService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := groupService.PurgeDeletedExpenses(context.Background(), time.Now())
+		if err != nil {
+			log.Printf("Failed to purge deleted expenses: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged %d expenses from the trash", purged)
+		}
+
+		<-ticker.C
+	}
+}
+
+// logBudgetAlertNotifier records budget alerts in the service log
+type logBudgetAlertNotifier struct{}
+
+func (logBudgetAlertNotifier) NotifyBudgetAlert(ctx context.Context, groupID string, alert *groupv1.BudgetAlert) {
+	category := alert.Budget.Category
+	if category == "" {
+		category = "(all)"
+	}
+	log.Printf("Budget alert: group %s reached %d%% of the %s budget (%d of %d) with expense %s",
+		groupID, alert.ThresholdPercent, category, alert.Spent, alert.Budget.Amount, alert.ExpenseId)
+}
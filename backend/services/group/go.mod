@@ -11,6 +11,7 @@ require (
 	github.com/jt-chihara/warikan/backend v0.0.0-00010101000000-000000000000
 	github.com/lib/pq v1.10.9
 	github.com/stretchr/testify v1.11.0
+	golang.org/x/text v0.32.0
 	google.golang.org/grpc v1.79.3
 	google.golang.org/protobuf v1.36.10
 )
@@ -23,7 +24,6 @@ require (
 	github.com/stretchr/objx v0.5.2 // indirect
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
-	golang.org/x/text v0.32.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251202230838-ff82c1b0f217 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
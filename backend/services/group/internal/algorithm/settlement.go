@@ -117,22 +117,25 @@ func CalculateMemberBalances(expenses []Expense, members []Member) []Balance {
 
 	// Calculate balances from expenses
 	for _, expense := range expenses {
+		// Income flows the other way: the recipient owes the participants
+		amount := expense.Amount
+		if expense.Income {
+			amount = -amount
+		}
+
 		// Add amount paid by payer
 		if payer, exists := balances[expense.PayerID]; exists {
-			payer.Amount += expense.Amount
+			payer.Amount += amount
 			balances[expense.PayerID] = payer
 		}
 
 		// Subtract each member's share
-		sharePerMember := expense.Amount / int64(len(expense.SplitBetween))
-		remainder := expense.Amount % int64(len(expense.SplitBetween))
-
+		shares := expense.Shares()
 		for i, memberID := range expense.SplitBetween {
 			if member, exists := balances[memberID]; exists {
-				share := sharePerMember
-				// Distribute remainder among first few members
-				if i < int(remainder) {
-					share++
+				share := shares[i]
+				if expense.Income {
+					share = -share
 				}
 				member.Amount -= share
 				balances[memberID] = member
@@ -155,6 +158,31 @@ type Expense struct {
 	PayerID      string
 	Amount       int64
 	SplitBetween []string
+	Income       bool    // PayerID received Amount on behalf of SplitBetween
+	SplitAmounts []int64 // Exact share of each SplitBetween member; split equally when empty
+}
+
+// Shares returns the share of each SplitBetween member: SplitAmounts when
+// given, otherwise an equal split with the remainder going to the first members
+func (e Expense) Shares() []int64 {
+	if len(e.SplitAmounts) == len(e.SplitBetween) {
+		return e.SplitAmounts
+	}
+
+	shares := make([]int64, len(e.SplitBetween))
+	if len(e.SplitBetween) == 0 {
+		return shares
+	}
+	sharePerMember := e.Amount / int64(len(e.SplitBetween))
+	remainder := e.Amount % int64(len(e.SplitBetween))
+	for i := range shares {
+		shares[i] = sharePerMember
+		// Distribute remainder among first few members
+		if i < int(remainder) {
+			shares[i]++
+		}
+	}
+	return shares
 }
 
 // Member represents a group member for balance calculation
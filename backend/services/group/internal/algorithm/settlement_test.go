@@ -159,6 +159,28 @@ func TestCalculateMemberBalances(t *testing.T) {
 				{MemberID: "2", Amount: -1000, Name: "Bob"},  // Paid 0, owes 1000
 			},
 		},
+		{
+			name: "exact shares",
+			expenses: []Expense{
+				{
+					ID:           "exp1",
+					PayerID:      "1",
+					Amount:       3000,
+					SplitBetween: []string{"1", "2", "3"},
+					SplitAmounts: []int64{500, 1500, 1000},
+				},
+			},
+			members: []Member{
+				{ID: "1", Name: "Alice"},
+				{ID: "2", Name: "Bob"},
+				{ID: "3", Name: "Charlie"},
+			},
+			want: []Balance{
+				{MemberID: "1", Amount: 2500, Name: "Alice"},    // Paid 3000, owes 500
+				{MemberID: "2", Amount: -1500, Name: "Bob"},     // Owes 1500
+				{MemberID: "3", Amount: -1000, Name: "Charlie"}, // Owes 1000
+			},
+		},
 		{
 			name: "multiple expenses complex scenario",
 			expenses: []Expense{
@@ -207,6 +229,56 @@ func TestCalculateMemberBalances(t *testing.T) {
 				{MemberID: "3", Amount: -333, Name: "Carol"}, // Paid 0, owes 333
 			},
 		},
+		{
+			name: "refund received by one member",
+			expenses: []Expense{
+				{
+					ID:           "exp1",
+					PayerID:      "1",
+					Amount:       30000,
+					SplitBetween: []string{"1", "2", "3"}, // Deposit, 10000 each
+				},
+				{
+					ID:           "inc1",
+					PayerID:      "2",
+					Amount:       9000,
+					SplitBetween: []string{"1", "2", "3"}, // Deposit returned to Bob, 3000 each
+					Income:       true,
+				},
+			},
+			members: []Member{
+				{ID: "1", Name: "Alice"},
+				{ID: "2", Name: "Bob"},
+				{ID: "3", Name: "Carol"},
+			},
+			want: []Balance{
+				{MemberID: "1", Amount: 23000, Name: "Alice"}, // Paid 30000, owes 10000, is owed 3000 of the refund
+				{MemberID: "2", Amount: -16000, Name: "Bob"},  // Owes 10000, holds 6000 of the others' refund
+				{MemberID: "3", Amount: -7000, Name: "Carol"}, // Owes 10000, is owed 3000 of the refund
+			},
+		},
+		{
+			name: "odd income with remainder distribution",
+			expenses: []Expense{
+				{
+					ID:           "inc1",
+					PayerID:      "1",
+					Amount:       1001,
+					SplitBetween: []string{"2", "3"}, // 501, 500
+					Income:       true,
+				},
+			},
+			members: []Member{
+				{ID: "1", Name: "Alice"},
+				{ID: "2", Name: "Bob"},
+				{ID: "3", Name: "Carol"},
+			},
+			want: []Balance{
+				{MemberID: "1", Amount: -1001, Name: "Alice"},
+				{MemberID: "2", Amount: 501, Name: "Bob"},
+				{MemberID: "3", Amount: 500, Name: "Carol"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrBankAccountNotFound = errors.New("bank account not found")
+)
+
+// BankAccount is a member's account that settlement transfers are paid to.
+// Names are half-width katakana as written in transfer files.
+type BankAccount struct {
+	BankCode      string `json:"bank_code"`
+	BankName      string `json:"bank_name"`
+	BranchCode    string `json:"branch_code"`
+	BranchName    string `json:"branch_name"`
+	AccountType   int    `json:"account_type"`
+	AccountNumber string `json:"account_number"`
+	AccountHolder string `json:"account_holder"`
+}
+
+// SealedBankAccount is a BankAccount as stored: JSON encrypted with the
+// member ID as associated data
+type SealedBankAccount struct {
+	MemberID  string
+	Details   []byte
+	UpdatedAt time.Time
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrClosedPeriodNotFound = errors.New("closed period not found")
+	ErrExpenseLocked        = errors.New("締め済みの期間の支払いは変更できません")
+)
+
+// ClosedPeriod freezes the expenses of a group created up to ClosedUntil.
+// Its closing balances are the opening balances of the next period.
+type ClosedPeriod struct {
+	ID              string
+	GroupID         string
+	PeriodStart     *time.Time // ClosedUntil of the previous period
+	ClosedUntil     time.Time
+	OpeningBalances []PeriodBalance
+	Balances        []PeriodBalance
+	Settlements     []PeriodSettlement
+	ClosedBy        string
+	ClosedAt        time.Time
+	// Set once the period was reopened; it no longer locks anything
+	ReopenedAt *time.Time
+	ReopenedBy string
+}
+
+// PeriodBalance is the balance of a member when a period was closed
+type PeriodBalance struct {
+	MemberID   string `json:"member_id"`
+	MemberName string `json:"member_name"`
+	Balance    int64  `json:"balance"`
+}
+
+// PeriodSettlement is a transfer that settles the balances of a period
+type PeriodSettlement struct {
+	FromMemberID string `json:"from_member_id"`
+	FromName     string `json:"from_name"`
+	ToMemberID   string `json:"to_member_id"`
+	ToName       string `json:"to_name"`
+	Amount       int64  `json:"amount"`
+}
+
+// Locks reports whether an expense created at createdAt can no longer change
+func (p *ClosedPeriod) Locks(createdAt time.Time) bool {
+	return p.ReopenedAt == nil && !createdAt.After(p.ClosedUntil)
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCommentNotFound = errors.New("comment not found")
+)
+
+type Comment struct {
+	ID         uuid.UUID `json:"id"`
+	ExpenseID  uuid.UUID `json:"expense_id"`
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
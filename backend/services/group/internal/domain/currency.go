@@ -0,0 +1,16 @@
+package domain
+
+import "strings"
+
+// Currencies without a minor unit. Amounts of other currencies are kept in
+// cents.
+var zeroDecimalCurrencies = map[string]bool{"JPY": true, "KRW": true}
+
+// CurrencyDecimals returns the number of decimal places of the currency's
+// minor unit, the unit amounts are stored in
+func CurrencyDecimals(currency string) int {
+	if zeroDecimalCurrencies[strings.ToUpper(strings.TrimSpace(currency))] {
+		return 0
+	}
+	return 2
+}
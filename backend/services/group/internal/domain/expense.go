@@ -11,21 +11,45 @@ var (
 	ErrExpenseNotFound = errors.New("expense not found")
 )
 
+// ExpenseKind tells money paid out for the group from money flowing into it
+type ExpenseKind string
+
+const (
+	ExpenseKindExpense ExpenseKind = "expense"
+	// ExpenseKindIncome is money received by PaidByID on behalf of the split
+	// members, e.g. a refunded deposit the recipient has to redistribute
+	ExpenseKindIncome ExpenseKind = "income"
+	// ExpenseKindPayment is a settle-up: PaidByID paid the single split member
+	// back, and the split member's share is the amount received
+	ExpenseKindPayment ExpenseKind = "payment"
+)
+
 type Expense struct {
 	ID           uuid.UUID     `json:"id"`
 	GroupID      uuid.UUID     `json:"group_id"`
 	Amount       int64         `json:"amount"` // Amount in cents (JPY)
 	Description  string        `json:"description"`
+	Category     string        `json:"category"`
+	Kind         ExpenseKind   `json:"kind"`
 	Currency     string        `json:"currency"`
 	PaidByID     uuid.UUID     `json:"paid_by_id"`
 	PaidByName   string        `json:"paid_by_name"`
 	SplitMembers []SplitMember `json:"split_members"`
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
+	// Whether the split members agreed to the expense
+	ApprovalStatus ApprovalStatus `json:"approval_status"`
+	// Set while the expense is in the trash
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	DeletedByID   *uuid.UUID `json:"deleted_by_id,omitempty"`
+	DeletedByName string     `json:"deleted_by_name,omitempty"`
 }
 
 type SplitMember struct {
 	MemberID   uuid.UUID `json:"member_id"`
 	MemberName string    `json:"member_name"`
 	Amount     int64     `json:"amount"` // Amount owed by this member in cents (JPY)
+	// The member's review of a pending expense; empty until they vote
+	Vote    ApprovalVote `json:"vote,omitempty"`
+	VotedAt *time.Time   `json:"voted_at,omitempty"`
 }
@@ -0,0 +1,49 @@
+package domain
+
+import "errors"
+
+var ErrExpenseAlreadyApproved = errors.New("承認済みの支払いです")
+
+// ApprovalStatus tells whether an expense counts towards balances yet
+type ApprovalStatus string
+
+const (
+	// ApprovalStatusApproved expenses count towards balances: every split
+	// member approved them, or the group does not ask for approval
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	// ApprovalStatusPending expenses wait for split members to approve them
+	ApprovalStatusPending ApprovalStatus = "pending"
+	// ApprovalStatusDisputed expenses were disputed by a split member
+	ApprovalStatusDisputed ApprovalStatus = "disputed"
+)
+
+// ApprovalVote is a split member's review of a pending expense
+type ApprovalVote string
+
+const (
+	ApprovalVoteApprove ApprovalVote = "approve"
+	ApprovalVoteDispute ApprovalVote = "dispute"
+)
+
+// IsApproved reports whether the expense counts towards balances and
+// settlements
+func (e *Expense) IsApproved() bool {
+	return e.ApprovalStatus != ApprovalStatusPending && e.ApprovalStatus != ApprovalStatusDisputed
+}
+
+// ApprovalStatusFromVotes works out the status of an expense under review
+// from the votes of its split members: disputed as soon as one disputes,
+// approved once all approve
+func ApprovalStatusFromVotes(splits []SplitMember) ApprovalStatus {
+	status := ApprovalStatusApproved
+	for _, split := range splits {
+		switch split.Vote {
+		case ApprovalVoteDispute:
+			return ApprovalStatusDisputed
+		case ApprovalVoteApprove:
+		default:
+			status = ApprovalStatusPending
+		}
+	}
+	return status
+}
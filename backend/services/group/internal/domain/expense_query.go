@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExpenseSortField selects the column an expense listing is ordered by
+type ExpenseSortField int
+
+const (
+	SortByCreatedAt ExpenseSortField = iota
+	SortByAmount
+	SortByDescription
+)
+
+// ExpenseQuery filters, orders and pages the expenses of a group.
+// Zero values mean "no restriction".
+type ExpenseQuery struct {
+	GroupID       uuid.UUID
+	IDs           []uuid.UUID // Only these expenses
+	CreatedFrom   *time.Time  // Inclusive
+	CreatedTo     *time.Time  // Exclusive
+	PayerID       *uuid.UUID
+	ParticipantID *uuid.UUID
+	MinAmount     int64
+	MaxAmount     int64
+	Category      string
+	Text          string
+	SortBy        ExpenseSortField
+	Ascending     bool
+	Limit         int            // 0 returns every match
+	After         *ExpenseCursor // Resume after this position in the sort order
+}
+
+// ExpenseCursor is a position in an expense listing. Only the value of the
+// active sort field is compared; ID breaks ties.
+type ExpenseCursor struct {
+	ID          uuid.UUID
+	CreatedAt   time.Time
+	Amount      int64
+	Description string
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRevisionNotFound = errors.New("revision not found")
+)
+
+// ExpenseRevision is a snapshot of an expense as saved by a create, update
+// or revert. Revisions of an expense are numbered from 1.
+type ExpenseRevision struct {
+	ExpenseID     uuid.UUID     `json:"expense_id"`
+	Revision      int           `json:"revision"`
+	Amount        int64         `json:"amount"` // Amount in cents (JPY)
+	Description   string        `json:"description"`
+	Category      string        `json:"category"`
+	Kind          ExpenseKind   `json:"kind"`
+	PaidByID      uuid.UUID     `json:"paid_by_id"`
+	PaidByName    string        `json:"paid_by_name"`
+	SplitMembers  []SplitMember `json:"split_members"`
+	ChangedByID   *uuid.UUID    `json:"changed_by_id,omitempty"`
+	ChangedByName string        `json:"changed_by_name,omitempty"`
+	RevertedFrom  int           `json:"reverted_from,omitempty"` // Revision restored by a revert
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// ExpenseChange tells who saved an expense, recorded with the new revision
+type ExpenseChange struct {
+	ChangedByID  *uuid.UUID
+	RevertedFrom int // 0 for a regular edit
+}
@@ -0,0 +1,8 @@
+package domain
+
+// ExpenseSearchHit is an expense matching a search query
+type ExpenseSearchHit struct {
+	Expense  *Expense
+	Comments []*Comment // Comments of the expense whose body matched
+	Score    float64    // 0..1, 1 = the query appears verbatim
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrExpenseTemplateNotFound = errors.New("expense template not found")
+)
+
+// SplitMode tells how the amount of an expense is divided among its split
+// members
+type SplitMode string
+
+const (
+	SplitModeEqual SplitMode = "equal"
+	// SplitModeShares divides the amount in proportion to each member's shares,
+	// e.g. 2:1:1 when one member consumes twice as much
+	SplitModeShares SplitMode = "shares"
+)
+
+// ExpenseTemplate prefills an expense that is entered repeatedly, such as the
+// weekly groceries
+type ExpenseTemplate struct {
+	ID          uuid.UUID        `json:"id"`
+	GroupID     uuid.UUID        `json:"group_id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Amount      int64            `json:"amount"` // Default amount in cents (JPY); 0 when entered each time
+	Category    string           `json:"category"`
+	Kind        ExpenseKind      `json:"kind"`
+	PaidByID    uuid.UUID        `json:"paid_by_id"`
+	PaidByName  string           `json:"paid_by_name"`
+	SplitMode   SplitMode        `json:"split_mode"`
+	Members     []TemplateMember `json:"members"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// TemplateMember is a split member of a template
+type TemplateMember struct {
+	MemberID   uuid.UUID `json:"member_id"`
+	MemberName string    `json:"member_name"`
+	Shares     int32     `json:"shares"` // Weight under SplitModeShares, 1 otherwise
+}
@@ -0,0 +1,10 @@
+package domain
+
+// Household is a set of members settling up as one unit, such as a couple
+// or a family. A member belongs to one household at most.
+type Household struct {
+	ID        string
+	GroupID   string
+	Name      string
+	MemberIDs []string // In the order the members joined the group
+}
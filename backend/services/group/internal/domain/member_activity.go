@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// MemberActivePeriod is the span in which a member takes part in a group's
+// expenses, such as the days someone joined a trip. Open ends are nil.
+type MemberActivePeriod struct {
+	MemberID    string
+	ActiveFrom  *time.Time
+	ActiveUntil *time.Time
+}
+
+// Covers reports whether the member takes part at t. Both ends are inclusive.
+func (p *MemberActivePeriod) Covers(t time.Time) bool {
+	if p.ActiveFrom != nil && t.Before(*p.ActiveFrom) {
+		return false
+	}
+	if p.ActiveUntil != nil && t.After(*p.ActiveUntil) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrMemberMergeLocked = errors.New("締め済みの期間の支払いがあるメンバーは統合できません")
+
+// MemberMerge records a member added twice being folded into the other one
+type MemberMerge struct {
+	ID               string
+	GroupID          string
+	KeptMemberID     string
+	KeptMemberName   string
+	MergedMemberID   string
+	MergedMemberName string
+	ExpenseCount     int
+	MergedBy         string
+	MergedAt         time.Time
+}
+
+// CombinedSplit is the split of an expense both merged members share in.
+// Splits holds every member left with their exact share, the kept member's
+// share being the sum of both. ApprovalStatus is the status the expense has
+// without the merged member's vote.
+type CombinedSplit struct {
+	ExpenseID      uuid.UUID
+	Splits         []SplitMember
+	ApprovalStatus ApprovalStatus
+}
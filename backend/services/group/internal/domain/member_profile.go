@@ -0,0 +1,11 @@
+package domain
+
+// MemberProfile is what a member can edit about themselves. Empty email,
+// color and payment handle are unset.
+type MemberProfile struct {
+	MemberID      string
+	Name          string
+	Email         string
+	Color         string // #RRGGBB
+	PaymentHandle string // e.g. a PayPay ID, shown when settling up
+}
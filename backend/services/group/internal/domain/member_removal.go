@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrMemberBalanceChanged = errors.New("メンバーの残高が変わったため削除できませんでした。もう一度お試しください")
+
+// ExpenseVersion is the state of a saved expense a calculation was based on
+type ExpenseVersion struct {
+	ExpenseID      uuid.UUID
+	UpdatedAt      time.Time
+	ApprovalStatus ApprovalStatus
+}
+
+// MemberRemoval archives a member after handing their balance over to others.
+// The balance was calculated from Expenses, the member's expenses at the
+// time; the removal is refused when any of them changed since.
+type MemberRemoval struct {
+	GroupID   string
+	MemberID  string
+	Expenses  []ExpenseVersion
+	Transfers []*Expense
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// attachmentChunkSize is the size of each chunk streamed back on download
+const attachmentChunkSize = 64 * 1024
+
+func (h *GroupHandler) UploadAttachment(stream groupv1.GroupService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	metadata := first.GetMetadata()
+	if metadata == nil {
+		return errors.New("最初のメッセージには添付ファイルの情報が必要です")
+	}
+
+	resp, err := h.service.UploadAttachment(stream.Context(), metadata, &uploadReader{stream: stream})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func (h *GroupHandler) ListExpenseAttachments(ctx context.Context, req *groupv1.ListExpenseAttachmentsRequest) (*groupv1.ListExpenseAttachmentsResponse, error) {
+	return h.service.ListExpenseAttachments(ctx, req)
+}
+
+func (h *GroupHandler) DownloadAttachment(req *groupv1.DownloadAttachmentRequest, stream groupv1.GroupService_DownloadAttachmentServer) error {
+	attachment, content, err := h.service.OpenAttachment(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if err := stream.Send(&groupv1.DownloadAttachmentResponse{
+		Data: &groupv1.DownloadAttachmentResponse_Attachment{Attachment: attachment},
+	}); err != nil {
+		return err
+	}
+
+	return sendChunks(content, func(chunk []byte) error {
+		return stream.Send(&groupv1.DownloadAttachmentResponse{
+			Data: &groupv1.DownloadAttachmentResponse_Chunk{Chunk: chunk},
+		})
+	})
+}
+
+func (h *GroupHandler) DeleteAttachment(ctx context.Context, req *groupv1.DeleteAttachmentRequest) (*groupv1.DeleteAttachmentResponse, error) {
+	return h.service.DeleteAttachment(ctx, req)
+}
+
+// sendChunks reads content to the end and sends it in chunks of at most
+// attachmentChunkSize bytes
+func sendChunks(content io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			if sendErr := send(append([]byte(nil), buf[:n]...)); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// uploadReader exposes the chunks of an upload stream as an io.Reader
+type uploadReader struct {
+	stream groupv1.GroupService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetMetadata() != nil {
+			return 0, errors.New("添付ファイルの情報は最初のメッセージでのみ送信できます")
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
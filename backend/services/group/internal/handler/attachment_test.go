@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+type fakeUploadStream struct {
+	grpc.ServerStream
+	requests []*groupv1.UploadAttachmentRequest
+	response *groupv1.UploadAttachmentResponse
+}
+
+func (s *fakeUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeUploadStream) Recv() (*groupv1.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeUploadStream) SendAndClose(resp *groupv1.UploadAttachmentResponse) error {
+	s.response = resp
+	return nil
+}
+
+type fakeDownloadStream struct {
+	grpc.ServerStream
+	responses []*groupv1.DownloadAttachmentResponse
+}
+
+func (s *fakeDownloadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeDownloadStream) Send(resp *groupv1.DownloadAttachmentResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestGroupHandler_UploadAttachment(t *testing.T) {
+	metadata := &groupv1.AttachmentMetadata{ExpenseId: "expense-1", FileName: "receipt.png", ContentType: "image/png"}
+	stream := &fakeUploadStream{
+		requests: []*groupv1.UploadAttachmentRequest{
+			{Data: &groupv1.UploadAttachmentRequest_Metadata{Metadata: metadata}},
+			{Data: &groupv1.UploadAttachmentRequest_Chunk{Chunk: []byte("hello ")}},
+			{Data: &groupv1.UploadAttachmentRequest_Chunk{Chunk: []byte("world")}},
+		},
+	}
+	expected := &groupv1.UploadAttachmentResponse{Attachment: &groupv1.Attachment{Id: "attachment-1"}}
+
+	mockService := new(MockGroupService)
+	mockService.On("UploadAttachment", mock.Anything, metadata, mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, err := io.ReadAll(args.Get(2).(io.Reader))
+			require.NoError(t, err)
+			assert.Equal(t, "hello world", string(body))
+		}).
+		Return(expected, nil)
+
+	handler := NewGroupHandler(mockService)
+	err := handler.UploadAttachment(stream)
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, stream.response)
+	mockService.AssertExpectations(t)
+}
+
+func TestGroupHandler_UploadAttachment_MissingMetadata(t *testing.T) {
+	stream := &fakeUploadStream{
+		requests: []*groupv1.UploadAttachmentRequest{
+			{Data: &groupv1.UploadAttachmentRequest_Chunk{Chunk: []byte("data")}},
+		},
+	}
+
+	mockService := new(MockGroupService)
+	handler := NewGroupHandler(mockService)
+	err := handler.UploadAttachment(stream)
+
+	assert.Error(t, err)
+	assert.Nil(t, stream.response)
+	mockService.AssertNotCalled(t, "UploadAttachment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGroupHandler_DownloadAttachment(t *testing.T) {
+	req := &groupv1.DownloadAttachmentRequest{AttachmentId: "attachment-1"}
+	attachment := &groupv1.Attachment{Id: "attachment-1", FileName: "receipt.png"}
+	content := strings.Repeat("a", attachmentChunkSize+10)
+
+	mockService := new(MockGroupService)
+	mockService.On("OpenAttachment", mock.Anything, req).Return(attachment, io.NopCloser(strings.NewReader(content)), nil)
+
+	stream := &fakeDownloadStream{}
+	handler := NewGroupHandler(mockService)
+	err := handler.DownloadAttachment(req, stream)
+
+	require.NoError(t, err)
+	require.Len(t, stream.responses, 3)
+	assert.Equal(t, attachment, stream.responses[0].GetAttachment())
+
+	var body strings.Builder
+	for _, resp := range stream.responses[1:] {
+		body.Write(resp.GetChunk())
+	}
+	assert.Equal(t, content, body.String())
+	mockService.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -85,6 +86,390 @@ func (m *MockGroupServiceInterface) UpdateExpense(ctx context.Context, req *grou
 	return args.Get(0).(*groupv1.UpdateExpenseResponse), args.Error(1)
 }
 
+func (m *MockGroupServiceInterface) UploadAttachment(ctx context.Context, metadata *groupv1.AttachmentMetadata, content io.Reader) (*groupv1.UploadAttachmentResponse, error) {
+	args := m.Called(ctx, metadata, content)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UploadAttachmentResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListExpenseAttachments(ctx context.Context, req *groupv1.ListExpenseAttachmentsRequest) (*groupv1.ListExpenseAttachmentsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListExpenseAttachmentsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) OpenAttachment(ctx context.Context, req *groupv1.DownloadAttachmentRequest) (*groupv1.Attachment, io.ReadCloser, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*groupv1.Attachment), args.Get(1).(io.ReadCloser), args.Error(2)
+}
+
+func (m *MockGroupServiceInterface) DeleteAttachment(ctx context.Context, req *groupv1.DeleteAttachmentRequest) (*groupv1.DeleteAttachmentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.DeleteAttachmentResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) AddExpenseComment(ctx context.Context, req *groupv1.AddExpenseCommentRequest) (*groupv1.AddExpenseCommentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.AddExpenseCommentResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateExpenseComment(ctx context.Context, req *groupv1.UpdateExpenseCommentRequest) (*groupv1.UpdateExpenseCommentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateExpenseCommentResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) DeleteExpenseComment(ctx context.Context, req *groupv1.DeleteExpenseCommentRequest) (*groupv1.DeleteExpenseCommentResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.DeleteExpenseCommentResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListExpenseComments(ctx context.Context, req *groupv1.ListExpenseCommentsRequest) (*groupv1.ListExpenseCommentsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListExpenseCommentsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) SearchExpenses(ctx context.Context, req *groupv1.SearchExpensesRequest) (*groupv1.SearchExpensesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.SearchExpensesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListDeletedExpenses(ctx context.Context, req *groupv1.ListDeletedExpensesRequest) (*groupv1.ListDeletedExpensesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListDeletedExpensesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) RestoreExpense(ctx context.Context, req *groupv1.RestoreExpenseRequest) (*groupv1.RestoreExpenseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.RestoreExpenseResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetExpenseHistory(ctx context.Context, req *groupv1.GetExpenseHistoryRequest) (*groupv1.GetExpenseHistoryResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetExpenseHistoryResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) RevertExpense(ctx context.Context, req *groupv1.RevertExpenseRequest) (*groupv1.RevertExpenseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.RevertExpenseResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) AddExpenses(ctx context.Context, req *groupv1.AddExpensesRequest) (*groupv1.AddExpensesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.AddExpensesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ImportExpensesCsv(ctx context.Context, req *groupv1.ImportExpensesCsvRequest) (*groupv1.ImportExpensesCsvResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ImportExpensesCsvResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ImportSplitwise(ctx context.Context, req *groupv1.ImportSplitwiseRequest) (*groupv1.ImportSplitwiseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ImportSplitwiseResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) OpenGroupExport(ctx context.Context, req *groupv1.ExportGroupRequest) (*groupv1.ExportMetadata, io.ReadCloser, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*groupv1.ExportMetadata), args.Get(1).(io.ReadCloser), args.Error(2)
+}
+
+func (m *MockGroupServiceInterface) RenderSettlementReport(ctx context.Context, req *groupv1.RenderSettlementReportRequest) (*groupv1.RenderSettlementReportResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.RenderSettlementReportResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetAccountMappings(ctx context.Context, req *groupv1.GetAccountMappingsRequest) (*groupv1.GetAccountMappingsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetAccountMappingsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateAccountMappings(ctx context.Context, req *groupv1.UpdateAccountMappingsRequest) (*groupv1.UpdateAccountMappingsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateAccountMappingsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ExportJournal(ctx context.Context, req *groupv1.ExportJournalRequest) (*groupv1.ExportJournalResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ExportJournalResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) SetMemberBankAccount(ctx context.Context, req *groupv1.SetMemberBankAccountRequest) (*groupv1.SetMemberBankAccountResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.SetMemberBankAccountResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) DeleteMemberBankAccount(ctx context.Context, req *groupv1.DeleteMemberBankAccountRequest) (*groupv1.DeleteMemberBankAccountResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.DeleteMemberBankAccountResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListBankAccounts(ctx context.Context, req *groupv1.ListBankAccountsRequest) (*groupv1.ListBankAccountsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListBankAccountsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ExportZenginTransfers(ctx context.Context, req *groupv1.ExportZenginTransfersRequest) (*groupv1.ExportZenginTransfersResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ExportZenginTransfersResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) CreateExpenseTemplate(ctx context.Context, req *groupv1.CreateExpenseTemplateRequest) (*groupv1.CreateExpenseTemplateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.CreateExpenseTemplateResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateExpenseTemplate(ctx context.Context, req *groupv1.UpdateExpenseTemplateRequest) (*groupv1.UpdateExpenseTemplateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateExpenseTemplateResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) DeleteExpenseTemplate(ctx context.Context, req *groupv1.DeleteExpenseTemplateRequest) (*groupv1.DeleteExpenseTemplateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.DeleteExpenseTemplateResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListExpenseTemplates(ctx context.Context, req *groupv1.ListExpenseTemplatesRequest) (*groupv1.ListExpenseTemplatesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListExpenseTemplatesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) AddExpenseFromTemplate(ctx context.Context, req *groupv1.AddExpenseFromTemplateRequest) (*groupv1.AddExpenseFromTemplateResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.AddExpenseFromTemplateResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetBudgets(ctx context.Context, req *groupv1.GetBudgetsRequest) (*groupv1.GetBudgetsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetBudgetsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateBudgets(ctx context.Context, req *groupv1.UpdateBudgetsRequest) (*groupv1.UpdateBudgetsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateBudgetsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetBudgetStatus(ctx context.Context, req *groupv1.GetBudgetStatusRequest) (*groupv1.GetBudgetStatusResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetBudgetStatusResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListDuplicateExpenses(ctx context.Context, req *groupv1.ListDuplicateExpensesRequest) (*groupv1.ListDuplicateExpensesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListDuplicateExpensesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) MergeExpenses(ctx context.Context, req *groupv1.MergeExpensesRequest) (*groupv1.MergeExpensesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.MergeExpensesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetGroupSettings(ctx context.Context, req *groupv1.GetGroupSettingsRequest) (*groupv1.GetGroupSettingsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetGroupSettingsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateGroupSettings(ctx context.Context, req *groupv1.UpdateGroupSettingsRequest) (*groupv1.UpdateGroupSettingsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateGroupSettingsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) VoteOnExpense(ctx context.Context, req *groupv1.VoteOnExpenseRequest) (*groupv1.VoteOnExpenseResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.VoteOnExpenseResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ClosePeriod(ctx context.Context, req *groupv1.ClosePeriodRequest) (*groupv1.ClosePeriodResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ClosePeriodResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListClosedPeriods(ctx context.Context, req *groupv1.ListClosedPeriodsRequest) (*groupv1.ListClosedPeriodsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListClosedPeriodsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ReopenPeriod(ctx context.Context, req *groupv1.ReopenPeriodRequest) (*groupv1.ReopenPeriodResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ReopenPeriodResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) CalculateScopedSettlements(ctx context.Context, req *groupv1.CalculateScopedSettlementsRequest) (*groupv1.CalculateScopedSettlementsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.CalculateScopedSettlementsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) SetMemberActivePeriod(ctx context.Context, req *groupv1.SetMemberActivePeriodRequest) (*groupv1.SetMemberActivePeriodResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.SetMemberActivePeriodResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) MergeMembers(ctx context.Context, req *groupv1.MergeMembersRequest) (*groupv1.MergeMembersResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.MergeMembersResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) ListMemberMerges(ctx context.Context, req *groupv1.ListMemberMergesRequest) (*groupv1.ListMemberMergesResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.ListMemberMergesResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateMember(ctx context.Context, req *groupv1.UpdateMemberRequest) (*groupv1.UpdateMemberResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateMemberResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) GetHouseholds(ctx context.Context, req *groupv1.GetHouseholdsRequest) (*groupv1.GetHouseholdsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GetHouseholdsResponse), args.Error(1)
+}
+
+func (m *MockGroupServiceInterface) UpdateHouseholds(ctx context.Context, req *groupv1.UpdateHouseholdsRequest) (*groupv1.UpdateHouseholdsResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.UpdateHouseholdsResponse), args.Error(1)
+}
+
 func TestGroupHandler_AddExpense(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"errors"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GroupHandler) ExportGroup(req *groupv1.ExportGroupRequest, stream groupv1.GroupService_ExportGroupServer) error {
+	metadata, content, err := h.service.OpenGroupExport(stream.Context(), req)
+	if err != nil {
+		return exportError(err)
+	}
+	defer content.Close()
+
+	if err := stream.Send(&groupv1.ExportGroupResponse{
+		Data: &groupv1.ExportGroupResponse_Metadata{Metadata: metadata},
+	}); err != nil {
+		return err
+	}
+
+	return sendChunks(content, func(chunk []byte) error {
+		return stream.Send(&groupv1.ExportGroupResponse{
+			Data: &groupv1.ExportGroupResponse_Chunk{Chunk: chunk},
+		})
+	})
+}
+
+// exportError gives the gateway a status code to tell a bad request and a
+// missing group apart from a failure
+func exportError(err error) error {
+	var validationErr validator.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrGroupNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return err
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+type fakeExportStream struct {
+	grpc.ServerStream
+	responses []*groupv1.ExportGroupResponse
+}
+
+func (s *fakeExportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeExportStream) Send(resp *groupv1.ExportGroupResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestGroupHandler_ExportGroup(t *testing.T) {
+	req := &groupv1.ExportGroupRequest{GroupId: "group-1", Format: groupv1.ExportFormat_EXPORT_FORMAT_CSV}
+	metadata := &groupv1.ExportMetadata{FileName: "旅行_20240501.csv", ContentType: "text/csv; charset=utf-8"}
+	content := strings.Repeat("a", attachmentChunkSize+10)
+
+	mockService := new(MockGroupService)
+	mockService.On("OpenGroupExport", mock.Anything, req).Return(metadata, io.NopCloser(strings.NewReader(content)), nil)
+
+	stream := &fakeExportStream{}
+	handler := NewGroupHandler(mockService)
+	err := handler.ExportGroup(req, stream)
+
+	require.NoError(t, err)
+	require.Len(t, stream.responses, 3)
+	assert.Equal(t, metadata, stream.responses[0].GetMetadata())
+
+	var body strings.Builder
+	for _, resp := range stream.responses[1:] {
+		body.Write(resp.GetChunk())
+	}
+	assert.Equal(t, content, body.String())
+	mockService.AssertExpectations(t)
+}
+
+func TestGroupHandler_ExportGroup_Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{"invalid request", validator.ValidationError{Field: "groupId", Message: "グループIDが無効です"}, codes.InvalidArgument},
+		{"group not found", domain.ErrGroupNotFound, codes.NotFound},
+		{"failure", errors.New("connection refused"), codes.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &groupv1.ExportGroupRequest{GroupId: "group-1"}
+
+			mockService := new(MockGroupService)
+			mockService.On("OpenGroupExport", mock.Anything, req).Return(nil, nil, tt.err)
+
+			stream := &fakeExportStream{}
+			handler := NewGroupHandler(mockService)
+			err := handler.ExportGroup(req, stream)
+
+			assert.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Empty(t, stream.responses)
+		})
+	}
+}
@@ -60,3 +60,175 @@ func (h *GroupHandler) UpdateExpense(ctx context.Context, req *groupv1.UpdateExp
 func (h *GroupHandler) DeleteExpense(ctx context.Context, req *groupv1.DeleteExpenseRequest) (*groupv1.DeleteExpenseResponse, error) {
 	return h.service.DeleteExpense(ctx, req)
 }
+
+func (h *GroupHandler) AddExpenseComment(ctx context.Context, req *groupv1.AddExpenseCommentRequest) (*groupv1.AddExpenseCommentResponse, error) {
+	return h.service.AddExpenseComment(ctx, req)
+}
+
+func (h *GroupHandler) UpdateExpenseComment(ctx context.Context, req *groupv1.UpdateExpenseCommentRequest) (*groupv1.UpdateExpenseCommentResponse, error) {
+	return h.service.UpdateExpenseComment(ctx, req)
+}
+
+func (h *GroupHandler) DeleteExpenseComment(ctx context.Context, req *groupv1.DeleteExpenseCommentRequest) (*groupv1.DeleteExpenseCommentResponse, error) {
+	return h.service.DeleteExpenseComment(ctx, req)
+}
+
+func (h *GroupHandler) ListExpenseComments(ctx context.Context, req *groupv1.ListExpenseCommentsRequest) (*groupv1.ListExpenseCommentsResponse, error) {
+	return h.service.ListExpenseComments(ctx, req)
+}
+
+func (h *GroupHandler) SearchExpenses(ctx context.Context, req *groupv1.SearchExpensesRequest) (*groupv1.SearchExpensesResponse, error) {
+	return h.service.SearchExpenses(ctx, req)
+}
+
+func (h *GroupHandler) ListDeletedExpenses(ctx context.Context, req *groupv1.ListDeletedExpensesRequest) (*groupv1.ListDeletedExpensesResponse, error) {
+	return h.service.ListDeletedExpenses(ctx, req)
+}
+
+func (h *GroupHandler) RestoreExpense(ctx context.Context, req *groupv1.RestoreExpenseRequest) (*groupv1.RestoreExpenseResponse, error) {
+	return h.service.RestoreExpense(ctx, req)
+}
+
+func (h *GroupHandler) GetExpenseHistory(ctx context.Context, req *groupv1.GetExpenseHistoryRequest) (*groupv1.GetExpenseHistoryResponse, error) {
+	return h.service.GetExpenseHistory(ctx, req)
+}
+
+func (h *GroupHandler) RevertExpense(ctx context.Context, req *groupv1.RevertExpenseRequest) (*groupv1.RevertExpenseResponse, error) {
+	return h.service.RevertExpense(ctx, req)
+}
+
+func (h *GroupHandler) AddExpenses(ctx context.Context, req *groupv1.AddExpensesRequest) (*groupv1.AddExpensesResponse, error) {
+	return h.service.AddExpenses(ctx, req)
+}
+
+func (h *GroupHandler) ImportExpensesCsv(ctx context.Context, req *groupv1.ImportExpensesCsvRequest) (*groupv1.ImportExpensesCsvResponse, error) {
+	return h.service.ImportExpensesCsv(ctx, req)
+}
+
+func (h *GroupHandler) ImportSplitwise(ctx context.Context, req *groupv1.ImportSplitwiseRequest) (*groupv1.ImportSplitwiseResponse, error) {
+	return h.service.ImportSplitwise(ctx, req)
+}
+
+func (h *GroupHandler) RenderSettlementReport(ctx context.Context, req *groupv1.RenderSettlementReportRequest) (*groupv1.RenderSettlementReportResponse, error) {
+	return h.service.RenderSettlementReport(ctx, req)
+}
+
+func (h *GroupHandler) GetAccountMappings(ctx context.Context, req *groupv1.GetAccountMappingsRequest) (*groupv1.GetAccountMappingsResponse, error) {
+	return h.service.GetAccountMappings(ctx, req)
+}
+
+func (h *GroupHandler) UpdateAccountMappings(ctx context.Context, req *groupv1.UpdateAccountMappingsRequest) (*groupv1.UpdateAccountMappingsResponse, error) {
+	return h.service.UpdateAccountMappings(ctx, req)
+}
+
+func (h *GroupHandler) ExportJournal(ctx context.Context, req *groupv1.ExportJournalRequest) (*groupv1.ExportJournalResponse, error) {
+	return h.service.ExportJournal(ctx, req)
+}
+
+func (h *GroupHandler) SetMemberBankAccount(ctx context.Context, req *groupv1.SetMemberBankAccountRequest) (*groupv1.SetMemberBankAccountResponse, error) {
+	return h.service.SetMemberBankAccount(ctx, req)
+}
+
+func (h *GroupHandler) DeleteMemberBankAccount(ctx context.Context, req *groupv1.DeleteMemberBankAccountRequest) (*groupv1.DeleteMemberBankAccountResponse, error) {
+	return h.service.DeleteMemberBankAccount(ctx, req)
+}
+
+func (h *GroupHandler) ListBankAccounts(ctx context.Context, req *groupv1.ListBankAccountsRequest) (*groupv1.ListBankAccountsResponse, error) {
+	return h.service.ListBankAccounts(ctx, req)
+}
+
+func (h *GroupHandler) ExportZenginTransfers(ctx context.Context, req *groupv1.ExportZenginTransfersRequest) (*groupv1.ExportZenginTransfersResponse, error) {
+	return h.service.ExportZenginTransfers(ctx, req)
+}
+
+func (h *GroupHandler) CreateExpenseTemplate(ctx context.Context, req *groupv1.CreateExpenseTemplateRequest) (*groupv1.CreateExpenseTemplateResponse, error) {
+	return h.service.CreateExpenseTemplate(ctx, req)
+}
+
+func (h *GroupHandler) UpdateExpenseTemplate(ctx context.Context, req *groupv1.UpdateExpenseTemplateRequest) (*groupv1.UpdateExpenseTemplateResponse, error) {
+	return h.service.UpdateExpenseTemplate(ctx, req)
+}
+
+func (h *GroupHandler) DeleteExpenseTemplate(ctx context.Context, req *groupv1.DeleteExpenseTemplateRequest) (*groupv1.DeleteExpenseTemplateResponse, error) {
+	return h.service.DeleteExpenseTemplate(ctx, req)
+}
+
+func (h *GroupHandler) ListExpenseTemplates(ctx context.Context, req *groupv1.ListExpenseTemplatesRequest) (*groupv1.ListExpenseTemplatesResponse, error) {
+	return h.service.ListExpenseTemplates(ctx, req)
+}
+
+func (h *GroupHandler) AddExpenseFromTemplate(ctx context.Context, req *groupv1.AddExpenseFromTemplateRequest) (*groupv1.AddExpenseFromTemplateResponse, error) {
+	return h.service.AddExpenseFromTemplate(ctx, req)
+}
+
+func (h *GroupHandler) GetBudgets(ctx context.Context, req *groupv1.GetBudgetsRequest) (*groupv1.GetBudgetsResponse, error) {
+	return h.service.GetBudgets(ctx, req)
+}
+
+func (h *GroupHandler) UpdateBudgets(ctx context.Context, req *groupv1.UpdateBudgetsRequest) (*groupv1.UpdateBudgetsResponse, error) {
+	return h.service.UpdateBudgets(ctx, req)
+}
+
+func (h *GroupHandler) GetBudgetStatus(ctx context.Context, req *groupv1.GetBudgetStatusRequest) (*groupv1.GetBudgetStatusResponse, error) {
+	return h.service.GetBudgetStatus(ctx, req)
+}
+
+func (h *GroupHandler) ListDuplicateExpenses(ctx context.Context, req *groupv1.ListDuplicateExpensesRequest) (*groupv1.ListDuplicateExpensesResponse, error) {
+	return h.service.ListDuplicateExpenses(ctx, req)
+}
+
+func (h *GroupHandler) MergeExpenses(ctx context.Context, req *groupv1.MergeExpensesRequest) (*groupv1.MergeExpensesResponse, error) {
+	return h.service.MergeExpenses(ctx, req)
+}
+
+func (h *GroupHandler) GetGroupSettings(ctx context.Context, req *groupv1.GetGroupSettingsRequest) (*groupv1.GetGroupSettingsResponse, error) {
+	return h.service.GetGroupSettings(ctx, req)
+}
+
+func (h *GroupHandler) UpdateGroupSettings(ctx context.Context, req *groupv1.UpdateGroupSettingsRequest) (*groupv1.UpdateGroupSettingsResponse, error) {
+	return h.service.UpdateGroupSettings(ctx, req)
+}
+
+func (h *GroupHandler) VoteOnExpense(ctx context.Context, req *groupv1.VoteOnExpenseRequest) (*groupv1.VoteOnExpenseResponse, error) {
+	return h.service.VoteOnExpense(ctx, req)
+}
+
+func (h *GroupHandler) ClosePeriod(ctx context.Context, req *groupv1.ClosePeriodRequest) (*groupv1.ClosePeriodResponse, error) {
+	return h.service.ClosePeriod(ctx, req)
+}
+
+func (h *GroupHandler) ListClosedPeriods(ctx context.Context, req *groupv1.ListClosedPeriodsRequest) (*groupv1.ListClosedPeriodsResponse, error) {
+	return h.service.ListClosedPeriods(ctx, req)
+}
+
+func (h *GroupHandler) ReopenPeriod(ctx context.Context, req *groupv1.ReopenPeriodRequest) (*groupv1.ReopenPeriodResponse, error) {
+	return h.service.ReopenPeriod(ctx, req)
+}
+
+func (h *GroupHandler) CalculateScopedSettlements(ctx context.Context, req *groupv1.CalculateScopedSettlementsRequest) (*groupv1.CalculateScopedSettlementsResponse, error) {
+	return h.service.CalculateScopedSettlements(ctx, req)
+}
+
+func (h *GroupHandler) SetMemberActivePeriod(ctx context.Context, req *groupv1.SetMemberActivePeriodRequest) (*groupv1.SetMemberActivePeriodResponse, error) {
+	return h.service.SetMemberActivePeriod(ctx, req)
+}
+
+func (h *GroupHandler) MergeMembers(ctx context.Context, req *groupv1.MergeMembersRequest) (*groupv1.MergeMembersResponse, error) {
+	return h.service.MergeMembers(ctx, req)
+}
+
+func (h *GroupHandler) ListMemberMerges(ctx context.Context, req *groupv1.ListMemberMergesRequest) (*groupv1.ListMemberMergesResponse, error) {
+	return h.service.ListMemberMerges(ctx, req)
+}
+
+func (h *GroupHandler) UpdateMember(ctx context.Context, req *groupv1.UpdateMemberRequest) (*groupv1.UpdateMemberResponse, error) {
+	return h.service.UpdateMember(ctx, req)
+}
+
+func (h *GroupHandler) GetHouseholds(ctx context.Context, req *groupv1.GetHouseholdsRequest) (*groupv1.GetHouseholdsResponse, error) {
+	return h.service.GetHouseholds(ctx, req)
+}
+
+func (h *GroupHandler) UpdateHouseholds(ctx context.Context, req *groupv1.UpdateHouseholdsRequest) (*groupv1.UpdateHouseholdsResponse, error) {
+	return h.service.UpdateHouseholds(ctx, req)
+}
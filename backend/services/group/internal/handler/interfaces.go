@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"io"
+
 	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
 )
 
@@ -18,4 +20,52 @@ type GroupServiceInterface interface {
 	GetGroupExpenses(ctx context.Context, req *groupv1.GetGroupExpensesRequest) (*groupv1.GetGroupExpensesResponse, error)
 	UpdateExpense(ctx context.Context, req *groupv1.UpdateExpenseRequest) (*groupv1.UpdateExpenseResponse, error)
 	DeleteExpense(ctx context.Context, req *groupv1.DeleteExpenseRequest) (*groupv1.DeleteExpenseResponse, error)
+	UploadAttachment(ctx context.Context, metadata *groupv1.AttachmentMetadata, content io.Reader) (*groupv1.UploadAttachmentResponse, error)
+	ListExpenseAttachments(ctx context.Context, req *groupv1.ListExpenseAttachmentsRequest) (*groupv1.ListExpenseAttachmentsResponse, error)
+	OpenAttachment(ctx context.Context, req *groupv1.DownloadAttachmentRequest) (*groupv1.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, req *groupv1.DeleteAttachmentRequest) (*groupv1.DeleteAttachmentResponse, error)
+	AddExpenseComment(ctx context.Context, req *groupv1.AddExpenseCommentRequest) (*groupv1.AddExpenseCommentResponse, error)
+	UpdateExpenseComment(ctx context.Context, req *groupv1.UpdateExpenseCommentRequest) (*groupv1.UpdateExpenseCommentResponse, error)
+	DeleteExpenseComment(ctx context.Context, req *groupv1.DeleteExpenseCommentRequest) (*groupv1.DeleteExpenseCommentResponse, error)
+	ListExpenseComments(ctx context.Context, req *groupv1.ListExpenseCommentsRequest) (*groupv1.ListExpenseCommentsResponse, error)
+	SearchExpenses(ctx context.Context, req *groupv1.SearchExpensesRequest) (*groupv1.SearchExpensesResponse, error)
+	ListDeletedExpenses(ctx context.Context, req *groupv1.ListDeletedExpensesRequest) (*groupv1.ListDeletedExpensesResponse, error)
+	RestoreExpense(ctx context.Context, req *groupv1.RestoreExpenseRequest) (*groupv1.RestoreExpenseResponse, error)
+	GetExpenseHistory(ctx context.Context, req *groupv1.GetExpenseHistoryRequest) (*groupv1.GetExpenseHistoryResponse, error)
+	RevertExpense(ctx context.Context, req *groupv1.RevertExpenseRequest) (*groupv1.RevertExpenseResponse, error)
+	AddExpenses(ctx context.Context, req *groupv1.AddExpensesRequest) (*groupv1.AddExpensesResponse, error)
+	ImportExpensesCsv(ctx context.Context, req *groupv1.ImportExpensesCsvRequest) (*groupv1.ImportExpensesCsvResponse, error)
+	ImportSplitwise(ctx context.Context, req *groupv1.ImportSplitwiseRequest) (*groupv1.ImportSplitwiseResponse, error)
+	OpenGroupExport(ctx context.Context, req *groupv1.ExportGroupRequest) (*groupv1.ExportMetadata, io.ReadCloser, error)
+	RenderSettlementReport(ctx context.Context, req *groupv1.RenderSettlementReportRequest) (*groupv1.RenderSettlementReportResponse, error)
+	GetAccountMappings(ctx context.Context, req *groupv1.GetAccountMappingsRequest) (*groupv1.GetAccountMappingsResponse, error)
+	UpdateAccountMappings(ctx context.Context, req *groupv1.UpdateAccountMappingsRequest) (*groupv1.UpdateAccountMappingsResponse, error)
+	ExportJournal(ctx context.Context, req *groupv1.ExportJournalRequest) (*groupv1.ExportJournalResponse, error)
+	SetMemberBankAccount(ctx context.Context, req *groupv1.SetMemberBankAccountRequest) (*groupv1.SetMemberBankAccountResponse, error)
+	DeleteMemberBankAccount(ctx context.Context, req *groupv1.DeleteMemberBankAccountRequest) (*groupv1.DeleteMemberBankAccountResponse, error)
+	ListBankAccounts(ctx context.Context, req *groupv1.ListBankAccountsRequest) (*groupv1.ListBankAccountsResponse, error)
+	ExportZenginTransfers(ctx context.Context, req *groupv1.ExportZenginTransfersRequest) (*groupv1.ExportZenginTransfersResponse, error)
+	CreateExpenseTemplate(ctx context.Context, req *groupv1.CreateExpenseTemplateRequest) (*groupv1.CreateExpenseTemplateResponse, error)
+	UpdateExpenseTemplate(ctx context.Context, req *groupv1.UpdateExpenseTemplateRequest) (*groupv1.UpdateExpenseTemplateResponse, error)
+	DeleteExpenseTemplate(ctx context.Context, req *groupv1.DeleteExpenseTemplateRequest) (*groupv1.DeleteExpenseTemplateResponse, error)
+	ListExpenseTemplates(ctx context.Context, req *groupv1.ListExpenseTemplatesRequest) (*groupv1.ListExpenseTemplatesResponse, error)
+	AddExpenseFromTemplate(ctx context.Context, req *groupv1.AddExpenseFromTemplateRequest) (*groupv1.AddExpenseFromTemplateResponse, error)
+	GetBudgets(ctx context.Context, req *groupv1.GetBudgetsRequest) (*groupv1.GetBudgetsResponse, error)
+	UpdateBudgets(ctx context.Context, req *groupv1.UpdateBudgetsRequest) (*groupv1.UpdateBudgetsResponse, error)
+	GetBudgetStatus(ctx context.Context, req *groupv1.GetBudgetStatusRequest) (*groupv1.GetBudgetStatusResponse, error)
+	ListDuplicateExpenses(ctx context.Context, req *groupv1.ListDuplicateExpensesRequest) (*groupv1.ListDuplicateExpensesResponse, error)
+	MergeExpenses(ctx context.Context, req *groupv1.MergeExpensesRequest) (*groupv1.MergeExpensesResponse, error)
+	GetGroupSettings(ctx context.Context, req *groupv1.GetGroupSettingsRequest) (*groupv1.GetGroupSettingsResponse, error)
+	UpdateGroupSettings(ctx context.Context, req *groupv1.UpdateGroupSettingsRequest) (*groupv1.UpdateGroupSettingsResponse, error)
+	VoteOnExpense(ctx context.Context, req *groupv1.VoteOnExpenseRequest) (*groupv1.VoteOnExpenseResponse, error)
+	ClosePeriod(ctx context.Context, req *groupv1.ClosePeriodRequest) (*groupv1.ClosePeriodResponse, error)
+	ListClosedPeriods(ctx context.Context, req *groupv1.ListClosedPeriodsRequest) (*groupv1.ListClosedPeriodsResponse, error)
+	ReopenPeriod(ctx context.Context, req *groupv1.ReopenPeriodRequest) (*groupv1.ReopenPeriodResponse, error)
+	CalculateScopedSettlements(ctx context.Context, req *groupv1.CalculateScopedSettlementsRequest) (*groupv1.CalculateScopedSettlementsResponse, error)
+	SetMemberActivePeriod(ctx context.Context, req *groupv1.SetMemberActivePeriodRequest) (*groupv1.SetMemberActivePeriodResponse, error)
+	MergeMembers(ctx context.Context, req *groupv1.MergeMembersRequest) (*groupv1.MergeMembersResponse, error)
+	ListMemberMerges(ctx context.Context, req *groupv1.ListMemberMergesRequest) (*groupv1.ListMemberMergesResponse, error)
+	UpdateMember(ctx context.Context, req *groupv1.UpdateMemberRequest) (*groupv1.UpdateMemberResponse, error)
+	GetHouseholds(ctx context.Context, req *groupv1.GetHouseholdsRequest) (*groupv1.GetHouseholdsResponse, error)
+	UpdateHouseholds(ctx context.Context, req *groupv1.UpdateHouseholdsRequest) (*groupv1.UpdateHouseholdsResponse, error)
 }
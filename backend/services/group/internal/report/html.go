@@ -0,0 +1,76 @@
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"amount": FormatAmount,
+	"signed": formatSigned,
+}).Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>{{.GroupName}} 精算レポート</title>
+<style>
+@page { size: A4; margin: 16mm; }
+body { font-family: "Hiragino Kaku Gothic ProN", "Hiragino Sans", "Noto Sans JP", "Yu Gothic", Meiryo, sans-serif; color: #1f2937; font-size: 13px; max-width: 760px; margin: 0 auto; padding: 24px; }
+h1 { font-size: 24px; margin: 0 0 4px; }
+h2 { font-size: 16px; margin: 28px 0 8px; border-left: 4px solid #2563eb; padding-left: 8px; }
+.description { color: #4b5563; margin: 0 0 8px; }
+.meta { color: #6b7280; font-size: 12px; margin: 0; }
+.total { margin-top: 20px; padding: 12px 16px; background: #eff6ff; border-radius: 8px; display: flex; justify-content: space-between; align-items: baseline; }
+.total strong { font-size: 22px; }
+table { width: 100%; border-collapse: collapse; }
+th, td { padding: 6px 8px; border-bottom: 1px solid #e5e7eb; text-align: left; }
+th { background: #f3f4f6; font-weight: 600; }
+.amount { text-align: right; font-variant-numeric: tabular-nums; white-space: nowrap; }
+.negative { color: #b91c1c; }
+.positive { color: #047857; }
+.none { color: #6b7280; }
+@media print { body { padding: 0; } .total { background: none; border: 1px solid #d1d5db; } }
+</style>
+</head>
+<body>
+<h1>{{.GroupName}} 精算レポート</h1>
+{{with .Description}}<p class="description">{{.}}</p>{{end}}
+<p class="meta">期間: {{.Period}} ／ 支払い: {{.ExpenseCount}}件 ／ メンバー: {{len .Members}}人 ／ 作成日: {{.GeneratedAt.Format "2006/01/02"}}</p>
+
+<div class="total"><span>総支出</span><strong>{{amount .TotalSpent .Currency}}</strong></div>
+
+<h2>メンバー別</h2>
+<table>
+<thead><tr><th>メンバー</th><th class="amount">支払った額</th><th class="amount">負担額</th><th class="amount">精算済み</th><th class="amount">差引</th></tr></thead>
+<tbody>
+{{- range .Members}}
+<tr><td>{{.Name}}</td><td class="amount">{{amount .Paid $.Currency}}</td><td class="amount">{{amount .Consumed $.Currency}}</td><td class="amount">{{amount .Settled $.Currency}}</td><td class="amount{{if lt .Net 0}} negative{{else if gt .Net 0}} positive{{end}}">{{signed .Net $.Currency}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+
+<h2>精算方法</h2>
+{{- if .Transfers}}
+<table>
+<thead><tr><th>支払う人</th><th>受け取る人</th><th class="amount">金額</th></tr></thead>
+<tbody>
+{{- range .Transfers}}
+<tr><td>{{.From}}</td><td>{{.To}}</td><td class="amount">{{amount .Amount $.Currency}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- else}}
+<p class="none">精算の必要はありません</p>
+{{- end}}
+</body>
+</html>
+`))
+
+// RenderHTML writes the report as a standalone HTML page that prints on one
+// A4 page for typical groups
+func RenderHTML(w io.Writer, r *Report) error {
+	return htmlTemplate.Execute(w, struct {
+		*Report
+		Period string
+	}{r, r.formatPeriod()})
+}
@@ -0,0 +1,263 @@
+package report
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 portrait in points
+const (
+	pageWidth    = 595.28
+	pageHeight   = 841.89
+	marginX      = 50.0
+	marginTop    = 56.0
+	marginBottom = 56.0
+)
+
+// The PDF uses HeiseiKakuGo-W5, one of the Japanese fonts PDF viewers provide
+// themselves, so no font file is embedded. With the UniJIS-UCS2-HW-H encoding
+// text is written as UTF-16 and ASCII maps to half-width glyphs.
+const (
+	pdfFontName     = "HeiseiKakuGo-W5"
+	pdfFontEncoding = "UniJIS-UCS2-HW-H"
+)
+
+// Column right edges of the member table
+var memberColumns = []float64{300, 380, 460, pageWidth - marginX}
+
+// Left edge of the recipient column of the transfer table
+const transferToColumn = 220.0
+
+// RenderPDF writes the report as a PDF document, on one A4 page unless the
+// group has many members
+func RenderPDF(w io.Writer, r *Report) error {
+	doc := &pdfDocument{title: r.GroupName + " 精算レポート"}
+	doc.newPage()
+
+	doc.text(marginX, doc.advance(20), 20, r.GroupName+" 精算レポート")
+	if r.Description != "" {
+		doc.advance(8)
+		doc.textColor(marginX, doc.advance(11), 11, r.Description, grayText)
+	}
+	doc.advance(8)
+	meta := fmt.Sprintf("期間: %s ／ 支払い: %d件 ／ メンバー: %d人 ／ 作成日: %s",
+		r.formatPeriod(), r.ExpenseCount, len(r.Members), r.GeneratedAt.Format(dateLayout))
+	doc.textColor(marginX, doc.advance(9), 9, meta, grayText)
+
+	// Total
+	doc.advance(18)
+	y := doc.advance(36)
+	doc.fillRect(marginX, y, pageWidth-2*marginX, 36, highlight)
+	doc.text(marginX+12, y+13, 11, "総支出")
+	doc.textRight(pageWidth-marginX-12, y+11, 18, FormatAmount(r.TotalSpent, r.Currency), defaultText)
+
+	// Members
+	doc.heading("メンバー別")
+	doc.tableHeader("メンバー", "支払った額", "負担額", "精算済み", "差引")
+	for _, member := range r.Members {
+		y := doc.row()
+		doc.text(marginX+6, y, 10, member.Name)
+		doc.textRight(memberColumns[0]-6, y, 10, FormatAmount(member.Paid, r.Currency), defaultText)
+		doc.textRight(memberColumns[1]-6, y, 10, FormatAmount(member.Consumed, r.Currency), defaultText)
+		doc.textRight(memberColumns[2]-6, y, 10, FormatAmount(member.Settled, r.Currency), defaultText)
+		color := defaultText
+		if member.Net < 0 {
+			color = negativeText
+		} else if member.Net > 0 {
+			color = positiveText
+		}
+		doc.textRight(memberColumns[3]-6, y, 10, formatSigned(member.Net, r.Currency), color)
+	}
+
+	// Transfers
+	doc.heading("精算方法")
+	if len(r.Transfers) == 0 {
+		doc.textColor(marginX, doc.advance(10), 10, "精算の必要はありません", grayText)
+	} else {
+		y := doc.tableHeader("支払う人", "", "", "", "金額")
+		doc.text(transferToColumn, y, 10, "受け取る人")
+		for _, transfer := range r.Transfers {
+			y := doc.row()
+			doc.text(marginX+6, y, 10, transfer.From)
+			doc.text(transferToColumn, y, 10, transfer.To)
+			doc.textRight(memberColumns[3]-6, y, 10, FormatAmount(transfer.Amount, r.Currency), defaultText)
+		}
+	}
+
+	return doc.writeTo(w)
+}
+
+type rgb [3]float64
+
+var (
+	defaultText  = rgb{0.12, 0.16, 0.22}
+	grayText     = rgb{0.42, 0.45, 0.5}
+	negativeText = rgb{0.73, 0.11, 0.11}
+	positiveText = rgb{0.02, 0.47, 0.34}
+	accent       = rgb{0.15, 0.39, 0.92}
+	highlight    = rgb{0.94, 0.96, 1}
+	headerFill   = rgb{0.95, 0.96, 0.96}
+	ruleColor    = rgb{0.9, 0.91, 0.92}
+)
+
+// pdfDocument lays out pages top to bottom and writes the PDF file
+type pdfDocument struct {
+	title string
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64 // Top of the free space on the current page
+}
+
+func (d *pdfDocument) newPage() {
+	d.page = &bytes.Buffer{}
+	d.pages = append(d.pages, d.page)
+	d.y = pageHeight - marginTop
+}
+
+// advance reserves height below the last content, starting a new page when
+// it does not fit, and returns the baseline of the reserved space
+func (d *pdfDocument) advance(height float64) float64 {
+	if d.y-height < marginBottom {
+		d.newPage()
+	}
+	d.y -= height
+	return d.y
+}
+
+func (d *pdfDocument) heading(title string) {
+	d.advance(24)
+	y := d.advance(14)
+	d.fillRect(marginX, y-2, 3, 16, accent)
+	d.text(marginX+9, y, 13, title)
+	d.advance(6)
+}
+
+// tableHeader draws a shaded header row and returns its text baseline. The
+// first label is left-aligned and the others right-aligned to memberColumns.
+func (d *pdfDocument) tableHeader(first string, rest ...string) float64 {
+	y := d.advance(20)
+	d.fillRect(marginX, y, pageWidth-2*marginX, 20, headerFill)
+	d.text(marginX+6, y+6, 10, first)
+	for i, label := range rest {
+		if label == "" {
+			continue
+		}
+		d.textRight(memberColumns[i]-6, y+6, 10, label, defaultText)
+	}
+	return y + 6
+}
+
+// row reserves a table row with a rule below it and returns its text baseline
+func (d *pdfDocument) row() float64 {
+	y := d.advance(20)
+	d.fillRect(marginX, y, pageWidth-2*marginX, 0.6, ruleColor)
+	return y + 6
+}
+
+func (d *pdfDocument) text(x, y, size float64, s string) {
+	d.textColor(x, y, size, s, defaultText)
+}
+
+func (d *pdfDocument) textRight(right, y, size float64, s string, color rgb) {
+	d.textColor(right-textWidth(s, size), y, size, s, color)
+}
+
+func (d *pdfDocument) textColor(x, y, size float64, s string, color rgb) {
+	fmt.Fprintf(d.page, "%.3f %.3f %.3f rg BT /F1 %.1f Tf %.2f %.2f Td <%s> Tj ET\n",
+		color[0], color[1], color[2], size, x, y, utf16Hex(s))
+}
+
+func (d *pdfDocument) fillRect(x, y, width, height float64, color rgb) {
+	fmt.Fprintf(d.page, "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		color[0], color[1], color[2], x, y, width, height)
+}
+
+// textWidth estimates the width of s: half-width for Latin-1, which includes
+// the yen sign, and half-width katakana, full-width for everything else
+func textWidth(s string, size float64) float64 {
+	var units float64
+	for _, r := range s {
+		if r < 0x100 || (r >= 0xFF61 && r <= 0xFF9F) {
+			units += 500
+		} else {
+			units += 1000
+		}
+	}
+	return units * size / 1000
+}
+
+// utf16Hex encodes s for a hex string in the UCS-2 encoded font. Characters
+// outside the Basic Multilingual Plane have no glyph and become "?".
+func utf16Hex(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+func (d *pdfDocument) writeTo(w io.Writer) error {
+	var out bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// Objects 1-6: catalog, page tree, info and font; pages follow in pairs
+	// of page and content stream
+	const firstPage = 7
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPage+2*i)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	title := utf16.Encode([]rune(d.title))
+	titleHex := make([]string, len(title))
+	for i, unit := range title {
+		titleHex[i] = fmt.Sprintf("%04X", unit)
+	}
+	object(fmt.Sprintf("<< /Title <FEFF%s> /Producer (warikan) >>", strings.Join(titleHex, "")))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s-%s /Encoding /%s /DescendantFonts [5 0 R] >>",
+		pdfFontName, pdfFontEncoding, pdfFontEncoding))
+	object(fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /%s"+
+		" /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >>"+
+		" /FontDescriptor 6 0 R /DW 1000 /W [231 389 500 631 631 500] >>", pdfFontName))
+	object(fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [-92 -250 1010 922]"+
+		" /ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>", pdfFontName))
+
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, firstPage+2*i+1))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(page.Bytes()); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 3 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
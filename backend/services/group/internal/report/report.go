@@ -0,0 +1,108 @@
+// Package report renders the settlement report of a group as HTML or PDF.
+package report
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+const dateLayout = "2006/01/02"
+
+// Report is what the settlement report shows
+type Report struct {
+	GroupName    string
+	Description  string
+	Currency     string
+	ExpenseCount int
+	// Dates of the first and last expense, zero when there are none
+	From time.Time
+	To   time.Time
+	// Money spent for the group less income such as refunds
+	TotalSpent  int64
+	Members     []MemberSummary
+	Transfers   []Transfer
+	GeneratedAt time.Time
+}
+
+// MemberSummary is one member's row of the report
+type MemberSummary struct {
+	Name     string
+	Paid     int64 // Paid for the group, less income the member received
+	Consumed int64 // The member's shares, less their part of income
+	Settled  int64 // Settle-up payments made, less those received
+	Net      int64 // Positive when the member is owed money
+}
+
+// Transfer is one payment that settles the group
+type Transfer struct {
+	From   string
+	To     string
+	Amount int64
+}
+
+var currencySymbols = map[string]string{
+	"JPY": "¥",
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"CNY": "CN¥",
+	"KRW": "₩",
+}
+
+// FormatAmount formats an amount stored in the currency's minor unit, with
+// the currency symbol and thousands separators: ¥12,345 or $1,234.50
+func FormatAmount(amount int64, currency string) string {
+	var b strings.Builder
+	if amount < 0 {
+		b.WriteString("-")
+		amount = -amount
+	}
+
+	if symbol, ok := currencySymbols[strings.ToUpper(currency)]; ok {
+		b.WriteString(symbol)
+	} else {
+		b.WriteString(strings.ToUpper(currency) + " ")
+	}
+
+	decimals := domain.CurrencyDecimals(currency)
+	digits := strconv.FormatInt(amount, 10)
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-decimals], digits[len(digits)-decimals:]
+
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(",")
+		}
+		b.WriteRune(digit)
+	}
+	if fraction != "" {
+		b.WriteString("." + fraction)
+	}
+
+	return b.String()
+}
+
+// formatSigned is FormatAmount with a plus sign for positive amounts
+func formatSigned(amount int64, currency string) string {
+	if amount > 0 {
+		return "+" + FormatAmount(amount, currency)
+	}
+	return FormatAmount(amount, currency)
+}
+
+// formatPeriod formats the period the expenses cover
+func (r *Report) formatPeriod() string {
+	if r.ExpenseCount == 0 {
+		return "-"
+	}
+	from, to := r.From.Format(dateLayout), r.To.Format(dateLayout)
+	if from == to {
+		return from
+	}
+	return from + " 〜 " + to
+}
@@ -0,0 +1,156 @@
+package report
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleReport() *Report {
+	return &Report{
+		GroupName:    "沖縄旅行",
+		Description:  "2024年GW <3泊>",
+		Currency:     "JPY",
+		ExpenseCount: 3,
+		From:         time.Date(2024, 5, 1, 10, 0, 0, 0, time.Local),
+		To:           time.Date(2024, 5, 4, 20, 0, 0, 0, time.Local),
+		TotalSpent:   123456,
+		Members: []MemberSummary{
+			{Name: "Alice", Paid: 100000, Consumed: 41152, Net: 58848},
+			{Name: "ボブ", Paid: 23456, Consumed: 41152, Settled: 10000, Net: -7696},
+			{Name: "Carol", Consumed: 41152, Settled: -10000, Net: -51152},
+		},
+		Transfers: []Transfer{
+			{From: "Carol", To: "Alice", Amount: 51152},
+			{From: "ボブ", To: "Alice", Amount: 7696},
+		},
+		GeneratedAt: time.Date(2024, 5, 5, 9, 0, 0, 0, time.Local),
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{0, "JPY", "¥0"},
+		{999, "JPY", "¥999"},
+		{1000, "JPY", "¥1,000"},
+		{1234567, "JPY", "¥1,234,567"},
+		{-51152, "JPY", "-¥51,152"},
+		{123450, "USD", "$1,234.50"},
+		{5, "EUR", "€0.05"},
+		{-99, "GBP", "-£0.99"},
+		{1000, "KRW", "₩1,000"},
+		{1000, "CHF", "CHF 10.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d %s", tt.amount, tt.currency), func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatAmount(tt.amount, tt.currency))
+		})
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, RenderHTML(&out, sampleReport()))
+	html := out.String()
+
+	assert.Contains(t, html, `<html lang="ja">`)
+	assert.Contains(t, html, "<title>沖縄旅行 精算レポート</title>")
+	assert.Contains(t, html, "2024年GW &lt;3泊&gt;")
+	assert.Contains(t, html, "期間: 2024/05/01 〜 2024/05/04")
+	assert.Contains(t, html, "<strong>¥123,456</strong>")
+	assert.Contains(t, html, `<td class="amount positive">&#43;¥58,848</td>`)
+	assert.Contains(t, html, `<td class="amount negative">-¥7,696</td>`)
+	assert.Contains(t, html, "<tr><td>Carol</td><td>Alice</td><td class=\"amount\">¥51,152</td></tr>")
+	assert.NotContains(t, html, "精算の必要はありません")
+}
+
+func TestRenderHTML_NoTransfers(t *testing.T) {
+	report := &Report{GroupName: "空のグループ", Currency: "JPY", Members: []MemberSummary{{Name: "Alice"}}}
+
+	var out bytes.Buffer
+	require.NoError(t, RenderHTML(&out, report))
+
+	assert.Contains(t, out.String(), "期間: -")
+	assert.Contains(t, out.String(), "精算の必要はありません")
+}
+
+// pdfText returns the decompressed content streams of a PDF written by
+// RenderPDF and checks that its cross-reference table points at each object
+func pdfText(t *testing.T, pdf []byte) []string {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(pdf, []byte("%%EOF\n")))
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+	require.NotNil(t, startxref)
+	xrefOffset, _ := strconv.Atoi(string(startxref[1]))
+	require.True(t, bytes.HasPrefix(pdf[xrefOffset:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf[xrefOffset:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		assert.True(t, bytes.HasPrefix(pdf[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+
+	var pages []string
+	for _, match := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(pdf, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(match[1]))
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		pages = append(pages, string(content))
+	}
+	return pages
+}
+
+func TestRenderPDF(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, RenderPDF(&out, sampleReport()))
+
+	pdf := out.Bytes()
+	assert.Contains(t, string(pdf), "/Encoding /UniJIS-UCS2-HW-H")
+	assert.Contains(t, string(pdf), "/Title <FEFF"+utf16Hex("沖縄旅行 精算レポート")+">")
+
+	pages := pdfText(t, pdf)
+	require.Len(t, pages, 1)
+	assert.Contains(t, pages[0], "<"+utf16Hex("沖縄旅行 精算レポート")+"> Tj")
+	assert.Contains(t, pages[0], "<"+utf16Hex("¥123,456")+"> Tj")
+	assert.Contains(t, pages[0], "<"+utf16Hex("-¥51,152")+"> Tj")
+	assert.Contains(t, pages[0], "<"+utf16Hex("受け取る人")+"> Tj")
+}
+
+func TestRenderPDF_ManyMembers(t *testing.T) {
+	report := sampleReport()
+	report.Members = nil
+	for i := 0; i < 50; i++ {
+		report.Members = append(report.Members, MemberSummary{Name: fmt.Sprintf("メンバー%d", i+1)})
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, RenderPDF(&out, report))
+
+	pages := pdfText(t, out.Bytes())
+	require.Len(t, pages, 2)
+	assert.Contains(t, strings.Join(pages, ""), "<"+utf16Hex("メンバー50")+"> Tj")
+	assert.Contains(t, string(out.Bytes()), "/Count 2")
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.Equal(t, 30.0, textWidth("¥1,000", 10))
+	assert.Equal(t, 25.0, textWidth("ｱｲｳｴｵ", 10))
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func (r *GroupRepository) GetAccountMappings(groupID string) ([]*groupv1.AccountMapping, error) {
+	rows, err := r.db.Query(`
+		SELECT category, account
+		FROM account_mappings WHERE group_id = $1
+		ORDER BY category ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mappings []*groupv1.AccountMapping
+	for rows.Next() {
+		var mapping groupv1.AccountMapping
+		if err := rows.Scan(&mapping.Category, &mapping.Account); err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, &mapping)
+	}
+
+	return mappings, rows.Err()
+}
+
+func (r *GroupRepository) ReplaceAccountMappings(groupID string, mappings []*groupv1.AccountMapping) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`DELETE FROM account_mappings WHERE group_id = $1`, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, mapping := range mappings {
+		_, err = tx.Exec(`
+			INSERT INTO account_mappings (group_id, category, account)
+			VALUES ($1, $2, $3)
+		`, groupID, mapping.Category, mapping.Account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
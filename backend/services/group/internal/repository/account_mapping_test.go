@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestGroupRepository_GetAccountMappings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+
+	rows := sqlmock.NewRows([]string{"category", "account"}).
+		AddRow("交通費", "旅費交通費").
+		AddRow("食費", "会議費")
+	mock.ExpectQuery(`SELECT category, account FROM account_mappings WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	mappings, err := repo.GetAccountMappings(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, mappings, 2)
+	assert.Equal(t, "交通費", mappings[0].Category)
+	assert.Equal(t, "旅費交通費", mappings[0].Account)
+	assert.Equal(t, "会議費", mappings[1].Account)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_ReplaceAccountMappings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM account_mappings WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(`INSERT INTO account_mappings`).
+		WithArgs(groupID, "食費", "会議費").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.ReplaceAccountMappings(groupID, []*groupv1.AccountMapping{{Category: "食費", Account: "会議費"}})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
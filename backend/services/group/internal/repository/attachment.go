@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func (r *expenseRepository) CreateAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	query := `
+		INSERT INTO expense_attachments (id, expense_id, file_name, content_type, size_bytes, storage_key, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		attachment.ID,
+		attachment.ExpenseID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.StorageKey,
+		attachment.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *expenseRepository) FindAttachmentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Attachment, error) {
+	query := `
+		SELECT id, expense_id, file_name, content_type, size_bytes, storage_key, created_at
+		FROM expense_attachments
+		WHERE expense_id = $1
+		ORDER BY created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		var attachment domain.Attachment
+		err := rows.Scan(
+			&attachment.ID,
+			&attachment.ExpenseID,
+			&attachment.FileName,
+			&attachment.ContentType,
+			&attachment.SizeBytes,
+			&attachment.StorageKey,
+			&attachment.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, &attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+func (r *expenseRepository) FindAttachmentByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	query := `
+		SELECT id, expense_id, file_name, content_type, size_bytes, storage_key, created_at
+		FROM expense_attachments
+		WHERE id = $1`
+
+	var attachment domain.Attachment
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&attachment.ID,
+		&attachment.ExpenseID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to query attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+func (r *expenseRepository) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM expense_attachments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrAttachmentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_CreateAttachment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	now := time.Now()
+	attachment := &domain.Attachment{
+		ID:          uuid.New(),
+		ExpenseID:   uuid.New(),
+		FileName:    "receipt.jpg",
+		ContentType: "image/jpeg",
+		SizeBytes:   2048,
+		StorageKey:  "expenses/x/y",
+		CreatedAt:   now,
+	}
+
+	mock.ExpectExec(`INSERT INTO expense_attachments \(id, expense_id, file_name, content_type, size_bytes, storage_key, created_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)`).
+		WithArgs(attachment.ID, attachment.ExpenseID, "receipt.jpg", "image/jpeg", int64(2048), "expenses/x/y", now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateAttachment(context.Background(), attachment)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindAttachmentsByExpenseID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	expenseID := uuid.New()
+	attachmentID := uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "expense_id", "file_name", "content_type", "size_bytes", "storage_key", "created_at"}).
+		AddRow(attachmentID, expenseID, "receipt.pdf", "application/pdf", int64(4096), "expenses/a/b", now)
+	mock.ExpectQuery(`SELECT id, expense_id, file_name, content_type, size_bytes, storage_key, created_at FROM expense_attachments WHERE expense_id = \$1`).
+		WithArgs(expenseID).
+		WillReturnRows(rows)
+
+	attachments, err := repo.FindAttachmentsByExpenseID(context.Background(), expenseID)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, attachmentID, attachments[0].ID)
+	assert.Equal(t, "receipt.pdf", attachments[0].FileName)
+	assert.Equal(t, int64(4096), attachments[0].SizeBytes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindAttachmentByID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(`SELECT id, expense_id, file_name, content_type, size_bytes, storage_key, created_at FROM expense_attachments WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnError(sql.ErrNoRows)
+
+	attachment, err := repo.FindAttachmentByID(context.Background(), id)
+
+	assert.ErrorIs(t, err, domain.ErrAttachmentNotFound)
+	assert.Nil(t, attachment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_DeleteAttachment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM expense_attachments WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM expense_attachments WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, repo.DeleteAttachment(context.Background(), id))
+	assert.ErrorIs(t, repo.DeleteAttachment(context.Background(), id), domain.ErrAttachmentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
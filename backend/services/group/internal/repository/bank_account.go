@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func (r *GroupRepository) SaveBankAccount(groupID string, account *domain.SealedBankAccount) error {
+	// Only members of the group can have their account saved through it
+	result, err := r.db.Exec(`
+		INSERT INTO member_bank_accounts (member_id, details, updated_at)
+		SELECT id, $2, $3 FROM members WHERE id = $1 AND group_id = $4 AND archived_at IS NULL
+		ON CONFLICT (member_id) DO UPDATE SET details = EXCLUDED.details, updated_at = EXCLUDED.updated_at
+	`, account.MemberID, account.Details, account.UpdatedAt, groupID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("member not found")
+	}
+
+	return nil
+}
+
+func (r *GroupRepository) GetBankAccounts(groupID string) ([]*domain.SealedBankAccount, error) {
+	rows, err := r.db.Query(`
+		SELECT b.member_id, b.details, b.updated_at
+		FROM member_bank_accounts b
+		JOIN members m ON b.member_id = m.id
+		WHERE m.group_id = $1
+		ORDER BY m.joined_at ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []*domain.SealedBankAccount
+	for rows.Next() {
+		var account domain.SealedBankAccount
+		if err := rows.Scan(&account.MemberID, &account.Details, &account.UpdatedAt); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, &account)
+	}
+
+	return accounts, rows.Err()
+}
+
+func (r *GroupRepository) DeleteBankAccount(groupID, memberID string) error {
+	result, err := r.db.Exec(`
+		DELETE FROM member_bank_accounts
+		WHERE member_id = $1 AND member_id IN (SELECT id FROM members WHERE group_id = $2)
+	`, memberID, groupID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrBankAccountNotFound
+	}
+
+	return nil
+}
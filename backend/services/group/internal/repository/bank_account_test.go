@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupRepository_SaveBankAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	account := &domain.SealedBankAccount{MemberID: uuid.New().String(), Details: []byte("sealed"), UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`INSERT INTO member_bank_accounts`).
+		WithArgs(account.MemberID, account.Details, account.UpdatedAt, groupID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, repo.SaveBankAccount(groupID, account))
+
+	// A member of another group is not found
+	mock.ExpectExec(`INSERT INTO member_bank_accounts`).
+		WithArgs(account.MemberID, account.Details, account.UpdatedAt, groupID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.EqualError(t, repo.SaveBankAccount(groupID, account), "member not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_GetBankAccounts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	memberID := uuid.New().String()
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"member_id", "details", "updated_at"}).
+		AddRow(memberID, []byte("sealed"), now)
+	mock.ExpectQuery(`SELECT b.member_id, b.details, b.updated_at FROM member_bank_accounts b`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	accounts, err := repo.GetBankAccounts(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, memberID, accounts[0].MemberID)
+	assert.Equal(t, []byte("sealed"), accounts[0].Details)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_DeleteBankAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	memberID := uuid.New().String()
+
+	mock.ExpectExec(`DELETE FROM member_bank_accounts`).
+		WithArgs(memberID, groupID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.ErrorIs(t, repo.DeleteBankAccount(groupID, memberID), domain.ErrBankAccountNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
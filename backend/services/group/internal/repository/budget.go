@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"fmt"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// Budget periods as stored in the period column
+var budgetPeriodNames = map[groupv1.BudgetPeriod]string{
+	groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL:   "total",
+	groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY: "monthly",
+	groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:  "weekly",
+}
+
+func (r *GroupRepository) GetBudgets(groupID string) ([]*groupv1.Budget, error) {
+	rows, err := r.db.Query(`
+		SELECT category, amount, period
+		FROM group_budgets WHERE group_id = $1
+		ORDER BY category ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var budgets []*groupv1.Budget
+	for rows.Next() {
+		var budget groupv1.Budget
+		var period string
+		err := rows.Scan(&budget.Category, &budget.Amount, &period)
+		if err != nil {
+			return nil, err
+		}
+		budget.Period, err = budgetPeriodFromName(period)
+		if err != nil {
+			return nil, err
+		}
+		budgets = append(budgets, &budget)
+	}
+
+	return budgets, rows.Err()
+}
+
+func (r *GroupRepository) ReplaceBudgets(groupID string, budgets []*groupv1.Budget) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`DELETE FROM group_budgets WHERE group_id = $1`, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, budget := range budgets {
+		period, ok := budgetPeriodNames[budget.Period]
+		if !ok {
+			return fmt.Errorf("unknown budget period %v", budget.Period)
+		}
+
+		_, err = tx.Exec(`
+			INSERT INTO group_budgets (group_id, category, amount, period)
+			VALUES ($1, $2, $3, $4)
+		`, groupID, budget.Category, budget.Amount, period)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func budgetPeriodFromName(name string) (groupv1.BudgetPeriod, error) {
+	for period, periodName := range budgetPeriodNames {
+		if periodName == name {
+			return period, nil
+		}
+	}
+	return groupv1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED, fmt.Errorf("unknown budget period %q", name)
+}
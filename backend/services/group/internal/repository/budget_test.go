@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestGroupRepository_GetBudgets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+
+	rows := sqlmock.NewRows([]string{"category", "amount", "period"}).
+		AddRow("", int64(100000), "total").
+		AddRow("食費", int64(30000), "monthly")
+	mock.ExpectQuery(`SELECT category, amount, period FROM group_budgets WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	budgets, err := repo.GetBudgets(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	assert.Equal(t, "", budgets[0].Category)
+	assert.Equal(t, groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL, budgets[0].Period)
+	assert.Equal(t, int64(30000), budgets[1].Amount)
+	assert.Equal(t, groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY, budgets[1].Period)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_ReplaceBudgets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM group_budgets WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO group_budgets`).
+		WithArgs(groupID, "食費", int64(30000), "weekly").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.ReplaceBudgets(groupID, []*groupv1.Budget{
+		{Category: "食費", Amount: 30000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY},
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
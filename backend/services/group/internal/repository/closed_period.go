@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func (r *GroupRepository) CreateClosedPeriod(period *domain.ClosedPeriod) error {
+	// The first period of a group has nothing carried forward
+	opening := period.OpeningBalances
+	if opening == nil {
+		opening = []domain.PeriodBalance{}
+	}
+
+	openingBalances, err := periodJSON(opening)
+	if err != nil {
+		return err
+	}
+	balances, err := periodJSON(period.Balances)
+	if err != nil {
+		return err
+	}
+	settlements, err := periodJSON(period.Settlements)
+	if err != nil {
+		return err
+	}
+
+	var closedBy sql.NullString
+	if period.ClosedBy != "" {
+		closedBy = sql.NullString{String: period.ClosedBy, Valid: true}
+	}
+
+	_, err = r.db.Exec(`
+		INSERT INTO closed_periods (id, group_id, period_start, closed_until, opening_balances, balances, settlements, closed_by, closed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, period.ID, period.GroupID, period.PeriodStart, period.ClosedUntil, openingBalances, balances, settlements, closedBy, period.ClosedAt)
+	return err
+}
+
+// GetClosedPeriods lists the closed periods of a group, newest first,
+// including reopened ones
+func (r *GroupRepository) GetClosedPeriods(groupID string) ([]*domain.ClosedPeriod, error) {
+	rows, err := r.db.Query(`
+		SELECT id, group_id, period_start, closed_until, opening_balances, balances, settlements,
+		       closed_by, closed_at, reopened_at, reopened_by
+		FROM closed_periods WHERE group_id = $1
+		ORDER BY closed_at DESC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []*domain.ClosedPeriod
+	for rows.Next() {
+		period, err := scanClosedPeriod(rows)
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+
+	return periods, rows.Err()
+}
+
+func (r *GroupRepository) GetClosedPeriod(periodID string) (*domain.ClosedPeriod, error) {
+	row := r.db.QueryRow(`
+		SELECT id, group_id, period_start, closed_until, opening_balances, balances, settlements,
+		       closed_by, closed_at, reopened_at, reopened_by
+		FROM closed_periods WHERE id = $1
+	`, periodID)
+
+	period, err := scanClosedPeriod(row)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrClosedPeriodNotFound
+	}
+	return period, err
+}
+
+func (r *GroupRepository) ReopenClosedPeriod(periodID, reopenedBy string) error {
+	result, err := r.db.Exec(`
+		UPDATE closed_periods SET reopened_at = NOW(), reopened_by = $2
+		WHERE id = $1 AND reopened_at IS NULL
+	`, periodID, reopenedBy)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrClosedPeriodNotFound
+	}
+
+	return nil
+}
+
+func scanClosedPeriod(row rowScanner) (*domain.ClosedPeriod, error) {
+	var period domain.ClosedPeriod
+	var openingBalances, balances, settlements []byte
+	var periodStart, reopenedAt sql.NullTime
+	var closedBy, reopenedBy sql.NullString
+	err := row.Scan(
+		&period.ID,
+		&period.GroupID,
+		&periodStart,
+		&period.ClosedUntil,
+		&openingBalances,
+		&balances,
+		&settlements,
+		&closedBy,
+		&period.ClosedAt,
+		&reopenedAt,
+		&reopenedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if periodStart.Valid {
+		period.PeriodStart = &periodStart.Time
+	}
+	if reopenedAt.Valid {
+		period.ReopenedAt = &reopenedAt.Time
+	}
+	period.ClosedBy = closedBy.String
+	period.ReopenedBy = reopenedBy.String
+
+	if err := json.Unmarshal(openingBalances, &period.OpeningBalances); err != nil {
+		return nil, fmt.Errorf("failed to decode opening balances: %w", err)
+	}
+	if err := json.Unmarshal(balances, &period.Balances); err != nil {
+		return nil, fmt.Errorf("failed to decode balances: %w", err)
+	}
+	if err := json.Unmarshal(settlements, &period.Settlements); err != nil {
+		return nil, fmt.Errorf("failed to decode settlements: %w", err)
+	}
+
+	return &period, nil
+}
+
+// periodJSON encodes a snapshot column. Like revision splits, the JSON goes
+// over the wire as text because lib/pq sends []byte as bytea.
+func periodJSON(snapshot interface{}) (string, error) {
+	encoded, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode period snapshot: %w", err)
+	}
+	return string(encoded), nil
+}
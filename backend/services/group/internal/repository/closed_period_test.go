@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+var closedPeriodColumns = []string{
+	"id", "group_id", "period_start", "closed_until", "opening_balances", "balances", "settlements",
+	"closed_by", "closed_at", "reopened_at", "reopened_by",
+}
+
+func TestGroupRepository_CreateClosedPeriod(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	period := &domain.ClosedPeriod{
+		ID:          uuid.New().String(),
+		GroupID:     uuid.New().String(),
+		ClosedUntil: now,
+		Balances:    []domain.PeriodBalance{{MemberID: "m1", MemberName: "Alice", Balance: 1000}},
+		Settlements: []domain.PeriodSettlement{},
+		ClosedAt:    now,
+	}
+
+	mock.ExpectExec(`INSERT INTO closed_periods \(id, group_id, period_start, closed_until, opening_balances, balances, settlements, closed_by, closed_at\)`).
+		WithArgs(period.ID, period.GroupID, nil, now, `[]`,
+			`[{"member_id":"m1","member_name":"Alice","balance":1000}]`, `[]`, nil, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewGroupRepository(db).CreateClosedPeriod(period)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_GetClosedPeriods(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	groupID := uuid.New().String()
+	adminID := uuid.New().String()
+	may := time.Date(2024, 5, 31, 23, 59, 59, 0, time.Local)
+	april := time.Date(2024, 4, 30, 23, 59, 59, 0, time.Local)
+
+	rows := sqlmock.NewRows(closedPeriodColumns).
+		AddRow("p2", groupID, april, may,
+			`[{"member_id":"m1","member_name":"Alice","balance":500}]`,
+			`[{"member_id":"m1","member_name":"Alice","balance":1500}]`,
+			`[{"from_member_id":"m2","from_name":"Bob","to_member_id":"m1","to_name":"Alice","amount":1500}]`,
+			nil, may, may, adminID).
+		AddRow("p1", groupID, nil, april, `[]`, `[]`, `[]`, adminID, april, nil, nil)
+	mock.ExpectQuery(`FROM closed_periods WHERE group_id = \$1 ORDER BY closed_at DESC`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	periods, err := NewGroupRepository(db).GetClosedPeriods(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, periods, 2)
+	assert.True(t, april.Equal(*periods[0].PeriodStart))
+	assert.Equal(t, int64(500), periods[0].OpeningBalances[0].Balance)
+	assert.Equal(t, "Bob", periods[0].Settlements[0].FromName)
+	assert.Empty(t, periods[0].ClosedBy)
+	assert.Equal(t, adminID, periods[0].ReopenedBy)
+	assert.Nil(t, periods[1].PeriodStart)
+	assert.Nil(t, periods[1].ReopenedAt)
+	assert.Equal(t, adminID, periods[1].ClosedBy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_ReopenClosedPeriod(t *testing.T) {
+	periodID := uuid.New().String()
+	adminID := uuid.New().String()
+
+	t.Run("reopened", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`UPDATE closed_periods SET reopened_at = NOW\(\), reopened_by = \$2 WHERE id = \$1 AND reopened_at IS NULL`).
+			WithArgs(periodID, adminID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = NewGroupRepository(db).ReopenClosedPeriod(periodID, adminID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already reopened", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`UPDATE closed_periods SET reopened_at`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = NewGroupRepository(db).ReopenClosedPeriod(periodID, adminID)
+
+		assert.ErrorIs(t, err, domain.ErrClosedPeriodNotFound)
+	})
+}
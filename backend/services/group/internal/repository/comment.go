@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func (r *expenseRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		INSERT INTO expense_comments (id, expense_id, member_id, body, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		comment.ID,
+		comment.ExpenseID,
+		comment.MemberID,
+		comment.Body,
+		comment.CreatedAt,
+		comment.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert comment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *expenseRepository) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	query := `
+		UPDATE expense_comments
+		SET body = $1, updated_at = $2
+		WHERE id = $3`
+
+	result, err := r.db.ExecContext(ctx, query, comment.Body, comment.UpdatedAt, comment.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	return nil
+}
+
+func (r *expenseRepository) FindCommentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Comment, error) {
+	query := `
+		SELECT c.id, c.expense_id, c.member_id, m.name, c.body, c.created_at, c.updated_at
+		FROM expense_comments c
+		JOIN members m ON c.member_id = m.id
+		WHERE c.expense_id = $1
+		ORDER BY c.created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		var comment domain.Comment
+		err := rows.Scan(
+			&comment.ID,
+			&comment.ExpenseID,
+			&comment.MemberID,
+			&comment.MemberName,
+			&comment.Body,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, &comment)
+	}
+
+	return comments, rows.Err()
+}
+
+func (r *expenseRepository) FindCommentByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	query := `
+		SELECT c.id, c.expense_id, c.member_id, m.name, c.body, c.created_at, c.updated_at
+		FROM expense_comments c
+		JOIN members m ON c.member_id = m.id
+		WHERE c.id = $1`
+
+	var comment domain.Comment
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&comment.ID,
+		&comment.ExpenseID,
+		&comment.MemberID,
+		&comment.MemberName,
+		&comment.Body,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to query comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+func (r *expenseRepository) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM expense_comments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrCommentNotFound
+	}
+
+	return nil
+}
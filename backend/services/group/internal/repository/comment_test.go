@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_CreateComment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	now := time.Now()
+	comment := &domain.Comment{
+		ID:        uuid.New(),
+		ExpenseID: uuid.New(),
+		MemberID:  uuid.New(),
+		Body:      "飲んでいないので外してください",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	mock.ExpectExec(`INSERT INTO expense_comments \(id, expense_id, member_id, body, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6\)`).
+		WithArgs(comment.ID, comment.ExpenseID, comment.MemberID, comment.Body, now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateComment(context.Background(), comment)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_UpdateComment_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	comment := &domain.Comment{ID: uuid.New(), Body: "updated", UpdatedAt: time.Now()}
+
+	mock.ExpectExec(`UPDATE expense_comments SET body = \$1, updated_at = \$2 WHERE id = \$3`).
+		WithArgs("updated", comment.UpdatedAt, comment.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdateComment(context.Background(), comment)
+
+	assert.ErrorIs(t, err, domain.ErrCommentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindCommentsByExpenseID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	expenseID := uuid.New()
+	commentID := uuid.New()
+	memberID := uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "expense_id", "member_id", "name", "body", "created_at", "updated_at"}).
+		AddRow(commentID, expenseID, memberID, "Alice", "I didn't drink", now, now)
+	mock.ExpectQuery(`SELECT c.id, c.expense_id, c.member_id, m.name, c.body, c.created_at, c.updated_at FROM expense_comments c JOIN members m ON c.member_id = m.id WHERE c.expense_id = \$1 ORDER BY c.created_at ASC`).
+		WithArgs(expenseID).
+		WillReturnRows(rows)
+
+	comments, err := repo.FindCommentsByExpenseID(context.Background(), expenseID)
+
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, commentID, comments[0].ID)
+	assert.Equal(t, "Alice", comments[0].MemberName)
+	assert.Equal(t, "I didn't drink", comments[0].Body)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindCommentByID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+	id := uuid.New()
+
+	mock.ExpectQuery(`FROM expense_comments c JOIN members m ON c.member_id = m.id WHERE c.id = \$1`).
+		WithArgs(id).
+		WillReturnError(sql.ErrNoRows)
+
+	comment, err := repo.FindCommentByID(context.Background(), id)
+
+	assert.ErrorIs(t, err, domain.ErrCommentNotFound)
+	assert.Nil(t, comment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_DeleteComment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+	id := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM expense_comments WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.DeleteComment(context.Background(), id))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
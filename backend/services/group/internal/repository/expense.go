@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jt-chihara/warikan/services/group/internal/domain"
@@ -11,10 +12,35 @@ import (
 
 type ExpenseRepository interface {
 	Create(ctx context.Context, expense *domain.Expense) error
-	Update(ctx context.Context, expense *domain.Expense) error
+	CreateBatch(ctx context.Context, expenses []*domain.Expense) error
+	Update(ctx context.Context, expense *domain.Expense, change domain.ExpenseChange) error
 	FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error)
+	FindByQuery(ctx context.Context, query domain.ExpenseQuery) ([]*domain.Expense, error)
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID, deletedBy *uuid.UUID) error
+	FindDeletedByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error)
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	PurgeDeleted(ctx context.Context, before time.Time) (int64, []string, error)
+	CreateAttachment(ctx context.Context, attachment *domain.Attachment) error
+	FindAttachmentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Attachment, error)
+	FindAttachmentByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	CreateComment(ctx context.Context, comment *domain.Comment) error
+	UpdateComment(ctx context.Context, comment *domain.Comment) error
+	FindCommentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Comment, error)
+	FindCommentByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	SearchExpenses(ctx context.Context, groupID uuid.UUID, text string, limit int) ([]*domain.ExpenseSearchHit, error)
+	FindRevisions(ctx context.Context, expenseID uuid.UUID) ([]*domain.ExpenseRevision, error)
+	FindRevision(ctx context.Context, expenseID uuid.UUID, revision int) (*domain.ExpenseRevision, error)
+	CreateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error
+	UpdateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error
+	FindTemplatesByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.ExpenseTemplate, error)
+	FindTemplateByID(ctx context.Context, id uuid.UUID) (*domain.ExpenseTemplate, error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	MergeExpenses(ctx context.Context, keepID, duplicateID uuid.UUID, mergedBy *uuid.UUID) error
+	SaveVote(ctx context.Context, expenseID, memberID uuid.UUID, vote domain.ApprovalVote, votedAt time.Time) (domain.ApprovalStatus, error)
 }
 
 type expenseRepository struct {
@@ -34,18 +60,21 @@ func (r *expenseRepository) Create(ctx context.Context, expense *domain.Expense)
 
 	// Insert expense
 	query := `
-		INSERT INTO expenses (id, group_id, amount, description, currency, paid_by_id, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		INSERT INTO expenses (id, group_id, amount, description, category, kind, currency, paid_by_id, created_at, updated_at, approval_status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
 
 	_, err = tx.ExecContext(ctx, query,
 		expense.ID,
 		expense.GroupID,
 		expense.Amount,
 		expense.Description,
+		expense.Category,
+		expense.Kind,
 		expense.Currency,
 		expense.PaidByID,
 		expense.CreatedAt,
 		expense.UpdatedAt,
+		expense.ApprovalStatus,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to insert expense: %w", err)
@@ -69,21 +98,29 @@ func (r *expenseRepository) Create(ctx context.Context, expense *domain.Expense)
 		}
 	}
 
+	if err := insertRevision(ctx, tx, expense, domain.ExpenseChange{}); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
-func (r *expenseRepository) Update(ctx context.Context, expense *domain.Expense) error {
+func (r *expenseRepository) Update(ctx context.Context, expense *domain.Expense, change domain.ExpenseChange) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	if err := insertBaselineRevision(ctx, tx, expense.ID); err != nil {
+		return err
+	}
+
 	// Update expense
 	query := `
 		UPDATE expenses 
-		SET amount = $2, description = $3, paid_by_id = $4, updated_at = $5
-		WHERE id = $1`
+		SET amount = $2, description = $3, paid_by_id = $4, updated_at = $5, category = $6, kind = $7, approval_status = $8
+		WHERE id = $1 AND deleted_at IS NULL`
 
 	result, err := tx.ExecContext(ctx, query,
 		expense.ID,
@@ -91,6 +128,9 @@ func (r *expenseRepository) Update(ctx context.Context, expense *domain.Expense)
 		expense.Description,
 		expense.PaidByID,
 		expense.UpdatedAt,
+		expense.Category,
+		expense.Kind,
+		expense.ApprovalStatus,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update expense: %w", err)
@@ -130,20 +170,30 @@ func (r *expenseRepository) Update(ctx context.Context, expense *domain.Expense)
 		}
 	}
 
+	if err := insertRevision(ctx, tx, expense, change); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 func (r *expenseRepository) FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error) {
 	query := `
-		SELECT e.id, e.group_id, e.amount, e.description, e.currency, e.paid_by_id, 
-		       e.created_at, e.updated_at,
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id, 
+		       e.created_at, e.updated_at, e.approval_status,
 		       m.name as paid_by_name
 		FROM expenses e
 		JOIN members m ON e.paid_by_id = m.id
-		WHERE e.group_id = $1
+		WHERE e.group_id = $1 AND e.deleted_at IS NULL
 		ORDER BY e.created_at DESC`
 
-	rows, err := r.db.QueryContext(ctx, query, groupID)
+	return r.queryExpenses(ctx, query, groupID)
+}
+
+// queryExpenses runs a query selecting the expense columns used by
+// FindByGroupID and loads the split members of every row
+func (r *expenseRepository) queryExpenses(ctx context.Context, query string, args ...interface{}) ([]*domain.Expense, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query expenses: %w", err)
 	}
@@ -157,10 +207,13 @@ func (r *expenseRepository) FindByGroupID(ctx context.Context, groupID uuid.UUID
 			&expense.GroupID,
 			&expense.Amount,
 			&expense.Description,
+			&expense.Category,
+			&expense.Kind,
 			&expense.Currency,
 			&expense.PaidByID,
 			&expense.CreatedAt,
 			&expense.UpdatedAt,
+			&expense.ApprovalStatus,
 			&expense.PaidByName,
 		)
 		if err != nil {
@@ -186,12 +239,12 @@ func (r *expenseRepository) FindByGroupID(ctx context.Context, groupID uuid.UUID
 
 func (r *expenseRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
 	query := `
-		SELECT e.id, e.group_id, e.amount, e.description, e.currency, e.paid_by_id, 
-		       e.created_at, e.updated_at,
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id, 
+		       e.created_at, e.updated_at, e.approval_status,
 		       m.name as paid_by_name
 		FROM expenses e
 		JOIN members m ON e.paid_by_id = m.id
-		WHERE e.id = $1`
+		WHERE e.id = $1 AND e.deleted_at IS NULL`
 
 	var expense domain.Expense
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
@@ -199,10 +252,13 @@ func (r *expenseRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain
 		&expense.GroupID,
 		&expense.Amount,
 		&expense.Description,
+		&expense.Category,
+		&expense.Kind,
 		&expense.Currency,
 		&expense.PaidByID,
 		&expense.CreatedAt,
 		&expense.UpdatedAt,
+		&expense.ApprovalStatus,
 		&expense.PaidByName,
 	)
 	if err != nil {
@@ -222,23 +278,15 @@ func (r *expenseRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain
 	return &expense, nil
 }
 
-func (r *expenseRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-	defer tx.Rollback()
-
-	// Delete expense splits first (foreign key constraint)
-	splitQuery := `DELETE FROM expense_splits WHERE expense_id = $1`
-	_, err = tx.ExecContext(ctx, splitQuery, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete expense splits: %w", err)
-	}
+// Delete moves an expense into the trash. It is left out of every listing
+// until it is restored or purged.
+func (r *expenseRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy *uuid.UUID) error {
+	query := `
+		UPDATE expenses
+		SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL`
 
-	// Delete expense
-	expenseQuery := `DELETE FROM expenses WHERE id = $1`
-	result, err := tx.ExecContext(ctx, expenseQuery, id)
+	result, err := r.db.ExecContext(ctx, query, id, deletedBy)
 	if err != nil {
 		return fmt.Errorf("failed to delete expense: %w", err)
 	}
@@ -252,12 +300,12 @@ func (r *expenseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return domain.ErrExpenseNotFound
 	}
 
-	return tx.Commit()
+	return nil
 }
 
 func (r *expenseRepository) findSplitMembers(ctx context.Context, expenseID uuid.UUID) ([]domain.SplitMember, error) {
 	query := `
-		SELECT es.member_id, es.amount, m.name
+		SELECT es.member_id, es.amount, m.name, es.vote, es.voted_at
 		FROM expense_splits es
 		JOIN members m ON es.member_id = m.id
 		WHERE es.expense_id = $1
@@ -272,7 +320,7 @@ func (r *expenseRepository) findSplitMembers(ctx context.Context, expenseID uuid
 	var splits []domain.SplitMember
 	for rows.Next() {
 		var split domain.SplitMember
-		err := rows.Scan(&split.MemberID, &split.Amount, &split.MemberName)
+		err := rows.Scan(&split.MemberID, &split.Amount, &split.MemberName, &split.Vote, &split.VotedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan split member: %w", err)
 		}
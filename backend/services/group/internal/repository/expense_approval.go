@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// SaveVote records the vote of a split member and returns the approval status
+// it results in, all or nothing. The expense and its splits are locked so the
+// status is worked out from every vote as saved, not from what a concurrent
+// vote or edit read before.
+func (r *expenseRepository) SaveVote(ctx context.Context, expenseID, memberID uuid.UUID, vote domain.ApprovalVote, votedAt time.Time) (domain.ApprovalStatus, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT approval_status
+		FROM expenses
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`
+
+	var current domain.ApprovalStatus
+	err = tx.QueryRowContext(ctx, query, expenseID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return "", domain.ErrExpenseNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to lock expense: %w", err)
+	}
+
+	if current == domain.ApprovalStatusApproved {
+		return "", domain.ErrExpenseAlreadyApproved
+	}
+
+	query = `
+		UPDATE expense_splits
+		SET vote = $3, voted_at = $4
+		WHERE expense_id = $1 AND member_id = $2`
+
+	result, err := tx.ExecContext(ctx, query, expenseID, memberID, vote, votedAt)
+	if err != nil {
+		return "", fmt.Errorf("failed to save vote: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return "", domain.ErrExpenseNotFound
+	}
+
+	query = `
+		SELECT vote
+		FROM expense_splits
+		WHERE expense_id = $1
+		FOR UPDATE`
+
+	rows, err := tx.QueryContext(ctx, query, expenseID)
+	if err != nil {
+		return "", fmt.Errorf("failed to query votes: %w", err)
+	}
+
+	var splits []domain.SplitMember
+	for rows.Next() {
+		var split domain.SplitMember
+		if err := rows.Scan(&split.Vote); err != nil {
+			rows.Close()
+			return "", fmt.Errorf("failed to scan vote: %w", err)
+		}
+		splits = append(splits, split)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("error during row iteration: %w", err)
+	}
+
+	status := domain.ApprovalStatusFromVotes(splits)
+
+	query = `
+		UPDATE expenses
+		SET approval_status = $2
+		WHERE id = $1`
+
+	if _, err := tx.ExecContext(ctx, query, expenseID, status); err != nil {
+		return "", fmt.Errorf("failed to update approval status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit vote: %w", err)
+	}
+
+	return status, nil
+}
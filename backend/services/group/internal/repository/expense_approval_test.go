@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_SaveVote(t *testing.T) {
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	now := time.Now()
+
+	expectVote := func(mock sqlmock.Sqlmock, memberID uuid.UUID, vote domain.ApprovalVote, saved []domain.ApprovalVote, status domain.ApprovalStatus) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT approval_status FROM expenses WHERE id = \$1 AND deleted_at IS NULL FOR UPDATE`).
+			WithArgs(expenseID).
+			WillReturnRows(sqlmock.NewRows([]string{"approval_status"}).AddRow("pending"))
+		mock.ExpectExec(`UPDATE expense_splits SET vote = \$3, voted_at = \$4 WHERE expense_id = \$1 AND member_id = \$2`).
+			WithArgs(expenseID, memberID, vote, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		rows := sqlmock.NewRows([]string{"vote"})
+		for _, v := range saved {
+			rows.AddRow(string(v))
+		}
+		mock.ExpectQuery(`SELECT vote FROM expense_splits WHERE expense_id = \$1 FOR UPDATE`).
+			WithArgs(expenseID).
+			WillReturnRows(rows)
+		mock.ExpectExec(`UPDATE expenses SET approval_status = \$2 WHERE id = \$1`).
+			WithArgs(expenseID, status).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+
+	t.Run("two votes in a row", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		// Each vote works out the status from the votes as locked, so the
+		// second one sees the first even if both read the expense before
+		expectVote(mock, aliceID, domain.ApprovalVoteApprove,
+			[]domain.ApprovalVote{domain.ApprovalVoteApprove, ""}, domain.ApprovalStatusPending)
+		expectVote(mock, bobID, domain.ApprovalVoteApprove,
+			[]domain.ApprovalVote{domain.ApprovalVoteApprove, domain.ApprovalVoteApprove}, domain.ApprovalStatusApproved)
+
+		repo := NewExpenseRepository(db)
+		status, err := repo.SaveVote(context.Background(), expenseID, aliceID, domain.ApprovalVoteApprove, now)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ApprovalStatusPending, status)
+
+		status, err = repo.SaveVote(context.Background(), expenseID, bobID, domain.ApprovalVoteApprove, now)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ApprovalStatusApproved, status)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("one dispute is enough", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectVote(mock, aliceID, domain.ApprovalVoteDispute,
+			[]domain.ApprovalVote{domain.ApprovalVoteDispute, domain.ApprovalVoteApprove}, domain.ApprovalStatusDisputed)
+
+		status, err := NewExpenseRepository(db).SaveVote(context.Background(), expenseID, aliceID, domain.ApprovalVoteDispute, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ApprovalStatusDisputed, status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("approved in the meantime", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT approval_status FROM expenses`).
+			WillReturnRows(sqlmock.NewRows([]string{"approval_status"}).AddRow("approved"))
+		mock.ExpectRollback()
+
+		_, err = NewExpenseRepository(db).SaveVote(context.Background(), expenseID, aliceID, domain.ApprovalVoteDispute, now)
+
+		assert.ErrorIs(t, err, domain.ErrExpenseAlreadyApproved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expense in the trash", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT approval_status FROM expenses`).
+			WillReturnRows(sqlmock.NewRows([]string{"approval_status"}))
+		mock.ExpectRollback()
+
+		_, err = NewExpenseRepository(db).SaveVote(context.Background(), expenseID, aliceID, domain.ApprovalVoteDispute, now)
+
+		assert.ErrorIs(t, err, domain.ErrExpenseNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
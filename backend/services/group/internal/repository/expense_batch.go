@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// maxRowsPerInsert keeps multi-row inserts well below the 65535 bind
+// parameters PostgreSQL accepts per statement
+const maxRowsPerInsert = 1000
+
+// CreateBatch inserts several new expenses with their splits and first
+// revisions in one transaction, using multi-row inserts
+func (r *expenseRepository) CreateBatch(ctx context.Context, expenses []*domain.Expense) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertExpenses(ctx, tx, expenses); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertExpenses inserts new expenses with their splits and first revisions
+// as part of a transaction
+func insertExpenses(ctx context.Context, tx *sql.Tx, expenses []*domain.Expense) error {
+	expenseRows := make([][]interface{}, 0, len(expenses))
+	revisionRows := make([][]interface{}, 0, len(expenses))
+	var splitRows [][]interface{}
+	for _, expense := range expenses {
+		expenseRows = append(expenseRows, []interface{}{
+			expense.ID,
+			expense.GroupID,
+			expense.Amount,
+			expense.Description,
+			expense.Category,
+			expense.Kind,
+			expense.Currency,
+			expense.PaidByID,
+			expense.CreatedAt,
+			expense.UpdatedAt,
+			expense.ApprovalStatus,
+		})
+
+		for _, split := range expense.SplitMembers {
+			splitRows = append(splitRows, []interface{}{expense.ID, split.MemberID, split.Amount})
+		}
+
+		splitsJSON, err := revisionSplitsJSON(expense.SplitMembers)
+		if err != nil {
+			return err
+		}
+		revisionRows = append(revisionRows, []interface{}{
+			expense.ID,
+			1,
+			expense.Amount,
+			expense.Description,
+			expense.Category,
+			expense.Kind,
+			expense.PaidByID,
+			expense.PaidByName,
+			splitsJSON,
+			expense.UpdatedAt,
+		})
+	}
+
+	err := insertRows(ctx, tx,
+		`INSERT INTO expenses (id, group_id, amount, description, category, kind, currency, paid_by_id, created_at, updated_at, approval_status)`,
+		expenseRows)
+	if err != nil {
+		return fmt.Errorf("failed to insert expenses: %w", err)
+	}
+
+	err = insertRows(ctx, tx,
+		`INSERT INTO expense_splits (expense_id, member_id, amount)`,
+		splitRows)
+	if err != nil {
+		return fmt.Errorf("failed to insert expense splits: %w", err)
+	}
+
+	err = insertRows(ctx, tx,
+		`INSERT INTO expense_revisions (expense_id, revision, amount, description, category, kind, paid_by_id, paid_by_name, splits, created_at)`,
+		revisionRows)
+	if err != nil {
+		return fmt.Errorf("failed to insert expense revisions: %w", err)
+	}
+
+	return nil
+}
+
+// insertRows appends a VALUES list for rows to insert and executes it, split
+// into statements of at most maxRowsPerInsert rows
+func insertRows(ctx context.Context, tx *sql.Tx, insert string, rows [][]interface{}) error {
+	for start := 0; start < len(rows); start += maxRowsPerInsert {
+		end := min(start+maxRowsPerInsert, len(rows))
+
+		var query strings.Builder
+		query.WriteString(insert)
+		query.WriteString(" VALUES ")
+		var args []interface{}
+		for i, row := range rows[start:end] {
+			if i > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString("(")
+			for j, value := range row {
+				if j > 0 {
+					query.WriteString(", ")
+				}
+				args = append(args, value)
+				fmt.Fprintf(&query, "$%d", len(args))
+			}
+			query.WriteString(")")
+		}
+
+		if _, err := tx.ExecContext(ctx, query.String(), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
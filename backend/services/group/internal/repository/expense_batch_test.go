@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_CreateBatch(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	now := time.Now()
+
+	lunch := &domain.Expense{
+		ID: uuid.New(), GroupID: groupID, Amount: 3000, Description: "Lunch", Kind: domain.ExpenseKindExpense,
+		Currency: "JPY", PaidByID: aliceID, PaidByName: "Alice", CreatedAt: now, UpdatedAt: now, ApprovalStatus: domain.ApprovalStatusApproved,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, MemberName: "Alice", Amount: 1500},
+			{MemberID: bobID, MemberName: "Bob", Amount: 1500},
+		},
+	}
+	taxi := &domain.Expense{
+		ID: uuid.New(), GroupID: groupID, Amount: 2000, Description: "Taxi", Kind: domain.ExpenseKindExpense,
+		Currency: "JPY", PaidByID: bobID, PaidByName: "Bob", CreatedAt: now, UpdatedAt: now, ApprovalStatus: domain.ApprovalStatusApproved,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: bobID, MemberName: "Bob", Amount: 2000},
+		},
+	}
+
+	t.Run("inserts every table with one statement", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO expenses \(.*\) VALUES \(\$1, .*, \$11\), \(\$12, .*, \$22\)$`).
+			WithArgs(
+				lunch.ID, groupID, int64(3000), "Lunch", "", "expense", "JPY", aliceID, now, now, "approved",
+				taxi.ID, groupID, int64(2000), "Taxi", "", "expense", "JPY", bobID, now, now, "approved",
+			).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO expense_splits \(expense_id, member_id, amount\) VALUES \(\$1, \$2, \$3\), \(\$4, \$5, \$6\), \(\$7, \$8, \$9\)$`).
+			WithArgs(lunch.ID, aliceID, int64(1500), lunch.ID, bobID, int64(1500), taxi.ID, bobID, int64(2000)).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`INSERT INTO expense_revisions \(.*\) VALUES \(\$1, .*, \$10\), \(\$11, .*, \$20\)$`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		repo := NewExpenseRepository(db)
+		err = repo.CreateBatch(context.Background(), []*domain.Expense{lunch, taxi})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when the splits fail", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO expenses`).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO expense_splits`).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		repo := NewExpenseRepository(db)
+		err = repo.CreateBatch(context.Background(), []*domain.Expense{lunch, taxi})
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestInsertRows_SplitsLargeBatches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows := make([][]interface{}, maxRowsPerInsert+1)
+	for i := range rows {
+		rows[i] = []interface{}{i}
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO t \(n\) VALUES \(\$1\), .*, \(\$1000\)$`).WillReturnResult(sqlmock.NewResult(0, maxRowsPerInsert))
+	mock.ExpectExec(`INSERT INTO t \(n\) VALUES \(\$1\)$`).WithArgs(maxRowsPerInsert).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	err = insertRows(context.Background(), tx, `INSERT INTO t (n)`, rows)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
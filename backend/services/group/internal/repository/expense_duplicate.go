@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// MergeExpenses hands the attachments and comments of a duplicate over to
+// the expense kept and moves the duplicate to the trash, all or nothing
+func (r *expenseRepository) MergeExpenses(ctx context.Context, keepID, duplicateID uuid.UUID, mergedBy *uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `UPDATE expense_attachments SET expense_id = $1 WHERE expense_id = $2`, keepID, duplicateID)
+	if err != nil {
+		return fmt.Errorf("failed to move attachments: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE expense_comments SET expense_id = $1 WHERE expense_id = $2`, keepID, duplicateID)
+	if err != nil {
+		return fmt.Errorf("failed to move comments: %w", err)
+	}
+
+	query := `
+		UPDATE expenses
+		SET deleted_at = NOW(), deleted_by = $2
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := tx.ExecContext(ctx, query, duplicateID, mergedBy)
+	if err != nil {
+		return fmt.Errorf("failed to delete expense: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrExpenseNotFound
+	}
+
+	return tx.Commit()
+}
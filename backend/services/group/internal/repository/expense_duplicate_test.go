@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_MergeExpenses(t *testing.T) {
+	keepID := uuid.New()
+	duplicateID := uuid.New()
+	mergedBy := uuid.New()
+
+	t.Run("moves attachments and comments, then trashes the duplicate", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE expense_attachments SET expense_id = \$1 WHERE expense_id = \$2`).
+			WithArgs(keepID, duplicateID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE expense_comments SET expense_id = \$1 WHERE expense_id = \$2`).
+			WithArgs(keepID, duplicateID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE expenses SET deleted_at = NOW\(\), deleted_by = \$2 WHERE id = \$1 AND deleted_at IS NULL`).
+			WithArgs(duplicateID, &mergedBy).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := NewExpenseRepository(db)
+		err = repo.MergeExpenses(context.Background(), keepID, duplicateID, &mergedBy)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicate already in the trash", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE expense_attachments`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE expense_comments`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE expenses SET deleted_at`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		repo := NewExpenseRepository(db)
+		err = repo.MergeExpenses(context.Background(), keepID, duplicateID, nil)
+
+		assert.ErrorIs(t, err, domain.ErrExpenseNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
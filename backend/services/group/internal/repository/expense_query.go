@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// FindByQuery lists the expenses of a group matching the query's filters,
+// ordered by the requested field with the expense ID as a tie breaker so that
+// keyset pagination is stable.
+func (r *expenseRepository) FindByQuery(ctx context.Context, q domain.ExpenseQuery) ([]*domain.Expense, error) {
+	conditions := []string{"e.group_id = $1", "e.deleted_at IS NULL"}
+	args := []interface{}{q.GroupID}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(q.IDs) > 0 {
+		placeholders := make([]string, len(q.IDs))
+		for i, id := range q.IDs {
+			placeholders[i] = arg(id)
+		}
+		conditions = append(conditions, "e.id IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if q.CreatedFrom != nil {
+		conditions = append(conditions, "e.created_at >= "+arg(*q.CreatedFrom))
+	}
+	if q.CreatedTo != nil {
+		conditions = append(conditions, "e.created_at < "+arg(*q.CreatedTo))
+	}
+	if q.PayerID != nil {
+		conditions = append(conditions, "e.paid_by_id = "+arg(*q.PayerID))
+	}
+	if q.ParticipantID != nil {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM expense_splits es WHERE es.expense_id = e.id AND es.member_id = "+arg(*q.ParticipantID)+")")
+	}
+	if q.MinAmount > 0 {
+		conditions = append(conditions, "e.amount >= "+arg(q.MinAmount))
+	}
+	if q.MaxAmount > 0 {
+		conditions = append(conditions, "e.amount <= "+arg(q.MaxAmount))
+	}
+	if q.Category != "" {
+		conditions = append(conditions, "e.category = "+arg(q.Category))
+	}
+	if q.Text != "" {
+		conditions = append(conditions, "e.description ILIKE "+arg("%"+escapeLike(q.Text)+"%"))
+	}
+
+	column, value := sortColumn(q)
+	direction, comparison := "DESC", "<"
+	if q.Ascending {
+		direction, comparison = "ASC", ">"
+	}
+
+	if q.After != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, e.id) %s (%s, %s)", column, comparison, arg(value), arg(q.After.ID)))
+	}
+
+	query := `
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id,
+		       e.created_at, e.updated_at, e.approval_status,
+		       m.name as paid_by_name
+		FROM expenses e
+		JOIN members m ON e.paid_by_id = m.id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY ` + column + " " + direction + ", e.id " + direction
+
+	if q.Limit > 0 {
+		query += " LIMIT " + arg(q.Limit)
+	}
+
+	return r.queryExpenses(ctx, query, args...)
+}
+
+// sortColumn returns the column for the query's sort field and the cursor's
+// value for that column
+func sortColumn(q domain.ExpenseQuery) (string, interface{}) {
+	var cursor domain.ExpenseCursor
+	if q.After != nil {
+		cursor = *q.After
+	}
+
+	switch q.SortBy {
+	case domain.SortByAmount:
+		return "e.amount", cursor.Amount
+	case domain.SortByDescription:
+		return "e.description", cursor.Description
+	default:
+		return "e.created_at", cursor.CreatedAt
+	}
+}
+
+// escapeLike escapes the LIKE wildcards in user input
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
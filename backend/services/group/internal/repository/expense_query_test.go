@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_FindByQuery(t *testing.T) {
+	groupID := uuid.New()
+	payerID := uuid.New()
+	participantID := uuid.New()
+	cursorID := uuid.New()
+	expenseIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name"}
+
+	tests := []struct {
+		name       string
+		query      domain.ExpenseQuery
+		setupMocks func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:  "defaults to newest first",
+			query: domain.ExpenseQuery{GroupID: groupID},
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE e\.group_id = \$1 AND e\.deleted_at IS NULL ORDER BY e\.created_at DESC, e\.id DESC$`).
+					WithArgs(groupID).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "all filters",
+			query: domain.ExpenseQuery{
+				GroupID:       groupID,
+				CreatedFrom:   &from,
+				CreatedTo:     &to,
+				PayerID:       &payerID,
+				ParticipantID: &participantID,
+				MinAmount:     100,
+				MaxAmount:     5000,
+				Category:      "食費",
+				Text:          "50%_off",
+			},
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE e\.group_id = \$1 AND e\.deleted_at IS NULL AND e\.created_at >= \$2 AND e\.created_at < \$3 AND e\.paid_by_id = \$4 AND EXISTS \(SELECT 1 FROM expense_splits es WHERE es\.expense_id = e\.id AND es\.member_id = \$5\) AND e\.amount >= \$6 AND e\.amount <= \$7 AND e\.category = \$8 AND e\.description ILIKE \$9`).
+					WithArgs(groupID, from, to, payerID, participantID, int64(100), int64(5000), "食費", `%50\%\_off%`).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "expense IDs within a date range",
+			query: domain.ExpenseQuery{
+				GroupID:     groupID,
+				IDs:         expenseIDs,
+				CreatedFrom: &from,
+			},
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE e\.group_id = \$1 AND e\.deleted_at IS NULL AND e\.id IN \(\$2, \$3\) AND e\.created_at >= \$4 ORDER BY`).
+					WithArgs(groupID, expenseIDs[0], expenseIDs[1], from).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "amount ascending after cursor with limit",
+			query: domain.ExpenseQuery{
+				GroupID:   groupID,
+				SortBy:    domain.SortByAmount,
+				Ascending: true,
+				Limit:     21,
+				After:     &domain.ExpenseCursor{ID: cursorID, Amount: 1200},
+			},
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE e\.group_id = \$1 AND e\.deleted_at IS NULL AND \(e\.amount, e\.id\) > \(\$2, \$3\) ORDER BY e\.amount ASC, e\.id ASC LIMIT \$4`).
+					WithArgs(groupID, int64(1200), cursorID, 21).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.setupMocks(mock)
+
+			repo := NewExpenseRepository(db)
+			expenses, err := repo.FindByQuery(context.Background(), tt.query)
+
+			assert.NoError(t, err)
+			assert.Empty(t, expenses)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestExpenseRepository_FindByQuery_LoadsSplits(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	paidByID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM expenses e JOIN members m ON e\.paid_by_id = m\.id WHERE e\.group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name"}).
+			AddRow(expenseID, groupID, int64(3000), "Lunch", "食費", "expense", "JPY", paidByID, now, now, "approved", "Alice"))
+	mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name, es\.vote, es\.voted_at FROM expense_splits es JOIN members m`).
+		WithArgs(expenseID).
+		WillReturnRows(sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}).AddRow(paidByID, int64(3000), "Alice", "", nil))
+
+	repo := NewExpenseRepository(db)
+	expenses, err := repo.FindByQuery(context.Background(), domain.ExpenseQuery{GroupID: groupID})
+
+	require.NoError(t, err)
+	require.Len(t, expenses, 1)
+	assert.Equal(t, "食費", expenses[0].Category)
+	assert.Len(t, expenses[0].SplitMembers, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
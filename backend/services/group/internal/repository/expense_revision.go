@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// insertRevision stores the state of an expense as its next revision
+func insertRevision(ctx context.Context, tx *sql.Tx, expense *domain.Expense, change domain.ExpenseChange) error {
+	splitsJSON, err := revisionSplitsJSON(expense.SplitMembers)
+	if err != nil {
+		return err
+	}
+
+	var revertedFrom sql.NullInt64
+	if change.RevertedFrom > 0 {
+		revertedFrom = sql.NullInt64{Int64: int64(change.RevertedFrom), Valid: true}
+	}
+
+	query := `
+		INSERT INTO expense_revisions (expense_id, revision, amount, description, category, kind, paid_by_id, paid_by_name, splits, changed_by, reverted_from, created_at)
+		VALUES ($1, (SELECT COALESCE(MAX(revision), 0) + 1 FROM expense_revisions WHERE expense_id = $1), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err = tx.ExecContext(ctx, query,
+		expense.ID,
+		expense.Amount,
+		expense.Description,
+		expense.Category,
+		expense.Kind,
+		expense.PaidByID,
+		expense.PaidByName,
+		splitsJSON,
+		change.ChangedByID,
+		revertedFrom,
+		expense.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert expense revision: %w", err)
+	}
+
+	return nil
+}
+
+// revisionSplitsJSON encodes splits for the revision splits column. lib/pq
+// sends []byte as bytea, so the JSON goes over the wire as text.
+func revisionSplitsJSON(splits []domain.SplitMember) (string, error) {
+	if splits == nil {
+		splits = []domain.SplitMember{}
+	}
+	splitsJSON, err := json.Marshal(splits)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode revision splits: %w", err)
+	}
+	return string(splitsJSON), nil
+}
+
+// insertBaselineRevision records the current state of an expense created
+// before revisions were kept, so its first update does not lose the original
+func insertBaselineRevision(ctx context.Context, tx *sql.Tx, expenseID uuid.UUID) error {
+	query := `
+		INSERT INTO expense_revisions (expense_id, revision, amount, description, category, kind, paid_by_id, paid_by_name, splits, created_at)
+		SELECT e.id, 1, e.amount, e.description, e.category, e.kind, e.paid_by_id, p.name,
+		       COALESCE((SELECT jsonb_agg(jsonb_build_object('member_id', es.member_id, 'member_name', m.name, 'amount', es.amount) ORDER BY m.name)
+		                 FROM expense_splits es JOIN members m ON es.member_id = m.id
+		                 WHERE es.expense_id = e.id), '[]'::jsonb),
+		       e.updated_at
+		FROM expenses e
+		JOIN members p ON e.paid_by_id = p.id
+		WHERE e.id = $1 AND e.deleted_at IS NULL
+		  AND NOT EXISTS (SELECT 1 FROM expense_revisions r WHERE r.expense_id = e.id)`
+
+	if _, err := tx.ExecContext(ctx, query, expenseID); err != nil {
+		return fmt.Errorf("failed to insert baseline revision: %w", err)
+	}
+
+	return nil
+}
+
+const revisionColumns = `
+		SELECT r.expense_id, r.revision, r.amount, r.description, r.category, r.kind, r.paid_by_id, r.paid_by_name,
+		       r.splits, r.changed_by, c.name as changed_by_name, r.reverted_from, r.created_at
+		FROM expense_revisions r
+		LEFT JOIN members c ON r.changed_by = c.id`
+
+// FindRevisions lists the revisions of an expense, oldest first
+func (r *expenseRepository) FindRevisions(ctx context.Context, expenseID uuid.UUID) ([]*domain.ExpenseRevision, error) {
+	query := revisionColumns + `
+		WHERE r.expense_id = $1
+		ORDER BY r.revision`
+
+	rows, err := r.db.QueryContext(ctx, query, expenseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expense revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.ExpenseRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during row iteration: %w", err)
+	}
+
+	return revisions, nil
+}
+
+func (r *expenseRepository) FindRevision(ctx context.Context, expenseID uuid.UUID, revision int) (*domain.ExpenseRevision, error) {
+	query := revisionColumns + `
+		WHERE r.expense_id = $1 AND r.revision = $2`
+
+	found, err := scanRevision(r.db.QueryRowContext(ctx, query, expenseID, revision))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRevision(row rowScanner) (*domain.ExpenseRevision, error) {
+	var revision domain.ExpenseRevision
+	var splitsJSON []byte
+	var changedBy uuid.NullUUID
+	var changedByName sql.NullString
+	var revertedFrom sql.NullInt64
+	err := row.Scan(
+		&revision.ExpenseID,
+		&revision.Revision,
+		&revision.Amount,
+		&revision.Description,
+		&revision.Category,
+		&revision.Kind,
+		&revision.PaidByID,
+		&revision.PaidByName,
+		&splitsJSON,
+		&changedBy,
+		&changedByName,
+		&revertedFrom,
+		&revision.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan expense revision: %w", err)
+	}
+
+	if err := json.Unmarshal(splitsJSON, &revision.SplitMembers); err != nil {
+		return nil, fmt.Errorf("failed to decode revision splits: %w", err)
+	}
+	if changedBy.Valid {
+		revision.ChangedByID = &changedBy.UUID
+	}
+	revision.ChangedByName = changedByName.String
+	revision.RevertedFrom = int(revertedFrom.Int64)
+
+	return &revision, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+var revisionColumnNames = []string{"expense_id", "revision", "amount", "description", "category", "kind", "paid_by_id", "paid_by_name", "splits", "changed_by", "changed_by_name", "reverted_from", "created_at"}
+
+func TestExpenseRepository_FindRevisions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	expenseID := uuid.New()
+	paidByID := uuid.New()
+	changedByID := uuid.New()
+	now := time.Now()
+	splits := `[{"member_id":"` + paidByID.String() + `","member_name":"Alice","amount":3000}]`
+
+	mock.ExpectQuery(`FROM expense_revisions r LEFT JOIN members c ON r\.changed_by = c\.id WHERE r\.expense_id = \$1 ORDER BY r\.revision`).
+		WithArgs(expenseID).
+		WillReturnRows(sqlmock.NewRows(revisionColumnNames).
+			AddRow(expenseID, 1, int64(3000), "Lunch", "", "expense", paidByID, "Alice", []byte(splits), nil, nil, nil, now).
+			AddRow(expenseID, 2, int64(3500), "Lunch", "食費", "expense", paidByID, "Alice", []byte(`[]`), changedByID, "Bob", int64(1), now))
+
+	repo := NewExpenseRepository(db)
+	revisions, err := repo.FindRevisions(context.Background(), expenseID)
+
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, 1, revisions[0].Revision)
+	assert.Equal(t, []domain.SplitMember{{MemberID: paidByID, MemberName: "Alice", Amount: 3000}}, revisions[0].SplitMembers)
+	assert.Nil(t, revisions[0].ChangedByID)
+	assert.Zero(t, revisions[0].RevertedFrom)
+	assert.Equal(t, &changedByID, revisions[1].ChangedByID)
+	assert.Equal(t, "Bob", revisions[1].ChangedByName)
+	assert.Equal(t, 1, revisions[1].RevertedFrom)
+	assert.Empty(t, revisions[1].SplitMembers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindRevision_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	expenseID := uuid.New()
+	mock.ExpectQuery(`WHERE r\.expense_id = \$1 AND r\.revision = \$2`).
+		WithArgs(expenseID, 3).
+		WillReturnRows(sqlmock.NewRows(revisionColumnNames))
+
+	repo := NewExpenseRepository(db)
+	_, err = repo.FindRevision(context.Background(), expenseID, 3)
+
+	assert.ErrorIs(t, err, domain.ErrRevisionNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
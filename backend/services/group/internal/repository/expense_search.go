@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// SearchExpenses ranks the expenses of a group by how well text matches their
+// description, comments, payer or split member names. A case-insensitive
+// substring match always qualifies and scores 1, so queries shorter than a
+// trigram still work; otherwise the pg_trgm word similarity has to pass its
+// threshold, which tolerates small typos.
+func (r *expenseRepository) SearchExpenses(ctx context.Context, groupID uuid.UUID, text string, limit int) ([]*domain.ExpenseSearchHit, error) {
+	query := `
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id,
+		       e.created_at, e.updated_at, e.approval_status,
+		       p.name as paid_by_name,
+		       hit.score
+		FROM expenses e
+		JOIN members p ON e.paid_by_id = p.id
+		CROSS JOIN LATERAL (
+			SELECT GREATEST(
+				CASE WHEN e.description ILIKE $3 THEN 1 ELSE word_similarity($2, e.description) END,
+				CASE WHEN p.name ILIKE $3 THEN 1 ELSE word_similarity($2, p.name) END,
+				(SELECT MAX(CASE WHEN c.body ILIKE $3 THEN 1 ELSE word_similarity($2, c.body) END)
+				 FROM expense_comments c WHERE c.expense_id = e.id),
+				(SELECT MAX(CASE WHEN m.name ILIKE $3 THEN 1 ELSE word_similarity($2, m.name) END)
+				 FROM expense_splits es JOIN members m ON es.member_id = m.id WHERE es.expense_id = e.id)
+			) AS score
+		) hit
+		WHERE e.group_id = $1 AND e.deleted_at IS NULL
+		  AND (
+			e.description ILIKE $3 OR $2 <% e.description
+			OR p.name ILIKE $3 OR $2 <% p.name
+			OR EXISTS (SELECT 1 FROM expense_comments c WHERE c.expense_id = e.id AND (c.body ILIKE $3 OR $2 <% c.body))
+			OR EXISTS (SELECT 1 FROM expense_splits es JOIN members m ON es.member_id = m.id
+			           WHERE es.expense_id = e.id AND (m.name ILIKE $3 OR $2 <% m.name))
+		  )
+		ORDER BY hit.score DESC, e.created_at DESC, e.id DESC
+		LIMIT $4`
+
+	pattern := "%" + escapeLike(text) + "%"
+
+	rows, err := r.db.QueryContext(ctx, query, groupID, text, pattern, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search expenses: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*domain.ExpenseSearchHit
+	for rows.Next() {
+		var expense domain.Expense
+		var hit domain.ExpenseSearchHit
+		err := rows.Scan(
+			&expense.ID,
+			&expense.GroupID,
+			&expense.Amount,
+			&expense.Description,
+			&expense.Category,
+			&expense.Kind,
+			&expense.Currency,
+			&expense.PaidByID,
+			&expense.CreatedAt,
+			&expense.UpdatedAt,
+			&expense.ApprovalStatus,
+			&expense.PaidByName,
+			&hit.Score,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan expense: %w", err)
+		}
+
+		// Load split members
+		splits, err := r.findSplitMembers(ctx, expense.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load split members for expense %s: %w", expense.ID, err)
+		}
+		expense.SplitMembers = splits
+		hit.Expense = &expense
+
+		comments, err := r.findMatchingComments(ctx, expense.ID, text, pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load matching comments for expense %s: %w", expense.ID, err)
+		}
+		hit.Comments = comments
+
+		hits = append(hits, &hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during row iteration: %w", err)
+	}
+
+	return hits, nil
+}
+
+func (r *expenseRepository) findMatchingComments(ctx context.Context, expenseID uuid.UUID, text, pattern string) ([]*domain.Comment, error) {
+	query := `
+		SELECT c.id, c.expense_id, c.member_id, m.name, c.body, c.created_at, c.updated_at
+		FROM expense_comments c
+		JOIN members m ON c.member_id = m.id
+		WHERE c.expense_id = $1 AND (c.body ILIKE $3 OR $2 <% c.body)
+		ORDER BY c.created_at ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, expenseID, text, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		var comment domain.Comment
+		err := rows.Scan(
+			&comment.ID,
+			&comment.ExpenseID,
+			&comment.MemberID,
+			&comment.MemberName,
+			&comment.Body,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, &comment)
+	}
+
+	return comments, rows.Err()
+}
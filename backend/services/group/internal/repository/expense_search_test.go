@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseRepository_SearchExpenses(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	paidByID := uuid.New()
+	commentID := uuid.New()
+	now := time.Now()
+
+	t.Run("returns ranked hits with matching comments", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`WHERE e\.group_id = \$1 AND e\.deleted_at IS NULL AND \( e\.description ILIKE \$3 OR \$2 <% e\.description .* ORDER BY hit\.score DESC, e\.created_at DESC, e\.id DESC LIMIT \$4`).
+			WithArgs(groupID, "taxi", "%taxi%", 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name", "score"}).
+				AddRow(expenseID, groupID, int64(2400), "Taxi to hotel", "交通費", "expense", "JPY", paidByID, now, now, "approved", "Alice", 1.0))
+		mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name, es\.vote, es\.voted_at FROM expense_splits es JOIN members m`).
+			WithArgs(expenseID).
+			WillReturnRows(sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}).AddRow(paidByID, int64(2400), "Alice", "", nil))
+		mock.ExpectQuery(`FROM expense_comments c JOIN members m ON c\.member_id = m\.id WHERE c\.expense_id = \$1 AND \(c\.body ILIKE \$3 OR \$2 <% c\.body\)`).
+			WithArgs(expenseID, "taxi", "%taxi%").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "expense_id", "member_id", "name", "body", "created_at", "updated_at"}).
+				AddRow(commentID, expenseID, paidByID, "Alice", "the taxi on day 2", now, now))
+
+		repo := NewExpenseRepository(db)
+		hits, err := repo.SearchExpenses(context.Background(), groupID, "taxi", 20)
+
+		require.NoError(t, err)
+		require.Len(t, hits, 1)
+		assert.Equal(t, expenseID, hits[0].Expense.ID)
+		assert.Equal(t, 1.0, hits[0].Score)
+		assert.Len(t, hits[0].Expense.SplitMembers, 1)
+		require.Len(t, hits[0].Comments, 1)
+		assert.Equal(t, commentID, hits[0].Comments[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("escapes LIKE wildcards", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`ORDER BY hit\.score DESC`).
+			WithArgs(groupID, "100%", `%100\%%`, 20).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		repo := NewExpenseRepository(db)
+		hits, err := repo.SearchExpenses(context.Background(), groupID, "100%", 20)
+
+		assert.NoError(t, err)
+		assert.Empty(t, hits)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`ORDER BY hit\.score DESC`).WillReturnError(errors.New("connection refused"))
+
+		repo := NewExpenseRepository(db)
+		hits, err := repo.SearchExpenses(context.Background(), groupID, "taxi", 20)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to search expenses")
+		assert.Nil(t, hits)
+	})
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+const templateColumns = `
+		SELECT t.id, t.group_id, t.name, t.description, t.amount, t.category, t.kind,
+		       t.paid_by_id, p.name, t.split_mode, t.created_at, t.updated_at
+		FROM expense_templates t
+		JOIN members p ON t.paid_by_id = p.id`
+
+func (r *expenseRepository) CreateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO expense_templates (id, group_id, name, description, amount, category, kind, paid_by_id, split_mode, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err = tx.ExecContext(ctx, query,
+		template.ID,
+		template.GroupID,
+		template.Name,
+		template.Description,
+		template.Amount,
+		template.Category,
+		template.Kind,
+		template.PaidByID,
+		template.SplitMode,
+		template.CreatedAt,
+		template.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert expense template: %w", err)
+	}
+
+	if err := insertTemplateMembers(ctx, tx, template); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UpdateTemplate replaces the fields and split members of a template
+func (r *expenseRepository) UpdateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE expense_templates
+		SET name = $1, description = $2, amount = $3, category = $4, kind = $5, paid_by_id = $6, split_mode = $7, updated_at = $8
+		WHERE id = $9`
+
+	result, err := tx.ExecContext(ctx, query,
+		template.Name,
+		template.Description,
+		template.Amount,
+		template.Category,
+		template.Kind,
+		template.PaidByID,
+		template.SplitMode,
+		template.UpdatedAt,
+		template.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update expense template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrExpenseTemplateNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM expense_template_members WHERE template_id = $1`, template.ID)
+	if err != nil {
+		return fmt.Errorf("failed to delete expense template members: %w", err)
+	}
+
+	if err := insertTemplateMembers(ctx, tx, template); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertTemplateMembers(ctx context.Context, tx *sql.Tx, template *domain.ExpenseTemplate) error {
+	query := `
+		INSERT INTO expense_template_members (template_id, member_id, position, shares)
+		VALUES ($1, $2, $3, $4)`
+
+	for i, member := range template.Members {
+		_, err := tx.ExecContext(ctx, query, template.ID, member.MemberID, i, member.Shares)
+		if err != nil {
+			return fmt.Errorf("failed to insert expense template member: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FindTemplatesByGroupID returns the templates of a group ordered by name
+func (r *expenseRepository) FindTemplatesByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.ExpenseTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, templateColumns+`
+		WHERE t.group_id = $1
+		ORDER BY t.name ASC`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expense templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*domain.ExpenseTemplate
+	byID := make(map[uuid.UUID]*domain.ExpenseTemplate)
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+		byID[template.ID] = template
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return templates, nil
+	}
+
+	memberQuery := `
+		SELECT tm.template_id, tm.member_id, m.name, tm.shares
+		FROM expense_template_members tm
+		JOIN expense_templates t ON tm.template_id = t.id
+		JOIN members m ON tm.member_id = m.id
+		WHERE t.group_id = $1
+		ORDER BY tm.template_id, tm.position`
+
+	memberRows, err := r.db.QueryContext(ctx, memberQuery, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expense template members: %w", err)
+	}
+	defer memberRows.Close()
+
+	for memberRows.Next() {
+		var templateID uuid.UUID
+		var member domain.TemplateMember
+		if err := memberRows.Scan(&templateID, &member.MemberID, &member.MemberName, &member.Shares); err != nil {
+			return nil, fmt.Errorf("failed to scan expense template member: %w", err)
+		}
+		if template, ok := byID[templateID]; ok {
+			template.Members = append(template.Members, member)
+		}
+	}
+
+	return templates, memberRows.Err()
+}
+
+func (r *expenseRepository) FindTemplateByID(ctx context.Context, id uuid.UUID) (*domain.ExpenseTemplate, error) {
+	template, err := scanTemplate(r.db.QueryRowContext(ctx, templateColumns+`
+		WHERE t.id = $1`, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrExpenseTemplateNotFound
+		}
+		return nil, err
+	}
+
+	memberQuery := `
+		SELECT tm.member_id, m.name, tm.shares
+		FROM expense_template_members tm
+		JOIN members m ON tm.member_id = m.id
+		WHERE tm.template_id = $1
+		ORDER BY tm.position`
+
+	rows, err := r.db.QueryContext(ctx, memberQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expense template members: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var member domain.TemplateMember
+		if err := rows.Scan(&member.MemberID, &member.MemberName, &member.Shares); err != nil {
+			return nil, fmt.Errorf("failed to scan expense template member: %w", err)
+		}
+		template.Members = append(template.Members, member)
+	}
+
+	return template, rows.Err()
+}
+
+func (r *expenseRepository) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM expense_templates WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete expense template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrExpenseTemplateNotFound
+	}
+
+	return nil
+}
+
+func scanTemplate(row rowScanner) (*domain.ExpenseTemplate, error) {
+	var template domain.ExpenseTemplate
+	err := row.Scan(
+		&template.ID,
+		&template.GroupID,
+		&template.Name,
+		&template.Description,
+		&template.Amount,
+		&template.Category,
+		&template.Kind,
+		&template.PaidByID,
+		&template.PaidByName,
+		&template.SplitMode,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan expense template: %w", err)
+	}
+	return &template, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+var templateRowColumns = []string{"id", "group_id", "name", "description", "amount", "category", "kind",
+	"paid_by_id", "name", "split_mode", "created_at", "updated_at"}
+
+func TestExpenseRepository_CreateTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	now := time.Now()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	template := &domain.ExpenseTemplate{
+		ID:          uuid.New(),
+		GroupID:     uuid.New(),
+		Name:        "週末の買い出し",
+		Description: "スーパー",
+		Category:    "食費",
+		Kind:        domain.ExpenseKindExpense,
+		PaidByID:    aliceID,
+		SplitMode:   domain.SplitModeShares,
+		Members: []domain.TemplateMember{
+			{MemberID: aliceID, Shares: 2},
+			{MemberID: bobID, Shares: 1},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO expense_templates \(id, group_id, name, description, amount, category, kind, paid_by_id, split_mode, created_at, updated_at\)`).
+		WithArgs(template.ID, template.GroupID, "週末の買い出し", "スーパー", int64(0), "食費", domain.ExpenseKindExpense, aliceID, domain.SplitModeShares, now, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO expense_template_members \(template_id, member_id, position, shares\)`).
+		WithArgs(template.ID, aliceID, 0, int32(2)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO expense_template_members \(template_id, member_id, position, shares\)`).
+		WithArgs(template.ID, bobID, 1, int32(1)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.CreateTemplate(context.Background(), template)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_UpdateTemplate_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	template := &domain.ExpenseTemplate{ID: uuid.New(), Name: "ガソリン", UpdatedAt: time.Now()}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE expense_templates SET name = \$1`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.UpdateTemplate(context.Background(), template)
+
+	assert.ErrorIs(t, err, domain.ErrExpenseTemplateNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindTemplatesByGroupID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	groupID := uuid.New()
+	groceriesID := uuid.New()
+	gasID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM expense_templates t JOIN members p ON t.paid_by_id = p.id WHERE t.group_id = \$1 ORDER BY t.name ASC`).
+		WithArgs(groupID).
+		WillReturnRows(sqlmock.NewRows(templateRowColumns).
+			AddRow(gasID, groupID, "ガソリン", "ガソリン代", int64(5000), "", "expense", bobID, "Bob", "equal", now, now).
+			AddRow(groceriesID, groupID, "週末の買い出し", "スーパー", int64(0), "食費", "expense", aliceID, "Alice", "shares", now, now))
+	mock.ExpectQuery(`SELECT tm.template_id, tm.member_id, m.name, tm.shares FROM expense_template_members tm`).
+		WithArgs(groupID).
+		WillReturnRows(sqlmock.NewRows([]string{"template_id", "member_id", "name", "shares"}).
+			AddRow(groceriesID, aliceID, "Alice", 2).
+			AddRow(groceriesID, bobID, "Bob", 1).
+			AddRow(gasID, bobID, "Bob", 1))
+
+	templates, err := repo.FindTemplatesByGroupID(context.Background(), groupID)
+
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "ガソリン", templates[0].Name)
+	assert.Equal(t, "Bob", templates[0].PaidByName)
+	assert.Equal(t, []domain.TemplateMember{{MemberID: bobID, MemberName: "Bob", Shares: 1}}, templates[0].Members)
+	assert.Equal(t, domain.SplitModeShares, templates[1].SplitMode)
+	assert.Equal(t, []domain.TemplateMember{
+		{MemberID: aliceID, MemberName: "Alice", Shares: 2},
+		{MemberID: bobID, MemberName: "Bob", Shares: 1},
+	}, templates[1].Members)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindTemplateByID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	id := uuid.New()
+	mock.ExpectQuery(`FROM expense_templates t JOIN members p ON t.paid_by_id = p.id WHERE t.id = \$1`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(templateRowColumns))
+
+	template, err := repo.FindTemplateByID(context.Background(), id)
+
+	assert.Nil(t, template)
+	assert.ErrorIs(t, err, domain.ErrExpenseTemplateNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_DeleteTemplate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewExpenseRepository(db)
+
+	id := uuid.New()
+	mock.ExpectExec(`DELETE FROM expense_templates WHERE id = \$1`).
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.DeleteTemplate(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -33,6 +33,8 @@ func TestExpenseRepository_Create(t *testing.T) {
 		GroupID:     groupID,
 		Amount:      3000,
 		Description: "Lunch",
+		Category:    "食費",
+		Kind:        domain.ExpenseKindExpense,
 		Currency:    "JPY",
 		PaidByID:    paidByID,
 		PaidByName:  "Alice",
@@ -48,8 +50,9 @@ func TestExpenseRepository_Create(t *testing.T) {
 				Amount:     1500,
 			},
 		},
-		CreatedAt: now,
-		UpdatedAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		ApprovalStatus: domain.ApprovalStatusApproved,
 	}
 
 	tests := []struct {
@@ -65,8 +68,8 @@ func TestExpenseRepository_Create(t *testing.T) {
 				mock.ExpectBegin()
 
 				// Expect expense insert
-				mock.ExpectExec(`INSERT INTO expenses \(id, group_id, amount, description, currency, paid_by_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`).
-					WithArgs(expenseID, groupID, int64(3000), "Lunch", "JPY", paidByID, now, now).
+				mock.ExpectExec(`INSERT INTO expenses \(id, group_id, amount, description, category, kind, currency, paid_by_id, created_at, updated_at, approval_status\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`).
+					WithArgs(expenseID, groupID, int64(3000), "Lunch", "食費", "expense", "JPY", paidByID, now, now, "approved").
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
 				// Expect split member inserts
@@ -78,6 +81,11 @@ func TestExpenseRepository_Create(t *testing.T) {
 					WithArgs(expenseID, member2ID, int64(1500)).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 
+				// Expect the first revision
+				mock.ExpectExec(`INSERT INTO expense_revisions`).
+					WithArgs(expenseID, int64(3000), "Lunch", "食費", "expense", paidByID, "Alice", sqlmock.AnyArg(), nil, nil, now).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
 				mock.ExpectCommit()
 			},
 			expectedErr: false,
@@ -88,7 +96,7 @@ func TestExpenseRepository_Create(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 				mock.ExpectExec(`INSERT INTO expenses`).
-					WithArgs(expenseID, groupID, int64(3000), "Lunch", "JPY", paidByID, now, now).
+					WithArgs(expenseID, groupID, int64(3000), "Lunch", "食費", "expense", "JPY", paidByID, now, now, "approved").
 					WillReturnError(sql.ErrConnDone)
 				mock.ExpectRollback()
 			},
@@ -140,19 +148,19 @@ func TestExpenseRepository_FindByGroupID(t *testing.T) {
 			setupMocks: func() {
 				// Mock main expense query
 				expenseRows := sqlmock.NewRows([]string{
-					"id", "group_id", "amount", "description", "currency", "paid_by_id", "created_at", "updated_at", "paid_by_name",
-				}).AddRow(expenseID, groupID, int64(3000), "Lunch", "JPY", paidByID, now, now, "Alice")
+					"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name",
+				}).AddRow(expenseID, groupID, int64(3000), "Lunch", "食費", "expense", "JPY", paidByID, now, now, "approved", "Alice")
 
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(groupID).
 					WillReturnRows(expenseRows)
 
 				// Mock split members query
-				splitRows := sqlmock.NewRows([]string{"member_id", "amount", "name"}).
-					AddRow(member1ID, int64(1500), "Alice").
-					AddRow(member2ID, int64(1500), "Bob")
+				splitRows := sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}).
+					AddRow(member1ID, int64(1500), "Alice", "", nil).
+					AddRow(member2ID, int64(1500), "Bob", "", nil)
 
-				mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name FROM expense_splits es JOIN members m`).
+				mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name, es\.vote, es\.voted_at FROM expense_splits es JOIN members m`).
 					WithArgs(expenseID).
 					WillReturnRows(splitRows)
 			},
@@ -164,10 +172,10 @@ func TestExpenseRepository_FindByGroupID(t *testing.T) {
 			groupID: groupID,
 			setupMocks: func() {
 				expenseRows := sqlmock.NewRows([]string{
-					"id", "group_id", "amount", "description", "currency", "paid_by_id", "created_at", "updated_at", "paid_by_name",
+					"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name",
 				})
 
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(groupID).
 					WillReturnRows(expenseRows)
 			},
@@ -178,7 +186,7 @@ func TestExpenseRepository_FindByGroupID(t *testing.T) {
 			name:    "query error",
 			groupID: groupID,
 			setupMocks: func() {
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(groupID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -243,19 +251,19 @@ func TestExpenseRepository_FindByID(t *testing.T) {
 			setupMocks: func() {
 				// Mock main expense query
 				expenseRow := sqlmock.NewRows([]string{
-					"id", "group_id", "amount", "description", "currency", "paid_by_id", "created_at", "updated_at", "paid_by_name",
-				}).AddRow(expenseID, groupID, int64(3000), "Lunch", "JPY", paidByID, now, now, "Alice")
+					"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name",
+				}).AddRow(expenseID, groupID, int64(3000), "Lunch", "食費", "expense", "JPY", paidByID, now, now, "approved", "Alice")
 
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(expenseID).
 					WillReturnRows(expenseRow)
 
 				// Mock split members query
-				splitRows := sqlmock.NewRows([]string{"member_id", "amount", "name"}).
-					AddRow(member1ID, int64(1500), "Alice").
-					AddRow(member2ID, int64(1500), "Bob")
+				splitRows := sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}).
+					AddRow(member1ID, int64(1500), "Alice", "", nil).
+					AddRow(member2ID, int64(1500), "Bob", "", nil)
 
-				mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name FROM expense_splits es JOIN members m`).
+				mock.ExpectQuery(`SELECT es\.member_id, es\.amount, m\.name, es\.vote, es\.voted_at FROM expense_splits es JOIN members m`).
 					WithArgs(expenseID).
 					WillReturnRows(splitRows)
 			},
@@ -273,7 +281,7 @@ func TestExpenseRepository_FindByID(t *testing.T) {
 			name:      "expense not found",
 			expenseID: expenseID,
 			setupMocks: func() {
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(expenseID).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -284,7 +292,7 @@ func TestExpenseRepository_FindByID(t *testing.T) {
 			name:      "query error",
 			expenseID: expenseID,
 			setupMocks: func() {
-				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, m\.name as paid_by_name FROM expenses e JOIN members m`).
+				mock.ExpectQuery(`SELECT e\.id, e\.group_id, e\.amount, e\.description, e\.category, e\.kind, e\.currency, e\.paid_by_id, e\.created_at, e\.updated_at, e\.approval_status, m\.name as paid_by_name FROM expenses e JOIN members m`).
 					WithArgs(expenseID).
 					WillReturnError(sql.ErrConnDone)
 			},
@@ -325,86 +333,53 @@ func TestExpenseRepository_Delete(t *testing.T) {
 
 	repo := NewExpenseRepository(db)
 	expenseID := uuid.New()
+	memberID := uuid.New()
 
 	tests := []struct {
 		name        string
 		expenseID   uuid.UUID
+		deletedBy   *uuid.UUID
 		setupMocks  func()
-		expectedErr bool
+		expectedErr error
 	}{
 		{
-			name:      "successful expense deletion",
+			name:      "moves expense into the trash",
 			expenseID: expenseID,
+			deletedBy: &memberID,
 			setupMocks: func() {
-				mock.ExpectBegin()
-
-				// Expect expense splits deletion
-				mock.ExpectExec(`DELETE FROM expense_splits WHERE expense_id = \$1`).
-					WithArgs(expenseID).
-					WillReturnResult(sqlmock.NewResult(0, 2))
-
-				// Expect expense deletion
-				mock.ExpectExec(`DELETE FROM expenses WHERE id = \$1`).
-					WithArgs(expenseID).
+				mock.ExpectExec(`UPDATE expenses SET deleted_at = NOW\(\), deleted_by = \$2 WHERE id = \$1 AND deleted_at IS NULL`).
+					WithArgs(expenseID, memberID).
 					WillReturnResult(sqlmock.NewResult(0, 1))
-
-				mock.ExpectCommit()
 			},
-			expectedErr: false,
 		},
 		{
-			name:      "expense not found",
+			name:      "no deleting member",
 			expenseID: expenseID,
 			setupMocks: func() {
-				mock.ExpectBegin()
-
-				// Expect expense splits deletion (might return 0 rows)
-				mock.ExpectExec(`DELETE FROM expense_splits WHERE expense_id = \$1`).
-					WithArgs(expenseID).
-					WillReturnResult(sqlmock.NewResult(0, 0))
-
-				// Expect expense deletion with 0 rows affected
-				mock.ExpectExec(`DELETE FROM expenses WHERE id = \$1`).
-					WithArgs(expenseID).
-					WillReturnResult(sqlmock.NewResult(0, 0))
-
-				mock.ExpectRollback()
+				mock.ExpectExec(`UPDATE expenses SET deleted_at = NOW\(\), deleted_by = \$2`).
+					WithArgs(expenseID, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
-			expectedErr: true,
 		},
 		{
-			name:      "splits deletion fails",
+			name:      "expense not found or already deleted",
 			expenseID: expenseID,
 			setupMocks: func() {
-				mock.ExpectBegin()
-
-				mock.ExpectExec(`DELETE FROM expense_splits WHERE expense_id = \$1`).
-					WithArgs(expenseID).
-					WillReturnError(sql.ErrConnDone)
-
-				mock.ExpectRollback()
+				mock.ExpectExec(`UPDATE expenses SET deleted_at = NOW\(\), deleted_by = \$2`).
+					WithArgs(expenseID, nil).
+					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
-			expectedErr: true,
+			expectedErr: domain.ErrExpenseNotFound,
 		},
 		{
-			name:      "expense deletion fails",
+			name:      "database error",
 			expenseID: expenseID,
 			setupMocks: func() {
-				mock.ExpectBegin()
-
-				// Expect expense splits deletion succeeds
-				mock.ExpectExec(`DELETE FROM expense_splits WHERE expense_id = \$1`).
-					WithArgs(expenseID).
-					WillReturnResult(sqlmock.NewResult(0, 2))
-
-				// Expect expense deletion fails
-				mock.ExpectExec(`DELETE FROM expenses WHERE id = \$1`).
-					WithArgs(expenseID).
+				mock.ExpectExec(`UPDATE expenses SET deleted_at = NOW\(\), deleted_by = \$2`).
+					WithArgs(expenseID, nil).
 					WillReturnError(sql.ErrConnDone)
-
-				mock.ExpectRollback()
 			},
-			expectedErr: true,
+			expectedErr: sql.ErrConnDone,
 		},
 	}
 
@@ -412,10 +387,10 @@ func TestExpenseRepository_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks()
 
-			err := repo.Delete(context.Background(), tt.expenseID)
+			err := repo.Delete(context.Background(), tt.expenseID, tt.deletedBy)
 
-			if tt.expectedErr {
-				assert.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -437,13 +412,17 @@ func TestExpenseRepository_Update(t *testing.T) {
 	paidByID := uuid.New()
 	member1ID := uuid.New()
 	member2ID := uuid.New()
+	changedByID := uuid.New()
 	now := time.Now()
 
+	change := domain.ExpenseChange{ChangedByID: &changedByID, RevertedFrom: 2}
+
 	expense := &domain.Expense{
 		ID:          expenseID,
 		GroupID:     groupID,
 		Amount:      4000, // Updated amount
 		Description: "Updated Lunch", // Updated description
+		Kind:        domain.ExpenseKindIncome,
 		Currency:    "JPY",
 		PaidByID:    paidByID,
 		PaidByName:  "Alice",
@@ -459,8 +438,9 @@ func TestExpenseRepository_Update(t *testing.T) {
 				Amount:     2000, // Updated split
 			},
 		},
-		CreatedAt: now,
-		UpdatedAt: now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		ApprovalStatus: domain.ApprovalStatusPending,
 	}
 
 	tests := []struct {
@@ -475,9 +455,14 @@ func TestExpenseRepository_Update(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 
+				// Expect baseline revision for expenses without history
+				mock.ExpectExec(`INSERT INTO expense_revisions .* SELECT e\.id, 1`).
+					WithArgs(expenseID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
 				// Expect expense update
-				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5 WHERE id = \$1`).
-					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now).
+				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5, category = \$6, kind = \$7, approval_status = \$8 WHERE id = \$1`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now, "", "income", "pending").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				// Expect deletion of existing splits
@@ -494,6 +479,11 @@ func TestExpenseRepository_Update(t *testing.T) {
 					WithArgs(expenseID, member2ID, int64(2000)).
 					WillReturnResult(sqlmock.NewResult(2, 1))
 
+				// Expect the new revision
+				mock.ExpectExec(`INSERT INTO expense_revisions \(expense_id, revision, .*\) VALUES \(\$1, \(SELECT COALESCE\(MAX\(revision\), 0\) \+ 1 FROM expense_revisions WHERE expense_id = \$1\)`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", "", "income", paidByID, "Alice", sqlmock.AnyArg(), &changedByID, int64(2), now).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+
 				mock.ExpectCommit()
 			},
 			expectedErr: false,
@@ -504,9 +494,13 @@ func TestExpenseRepository_Update(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 
+				mock.ExpectExec(`INSERT INTO expense_revisions .* SELECT e\.id, 1`).
+					WithArgs(expenseID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
 				// Expect expense update with 0 rows affected
-				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5 WHERE id = \$1`).
-					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now).
+				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5, category = \$6, kind = \$7, approval_status = \$8 WHERE id = \$1`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now, "", "income", "pending").
 					WillReturnResult(sqlmock.NewResult(0, 0))
 
 				mock.ExpectRollback()
@@ -519,8 +513,12 @@ func TestExpenseRepository_Update(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 
-				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5 WHERE id = \$1`).
-					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now).
+				mock.ExpectExec(`INSERT INTO expense_revisions .* SELECT e\.id, 1`).
+					WithArgs(expenseID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
+				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5, category = \$6, kind = \$7, approval_status = \$8 WHERE id = \$1`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now, "", "income", "pending").
 					WillReturnError(sql.ErrConnDone)
 
 				mock.ExpectRollback()
@@ -533,9 +531,13 @@ func TestExpenseRepository_Update(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 
+				mock.ExpectExec(`INSERT INTO expense_revisions .* SELECT e\.id, 1`).
+					WithArgs(expenseID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
 				// Expect expense update succeeds
-				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5 WHERE id = \$1`).
-					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now).
+				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5, category = \$6, kind = \$7, approval_status = \$8 WHERE id = \$1`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now, "", "income", "pending").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				// Expect deletion of existing splits fails
@@ -553,9 +555,13 @@ func TestExpenseRepository_Update(t *testing.T) {
 			setupMocks: func() {
 				mock.ExpectBegin()
 
+				mock.ExpectExec(`INSERT INTO expense_revisions .* SELECT e\.id, 1`).
+					WithArgs(expenseID).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+
 				// Expect expense update succeeds
-				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5 WHERE id = \$1`).
-					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now).
+				mock.ExpectExec(`UPDATE expenses SET amount = \$2, description = \$3, paid_by_id = \$4, updated_at = \$5, category = \$6, kind = \$7, approval_status = \$8 WHERE id = \$1`).
+					WithArgs(expenseID, int64(4000), "Updated Lunch", paidByID, now, "", "income", "pending").
 					WillReturnResult(sqlmock.NewResult(0, 1))
 
 				// Expect deletion of existing splits succeeds
@@ -578,7 +584,7 @@ func TestExpenseRepository_Update(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks()
 
-			err := repo.Update(context.Background(), tt.expense)
+			err := repo.Update(context.Background(), tt.expense, change)
 
 			if tt.expectedErr {
 				assert.Error(t, err)
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// FindDeletedByGroupID lists the expenses of a group that are in the trash,
+// most recently deleted first
+func (r *expenseRepository) FindDeletedByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error) {
+	query := `
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id,
+		       e.created_at, e.updated_at, e.approval_status,
+		       m.name as paid_by_name,
+		       e.deleted_at, e.deleted_by, d.name as deleted_by_name
+		FROM expenses e
+		JOIN members m ON e.paid_by_id = m.id
+		LEFT JOIN members d ON e.deleted_by = d.id
+		WHERE e.group_id = $1 AND e.deleted_at IS NOT NULL
+		ORDER BY e.deleted_at DESC, e.id DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query deleted expenses: %w", err)
+	}
+	defer rows.Close()
+
+	var expenses []*domain.Expense
+	for rows.Next() {
+		var expense domain.Expense
+		var deletedAt time.Time
+		var deletedBy uuid.NullUUID
+		var deletedByName sql.NullString
+		err := rows.Scan(
+			&expense.ID,
+			&expense.GroupID,
+			&expense.Amount,
+			&expense.Description,
+			&expense.Category,
+			&expense.Kind,
+			&expense.Currency,
+			&expense.PaidByID,
+			&expense.CreatedAt,
+			&expense.UpdatedAt,
+			&expense.ApprovalStatus,
+			&expense.PaidByName,
+			&deletedAt,
+			&deletedBy,
+			&deletedByName,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan deleted expense: %w", err)
+		}
+
+		expense.DeletedAt = &deletedAt
+		if deletedBy.Valid {
+			expense.DeletedByID = &deletedBy.UUID
+		}
+		expense.DeletedByName = deletedByName.String
+
+		// Load split members
+		splits, err := r.findSplitMembers(ctx, expense.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load split members for expense %s: %w", expense.ID, err)
+		}
+		expense.SplitMembers = splits
+
+		expenses = append(expenses, &expense)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error during row iteration: %w", err)
+	}
+
+	return expenses, nil
+}
+
+// FindDeletedByID returns an expense that is in the trash
+func (r *expenseRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
+	query := `
+		SELECT e.id, e.group_id, e.amount, e.description, e.category, e.kind, e.currency, e.paid_by_id,
+		       e.created_at, e.updated_at, e.approval_status,
+		       m.name as paid_by_name
+		FROM expenses e
+		JOIN members m ON e.paid_by_id = m.id
+		WHERE e.id = $1 AND e.deleted_at IS NOT NULL`
+
+	expenses, err := r.queryExpenses(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expenses) == 0 {
+		return nil, domain.ErrExpenseNotFound
+	}
+
+	return expenses[0], nil
+}
+
+// Restore takes an expense back out of the trash
+func (r *expenseRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE expenses
+		SET deleted_at = NULL, deleted_by = NULL
+		WHERE id = $1 AND deleted_at IS NOT NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore expense: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrExpenseNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeleted permanently removes expenses deleted before the given time,
+// along with their splits, comments and attachment rows. It returns the
+// number of purged expenses and the storage keys of their attachments so the
+// caller can remove the files.
+func (r *expenseRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, []string, error) {
+	// One statement, so an expense restored meanwhile is either purged with
+	// its keys or kept with its files. The attachment rows are read from the
+	// statement's snapshot, before the cascade removes them.
+	query := `
+		WITH purged AS (
+			DELETE FROM expenses WHERE deleted_at < $1
+			RETURNING id
+		)
+		SELECT p.id, a.storage_key
+		FROM purged p
+		LEFT JOIN expense_attachments a ON a.expense_id = p.id`
+
+	rows, err := r.db.QueryContext(ctx, query, before)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to purge expenses: %w", err)
+	}
+	defer rows.Close()
+
+	purged := make(map[uuid.UUID]bool)
+	var storageKeys []string
+	for rows.Next() {
+		var id uuid.UUID
+		var key sql.NullString
+		if err := rows.Scan(&id, &key); err != nil {
+			return 0, nil, fmt.Errorf("failed to scan purged expense: %w", err)
+		}
+		purged[id] = true
+		if key.Valid {
+			storageKeys = append(storageKeys, key.String)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("failed to purge expenses: %w", err)
+	}
+
+	return int64(len(purged)), storageKeys, nil
+}
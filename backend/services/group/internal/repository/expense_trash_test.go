@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestExpenseRepository_FindDeletedByGroupID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	groupID := uuid.New()
+	paidByID := uuid.New()
+	deletedByID := uuid.New()
+	firstID := uuid.New()
+	secondID := uuid.New()
+	now := time.Now()
+
+	columns := []string{"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name", "deleted_at", "deleted_by", "deleted_by_name"}
+	mock.ExpectQuery(`LEFT JOIN members d ON e\.deleted_by = d\.id WHERE e\.group_id = \$1 AND e\.deleted_at IS NOT NULL ORDER BY e\.deleted_at DESC`).
+		WithArgs(groupID).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(firstID, groupID, int64(3000), "Lunch", "", "expense", "JPY", paidByID, now, now, "approved", "Alice", now, deletedByID, "Bob").
+			AddRow(secondID, groupID, int64(1200), "Coffee", "", "expense", "JPY", paidByID, now, now, "approved", "Alice", now.Add(-time.Hour), nil, nil))
+	mock.ExpectQuery(`FROM expense_splits es`).WithArgs(firstID).WillReturnRows(sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}))
+	mock.ExpectQuery(`FROM expense_splits es`).WithArgs(secondID).WillReturnRows(sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}))
+
+	repo := NewExpenseRepository(db)
+	expenses, err := repo.FindDeletedByGroupID(context.Background(), groupID)
+
+	require.NoError(t, err)
+	require.Len(t, expenses, 2)
+	require.NotNil(t, expenses[0].DeletedAt)
+	assert.Equal(t, &deletedByID, expenses[0].DeletedByID)
+	assert.Equal(t, "Bob", expenses[0].DeletedByName)
+	assert.Nil(t, expenses[1].DeletedByID)
+	assert.Empty(t, expenses[1].DeletedByName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_FindDeletedByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	expenseID := uuid.New()
+	groupID := uuid.New()
+	paidByID := uuid.New()
+	now := time.Now()
+
+	columns := []string{"id", "group_id", "amount", "description", "category", "kind", "currency", "paid_by_id", "created_at", "updated_at", "approval_status", "paid_by_name"}
+	mock.ExpectQuery(`WHERE e\.id = \$1 AND e\.deleted_at IS NOT NULL`).
+		WithArgs(expenseID).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(expenseID, groupID, int64(3000), "Lunch", "", "expense", "JPY", paidByID, now, now, "approved", "Alice"))
+	mock.ExpectQuery(`FROM expense_splits es`).WithArgs(expenseID).WillReturnRows(sqlmock.NewRows([]string{"member_id", "amount", "name", "vote", "voted_at"}))
+
+	// An expense that is not in the trash is not found
+	mock.ExpectQuery(`WHERE e\.id = \$1 AND e\.deleted_at IS NOT NULL`).
+		WithArgs(expenseID).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	repo := NewExpenseRepository(db)
+	expense, err := repo.FindDeletedByID(context.Background(), expenseID)
+	require.NoError(t, err)
+	assert.Equal(t, groupID, expense.GroupID)
+	assert.Equal(t, "Lunch", expense.Description)
+
+	_, err = repo.FindDeletedByID(context.Background(), expenseID)
+	assert.ErrorIs(t, err, domain.ErrExpenseNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExpenseRepository_Restore(t *testing.T) {
+	expenseID := uuid.New()
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		expectedErr  error
+	}{
+		{name: "restores deleted expense", rowsAffected: 1},
+		{name: "expense not in the trash", rowsAffected: 0, expectedErr: domain.ErrExpenseNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(`UPDATE expenses SET deleted_at = NULL, deleted_by = NULL WHERE id = \$1 AND deleted_at IS NOT NULL`).
+				WithArgs(expenseID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			repo := NewExpenseRepository(db)
+			err = repo.Restore(context.Background(), expenseID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestExpenseRepository_PurgeDeleted(t *testing.T) {
+	before := time.Now().AddDate(0, 0, -30)
+
+	t.Run("purges expenses and returns attachment keys", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		withFiles := uuid.New()
+		withoutFiles := uuid.New()
+		mock.ExpectQuery(`WITH purged AS \( DELETE FROM expenses WHERE deleted_at < \$1 RETURNING id \) SELECT p\.id, a\.storage_key FROM purged p LEFT JOIN expense_attachments a ON a\.expense_id = p\.id`).
+			WithArgs(before).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "storage_key"}).
+				AddRow(withFiles, "receipts/a.jpg").
+				AddRow(withFiles, "receipts/b.pdf").
+				AddRow(withoutFiles, nil))
+
+		repo := NewExpenseRepository(db)
+		purged, keys, err := repo.PurgeDeleted(context.Background(), before)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		assert.Equal(t, []string{"receipts/a.jpg", "receipts/b.pdf"}, keys)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete fails", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`WITH purged AS \( DELETE FROM expenses`).
+			WithArgs(before).
+			WillReturnError(sql.ErrConnDone)
+
+		repo := NewExpenseRepository(db)
+		purged, keys, err := repo.PurgeDeleted(context.Background(), before)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Zero(t, purged)
+		assert.Nil(t, keys)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
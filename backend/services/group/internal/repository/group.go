@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
 )
 
 type GroupRepository struct {
@@ -89,7 +91,7 @@ func (r *GroupRepository) GetGroupByID(groupID string) (*groupv1.Group, error) {
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("group not found")
+			return nil, domain.ErrGroupNotFound
 		}
 		return nil, err
 	}
@@ -99,8 +101,8 @@ func (r *GroupRepository) GetGroupByID(groupID string) (*groupv1.Group, error) {
 
 	// Get members
 	rows, err := r.db.Query(`
-		SELECT id, name, joined_at
-		FROM members WHERE group_id = $1
+		SELECT id, name, email, joined_at
+		FROM members WHERE group_id = $1 AND archived_at IS NULL
 		ORDER BY joined_at ASC
 	`, groupID)
 	if err != nil {
@@ -111,13 +113,15 @@ func (r *GroupRepository) GetGroupByID(groupID string) (*groupv1.Group, error) {
 	var members []*groupv1.Member
 	for rows.Next() {
 		var member groupv1.Member
+		var email sql.NullString
 		var joinedAt time.Time
 
-		err := rows.Scan(&member.Id, &member.Name, &joinedAt)
+		err := rows.Scan(&member.Id, &member.Name, &email, &joinedAt)
 		if err != nil {
 			return nil, err
 		}
 
+		member.Email = email.String
 		member.JoinedAt = timestamppb.New(joinedAt)
 
 		members = append(members, &member)
@@ -146,14 +150,14 @@ func (r *GroupRepository) DeleteGroup(groupID string) error {
 	return err
 }
 
-func (r *GroupRepository) AddMember(groupID, memberName string) (*groupv1.Member, error) {
+func (r *GroupRepository) AddMember(groupID, memberName, memberEmail string) (*groupv1.Member, error) {
 	memberID := uuid.New().String()
 	now := time.Now()
 
 	_, err := r.db.Exec(`
-		INSERT INTO members (id, group_id, name, joined_at)
-		VALUES ($1, $2, $3, $4)
-	`, memberID, groupID, memberName, now)
+		INSERT INTO members (id, group_id, name, email, joined_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), $5)
+	`, memberID, groupID, memberName, memberEmail, now)
 	if err != nil {
 		return nil, err
 	}
@@ -161,14 +165,116 @@ func (r *GroupRepository) AddMember(groupID, memberName string) (*groupv1.Member
 	return &groupv1.Member{
 		Id:       memberID,
 		Name:     memberName,
+		Email:    memberEmail,
 		JoinedAt: timestamppb.New(now),
 	}, nil
 }
 
-func (r *GroupRepository) RemoveMember(groupID, memberID string) error {
-	_, err := r.db.Exec(`
-		DELETE FROM members 
+// RemoveMember hands a member's balance over and archives the member, all or
+// nothing. The row is kept because the member's expenses and shares still
+// count towards everyone else's balances.
+//
+// The member's expenses are locked and must be as the balance was
+// calculated from; locking the member row holds back new expenses naming the
+// member until the removal is done.
+func (r *GroupRepository) RemoveMember(removal *domain.MemberRemoval) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var locked string
+	err = tx.QueryRow(`
+		SELECT id FROM members
+		WHERE id = $1 AND group_id = $2 AND archived_at IS NULL
+		FOR UPDATE
+	`, removal.MemberID, removal.GroupID).Scan(&locked)
+	if err == sql.ErrNoRows {
+		return errors.New("member not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := checkExpenseVersions(tx, removal); err != nil {
+		return err
+	}
+
+	if err := insertExpenses(context.Background(), tx, removal.Transfers); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE members SET archived_at = NOW()
 		WHERE id = $1 AND group_id = $2
-	`, memberID, groupID)
-	return err
+	`, removal.MemberID, removal.GroupID)
+	if err != nil {
+		return err
+	}
+
+	// What the deletion used to clear along with the member
+	_, err = tx.Exec(`
+		UPDATE group_settings SET admin_member_id = NULL
+		WHERE group_id = $1 AND admin_member_id = $2
+	`, removal.GroupID, removal.MemberID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM member_bank_accounts WHERE member_id = $1`, removal.MemberID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM household_members WHERE member_id = $1`, removal.MemberID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkExpenseVersions locks the expenses a member of a removal paid or shares
+// and compares them with the ones the balance was calculated from
+func checkExpenseVersions(tx *sql.Tx, removal *domain.MemberRemoval) error {
+	rows, err := tx.Query(`
+		SELECT e.id, e.updated_at, e.approval_status
+		FROM expenses e
+		WHERE e.group_id = $1 AND e.deleted_at IS NULL
+		  AND (e.paid_by_id = $2 OR EXISTS (
+		      SELECT 1 FROM expense_splits es WHERE es.expense_id = e.id AND es.member_id = $2
+		  ))
+		FOR UPDATE
+	`, removal.GroupID, removal.MemberID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	expected := make(map[uuid.UUID]domain.ExpenseVersion, len(removal.Expenses))
+	for _, version := range removal.Expenses {
+		expected[version.ExpenseID] = version
+	}
+
+	var count int
+	for rows.Next() {
+		var current domain.ExpenseVersion
+		if err := rows.Scan(&current.ExpenseID, &current.UpdatedAt, &current.ApprovalStatus); err != nil {
+			return err
+		}
+		version, ok := expected[current.ExpenseID]
+		if !ok || !version.UpdatedAt.Equal(current.UpdatedAt) || version.ApprovalStatus != current.ApprovalStatus {
+			return domain.ErrMemberBalanceChanged
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if count != len(expected) {
+		return domain.ErrMemberBalanceChanged
+	}
+	return nil
 }
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"database/sql"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+// GetGroupSettings returns the settings of a group, or the defaults when
+// the group never changed them
+func (r *GroupRepository) GetGroupSettings(groupID string) (*groupv1.GroupSettings, error) {
+	var settings groupv1.GroupSettings
+	var adminMemberID sql.NullString
+	err := r.db.QueryRow(`
+		SELECT require_expense_approval, admin_member_id
+		FROM group_settings WHERE group_id = $1
+	`, groupID).Scan(&settings.RequireExpenseApproval, &adminMemberID)
+	if err == sql.ErrNoRows {
+		return &groupv1.GroupSettings{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	settings.AdminMemberId = adminMemberID.String
+
+	return &settings, nil
+}
+
+func (r *GroupRepository) SaveGroupSettings(groupID string, settings *groupv1.GroupSettings) error {
+	var adminMemberID sql.NullString
+	if settings.AdminMemberId != "" {
+		adminMemberID = sql.NullString{String: settings.AdminMemberId, Valid: true}
+	}
+
+	_, err := r.db.Exec(`
+		INSERT INTO group_settings (group_id, require_expense_approval, admin_member_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (group_id) DO UPDATE SET
+			require_expense_approval = EXCLUDED.require_expense_approval,
+			admin_member_id = EXCLUDED.admin_member_id
+	`, groupID, settings.RequireExpenseApproval, adminMemberID)
+	return err
+}
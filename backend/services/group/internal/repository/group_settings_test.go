@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+)
+
+func TestGroupRepository_GetGroupSettings(t *testing.T) {
+	groupID := uuid.New().String()
+	adminID := uuid.New().String()
+
+	t.Run("stored settings", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`SELECT require_expense_approval, admin_member_id FROM group_settings WHERE group_id = \$1`).
+			WithArgs(groupID).
+			WillReturnRows(sqlmock.NewRows([]string{"require_expense_approval", "admin_member_id"}).AddRow(true, adminID))
+
+		settings, err := NewGroupRepository(db).GetGroupSettings(groupID)
+
+		require.NoError(t, err)
+		assert.True(t, settings.RequireExpenseApproval)
+		assert.Equal(t, adminID, settings.AdminMemberId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("defaults without a row", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM group_settings WHERE group_id`).
+			WithArgs(groupID).
+			WillReturnRows(sqlmock.NewRows([]string{"require_expense_approval", "admin_member_id"}))
+
+		settings, err := NewGroupRepository(db).GetGroupSettings(groupID)
+
+		require.NoError(t, err)
+		assert.False(t, settings.RequireExpenseApproval)
+		assert.Empty(t, settings.AdminMemberId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGroupRepository_SaveGroupSettings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	groupID := uuid.New().String()
+
+	mock.ExpectExec(`INSERT INTO group_settings \(group_id, require_expense_approval, admin_member_id\) VALUES \(\$1, \$2, \$3\) ON CONFLICT \(group_id\) DO UPDATE`).
+		WithArgs(groupID, true, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewGroupRepository(db).SaveGroupSettings(groupID, &groupv1.GroupSettings{RequireExpenseApproval: true})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
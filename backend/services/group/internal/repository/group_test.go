@@ -9,6 +9,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
 )
 
 func TestGroupRepository_CreateGroup(t *testing.T) {
@@ -132,7 +134,7 @@ func TestGroupRepository_GetGroupByID(t *testing.T) {
 		AddRow(uuid.New().String(), "Alice", sql.NullString{String: "alice@example.com", Valid: true}, createdAt).
 		AddRow(uuid.New().String(), "Bob", sql.NullString{Valid: false}, createdAt)
 
-	mock.ExpectQuery(`SELECT id, name, email, joined_at FROM members WHERE group_id = \$1 ORDER BY joined_at ASC`).
+	mock.ExpectQuery(`SELECT id, name, email, joined_at FROM members WHERE group_id = \$1 AND archived_at IS NULL ORDER BY joined_at ASC`).
 		WithArgs(groupID).
 		WillReturnRows(memberRows)
 
@@ -183,7 +185,7 @@ func TestGroupRepository_UpdateGroup(t *testing.T) {
 
 	memberRows := sqlmock.NewRows([]string{"id", "name", "email", "joined_at"})
 
-	mock.ExpectQuery(`SELECT id, name, email, joined_at FROM members WHERE group_id = \$1 ORDER BY joined_at ASC`).
+	mock.ExpectQuery(`SELECT id, name, email, joined_at FROM members WHERE group_id = \$1 AND archived_at IS NULL ORDER BY joined_at ASC`).
 		WithArgs(groupID).
 		WillReturnRows(memberRows)
 
@@ -257,27 +259,130 @@ func TestGroupRepository_AddMember(t *testing.T) {
 }
 
 func TestGroupRepository_RemoveMember(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	require.NoError(t, err)
-	defer db.Close()
-
-	repo := NewGroupRepository(db)
-
-	// Test data
-	groupID := uuid.New().String()
-	memberID := uuid.New().String()
-
-	// Mock member deletion
-	mock.ExpectExec(`DELETE FROM members WHERE id = \$1 AND group_id = \$2`).
-		WithArgs(memberID, groupID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-
-	// Execute
-	err = repo.RemoveMember(groupID, memberID)
-
-	// Assertions
-	require.NoError(t, err)
+	groupID := uuid.New()
+	memberID := uuid.New()
+	otherID := uuid.New()
+	updatedAt := time.Now()
 
-	// Verify all expectations were met
-	assert.NoError(t, mock.ExpectationsWereMet())
+	dinner := domain.ExpenseVersion{ExpenseID: uuid.New(), UpdatedAt: updatedAt, ApprovalStatus: domain.ApprovalStatusApproved}
+	transfer := &domain.Expense{
+		ID: uuid.New(), GroupID: groupID, Amount: 3000, Description: "残高の引き継ぎ", Kind: domain.ExpenseKindPayment,
+		Currency: "JPY", PaidByID: otherID, CreatedAt: updatedAt, UpdatedAt: updatedAt, ApprovalStatus: domain.ApprovalStatusApproved,
+		SplitMembers: []domain.SplitMember{{MemberID: memberID, Amount: 3000}},
+	}
+	removal := &domain.MemberRemoval{
+		GroupID:   groupID.String(),
+		MemberID:  memberID.String(),
+		Expenses:  []domain.ExpenseVersion{dinner},
+		Transfers: []*domain.Expense{transfer},
+	}
+
+	expectLockedExpenses := func(mock sqlmock.Sqlmock, versions ...domain.ExpenseVersion) {
+		mock.ExpectQuery(`SELECT id FROM members WHERE id = \$1 AND group_id = \$2 AND archived_at IS NULL FOR UPDATE`).
+			WithArgs(memberID.String(), groupID.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(memberID.String()))
+		rows := sqlmock.NewRows([]string{"id", "updated_at", "approval_status"})
+		for _, version := range versions {
+			rows.AddRow(version.ExpenseID, version.UpdatedAt, string(version.ApprovalStatus))
+		}
+		mock.ExpectQuery(`SELECT e.id, e.updated_at, e.approval_status FROM expenses e .* FOR UPDATE`).
+			WithArgs(groupID.String(), memberID.String()).
+			WillReturnRows(rows)
+	}
+
+	t.Run("transfers and archives in one transaction", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockedExpenses(mock, dinner)
+		mock.ExpectExec(`INSERT INTO expenses`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO expense_splits`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO expense_revisions`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE members SET archived_at = NOW\(\) WHERE id = \$1 AND group_id = \$2`).
+			WithArgs(memberID.String(), groupID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE group_settings SET admin_member_id = NULL`).
+			WithArgs(groupID.String(), memberID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM member_bank_accounts WHERE member_id = \$1`).
+			WithArgs(memberID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM household_members WHERE member_id = \$1`).
+			WithArgs(memberID.String()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = NewGroupRepository(db).RemoveMember(removal)
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("member not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		// An archived member or a member of another group is not found
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id FROM members`).
+			WithArgs(memberID.String(), groupID.String()).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err = NewGroupRepository(db).RemoveMember(removal)
+
+		assert.EqualError(t, err, "member not found")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expense edited since the balance was calculated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		edited := dinner
+		edited.UpdatedAt = updatedAt.Add(time.Minute)
+		mock.ExpectBegin()
+		expectLockedExpenses(mock, edited)
+		mock.ExpectRollback()
+
+		err = NewGroupRepository(db).RemoveMember(removal)
+
+		assert.ErrorIs(t, err, domain.ErrMemberBalanceChanged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expense added since the balance was calculated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		added := domain.ExpenseVersion{ExpenseID: uuid.New(), UpdatedAt: updatedAt, ApprovalStatus: domain.ApprovalStatusApproved}
+		mock.ExpectBegin()
+		expectLockedExpenses(mock, dinner, added)
+		mock.ExpectRollback()
+
+		err = NewGroupRepository(db).RemoveMember(removal)
+
+		assert.ErrorIs(t, err, domain.ErrMemberBalanceChanged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expense deleted since the balance was calculated", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectLockedExpenses(mock)
+		mock.ExpectRollback()
+
+		err = NewGroupRepository(db).RemoveMember(removal)
+
+		assert.ErrorIs(t, err, domain.ErrMemberBalanceChanged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// GetHouseholds returns the households of a group by name, each with its
+// members in the order they joined
+func (r *GroupRepository) GetHouseholds(groupID string) ([]*domain.Household, error) {
+	rows, err := r.db.Query(`
+		SELECT h.id, h.name, hm.member_id
+		FROM households h
+		LEFT JOIN household_members hm ON hm.household_id = h.id
+		LEFT JOIN members m ON m.id = hm.member_id
+		WHERE h.group_id = $1
+		ORDER BY h.name ASC, h.id, m.joined_at ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var households []*domain.Household
+	for rows.Next() {
+		var householdID, name string
+		var memberID sql.NullString
+		if err := rows.Scan(&householdID, &name, &memberID); err != nil {
+			return nil, err
+		}
+
+		if len(households) == 0 || households[len(households)-1].ID != householdID {
+			households = append(households, &domain.Household{ID: householdID, GroupID: groupID, Name: name})
+		}
+		if memberID.Valid {
+			household := households[len(households)-1]
+			household.MemberIDs = append(household.MemberIDs, memberID.String)
+		}
+	}
+
+	return households, rows.Err()
+}
+
+func (r *GroupRepository) ReplaceHouseholds(groupID string, households []*domain.Household) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Cascades to the household members
+	_, err = tx.Exec(`DELETE FROM households WHERE group_id = $1`, groupID)
+	if err != nil {
+		return err
+	}
+
+	for _, household := range households {
+		_, err = tx.Exec(`
+			INSERT INTO households (id, group_id, name)
+			VALUES ($1, $2, $3)
+		`, household.ID, groupID, household.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, memberID := range household.MemberIDs {
+			_, err = tx.Exec(`
+				INSERT INTO household_members (household_id, member_id)
+				VALUES ($1, $2)
+			`, household.ID, memberID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupRepository_GetHouseholds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	suzukiID := uuid.New().String()
+	tanakaID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "member_id"}).
+		AddRow(suzukiID, "鈴木家", nil).
+		AddRow(tanakaID, "田中家", aliceID).
+		AddRow(tanakaID, "田中家", bobID)
+	mock.ExpectQuery(`SELECT h.id, h.name, hm.member_id FROM households h`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	households, err := repo.GetHouseholds(groupID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.Household{
+		{ID: suzukiID, GroupID: groupID, Name: "鈴木家"},
+		{ID: tanakaID, GroupID: groupID, Name: "田中家", MemberIDs: []string{aliceID, bobID}},
+	}, households)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_ReplaceHouseholds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	household := &domain.Household{
+		ID:        uuid.New().String(),
+		Name:      "田中家",
+		MemberIDs: []string{uuid.New().String(), uuid.New().String()},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM households WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO households`).
+		WithArgs(household.ID, groupID, "田中家").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, memberID := range household.MemberIDs {
+		mock.ExpectExec(`INSERT INTO household_members`).
+			WithArgs(household.ID, memberID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	err = repo.ReplaceHouseholds(groupID, []*domain.Household{household})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
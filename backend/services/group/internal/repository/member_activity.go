@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// GetMemberActivePeriods returns the active period of every member of a
+// group, in the order they joined
+func (r *GroupRepository) GetMemberActivePeriods(groupID string) ([]*domain.MemberActivePeriod, error) {
+	rows, err := r.db.Query(`
+		SELECT id, active_from, active_until
+		FROM members WHERE group_id = $1 AND archived_at IS NULL
+		ORDER BY joined_at ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var periods []*domain.MemberActivePeriod
+	for rows.Next() {
+		var period domain.MemberActivePeriod
+		var activeFrom, activeUntil sql.NullTime
+		if err := rows.Scan(&period.MemberID, &activeFrom, &activeUntil); err != nil {
+			return nil, err
+		}
+		if activeFrom.Valid {
+			period.ActiveFrom = &activeFrom.Time
+		}
+		if activeUntil.Valid {
+			period.ActiveUntil = &activeUntil.Time
+		}
+		periods = append(periods, &period)
+	}
+
+	return periods, rows.Err()
+}
+
+func (r *GroupRepository) SetMemberActivePeriod(groupID string, period *domain.MemberActivePeriod) error {
+	result, err := r.db.Exec(`
+		UPDATE members SET active_from = $3, active_until = $4
+		WHERE id = $1 AND group_id = $2 AND archived_at IS NULL
+	`, period.MemberID, groupID, period.ActiveFrom, period.ActiveUntil)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("member not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupRepository_GetMemberActivePeriods(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	from := time.Date(2024, 8, 11, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"id", "active_from", "active_until"}).
+		AddRow(aliceID, nil, nil).
+		AddRow(bobID, from, nil)
+	mock.ExpectQuery(`SELECT id, active_from, active_until FROM members WHERE group_id = \$1`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	periods, err := repo.GetMemberActivePeriods(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, periods, 2)
+	assert.Equal(t, aliceID, periods[0].MemberID)
+	assert.Nil(t, periods[0].ActiveFrom)
+	assert.Nil(t, periods[0].ActiveUntil)
+	require.NotNil(t, periods[1].ActiveFrom)
+	assert.True(t, from.Equal(*periods[1].ActiveFrom))
+	assert.Nil(t, periods[1].ActiveUntil)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_SetMemberActivePeriod(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	until := time.Date(2024, 8, 12, 23, 59, 59, 0, time.UTC)
+	period := &domain.MemberActivePeriod{MemberID: uuid.New().String(), ActiveUntil: &until}
+
+	mock.ExpectExec(`UPDATE members SET active_from = \$3, active_until = \$4`).
+		WithArgs(period.MemberID, groupID, nil, until).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.SetMemberActivePeriod(groupID, period))
+
+	// A member of another group is not found
+	mock.ExpectExec(`UPDATE members SET active_from = \$3, active_until = \$4`).
+		WithArgs(period.MemberID, groupID, nil, until).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.EqualError(t, repo.SetMemberActivePeriod(groupID, period), "member not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
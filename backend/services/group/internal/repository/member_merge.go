@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// MergeMembers folds the merged member into the kept one, all or nothing.
+// Shares of expenses both members are split on are replaced by the ones
+// combine works out from the locked expenses; everything else pointing at the
+// merged member is moved over, and the merged member is archived so its name
+// stays in the audit trail. Locking both member rows holds back new expenses
+// and shares naming them until the merge is done.
+func (r *GroupRepository) MergeMembers(merge *domain.MemberMerge, combine func(expense *domain.Expense) (domain.CombinedSplit, bool)) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`
+		SELECT id FROM members
+		WHERE group_id = $1 AND id IN ($2, $3) AND archived_at IS NULL
+		FOR UPDATE
+	`, merge.GroupID, merge.KeptMemberID, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+	var found int
+	for rows.Next() {
+		found++
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if found != 2 {
+		return errors.New("member not found")
+	}
+
+	// Moving shares or payments of a closed period would change its frozen balances
+	var locked bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM expenses e
+			JOIN closed_periods cp ON cp.group_id = e.group_id AND cp.reopened_at IS NULL
+			WHERE e.group_id = $1 AND e.created_at <= cp.closed_until
+			  AND (e.paid_by_id IN ($2, $3) OR EXISTS (
+			      SELECT 1 FROM expense_splits es WHERE es.expense_id = e.id AND es.member_id IN ($2, $3)
+			  ))
+		)
+	`, merge.GroupID, merge.KeptMemberID, merge.MergedMemberID).Scan(&locked)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return domain.ErrMemberMergeLocked
+	}
+
+	shared, err := lockSharedExpenses(tx, merge)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE members SET archived_at = NOW()
+		WHERE id = $1 AND group_id = $2
+	`, merge.MergedMemberID, merge.GroupID)
+	if err != nil {
+		return err
+	}
+
+	var combined []domain.CombinedSplit
+	for _, expense := range shared {
+		if split, ok := combine(expense); ok {
+			combined = append(combined, split)
+		}
+	}
+
+	for _, split := range combined {
+		_, err = tx.Exec(`
+			DELETE FROM expense_splits WHERE expense_id = $1 AND member_id = $2
+		`, split.ExpenseID, merge.MergedMemberID)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range split.Splits {
+			_, err = tx.Exec(`
+				UPDATE expense_splits SET amount = $3
+				WHERE expense_id = $1 AND member_id = $2
+			`, split.ExpenseID, member.MemberID, member.Amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(`
+			UPDATE expenses SET approval_status = $2 WHERE id = $1
+		`, split.ExpenseID, split.ApprovalStatus)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Template shares of both members add up like expense shares do
+	_, err = tx.Exec(`
+		UPDATE expense_template_members kept SET shares = kept.shares + merged.shares
+		FROM expense_template_members merged
+		WHERE kept.member_id = $1 AND merged.member_id = $2 AND kept.template_id = merged.template_id
+	`, merge.KeptMemberID, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM expense_template_members
+		WHERE member_id = $2 AND template_id IN (
+			SELECT template_id FROM expense_template_members WHERE member_id = $1
+		)
+	`, merge.KeptMemberID, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`UPDATE expenses SET paid_by_id = $1 WHERE paid_by_id = $2`,
+		`UPDATE expenses SET deleted_by = $1 WHERE deleted_by = $2`,
+		`UPDATE expense_splits SET member_id = $1 WHERE member_id = $2`,
+		`UPDATE expense_comments SET member_id = $1 WHERE member_id = $2`,
+		`UPDATE expense_revisions SET changed_by = $1 WHERE changed_by = $2`,
+		`UPDATE expense_templates SET paid_by_id = $1 WHERE paid_by_id = $2`,
+		`UPDATE expense_template_members SET member_id = $1 WHERE member_id = $2`,
+		`UPDATE group_settings SET admin_member_id = $1 WHERE admin_member_id = $2`,
+		`UPDATE closed_periods SET closed_by = $1 WHERE closed_by = $2`,
+		`UPDATE closed_periods SET reopened_by = $1 WHERE reopened_by = $2`,
+	} {
+		if _, err := tx.Exec(query, merge.KeptMemberID, merge.MergedMemberID); err != nil {
+			return err
+		}
+	}
+
+	// Bank account details are sealed for one member and cannot move
+	_, err = tx.Exec(`DELETE FROM member_bank_accounts WHERE member_id = $1`, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+
+	// The kept member takes the household of the merged one unless already in one
+	_, err = tx.Exec(`
+		UPDATE household_members SET member_id = $1
+		WHERE member_id = $2 AND NOT EXISTS (SELECT 1 FROM household_members WHERE member_id = $1)
+	`, merge.KeptMemberID, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM household_members WHERE member_id = $1`, merge.MergedMemberID)
+	if err != nil {
+		return err
+	}
+
+	var mergedBy sql.NullString
+	if merge.MergedBy != "" {
+		mergedBy = sql.NullString{String: merge.MergedBy, Valid: true}
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO member_merges (id, group_id, kept_member_id, kept_member_name, merged_member_id, merged_member_name, expense_count, merged_by, merged_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, merge.ID, merge.GroupID, merge.KeptMemberID, merge.KeptMemberName, merge.MergedMemberID, merge.MergedMemberName,
+		merge.ExpenseCount, mergedBy, merge.MergedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// lockSharedExpenses locks and loads the expenses, trashed ones included, both
+// members of a merge are split on, with their approval status and votes. Splits come in the order the expense
+// repository loads them in, which the shares of equal splits depend on.
+func lockSharedExpenses(tx *sql.Tx, merge *domain.MemberMerge) ([]*domain.Expense, error) {
+	rows, err := tx.Query(`
+		SELECT e.id, e.amount, e.approval_status, es.member_id, es.amount, es.vote
+		FROM expenses e
+		JOIN expense_splits es ON es.expense_id = e.id
+		JOIN members m ON es.member_id = m.id
+		WHERE e.group_id = $1
+		  AND EXISTS (SELECT 1 FROM expense_splits kept WHERE kept.expense_id = e.id AND kept.member_id = $2)
+		  AND EXISTS (SELECT 1 FROM expense_splits merged WHERE merged.expense_id = e.id AND merged.member_id = $3)
+		ORDER BY e.id, m.name
+		FOR UPDATE OF e, es
+	`, merge.GroupID, merge.KeptMemberID, merge.MergedMemberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expenses []*domain.Expense
+	for rows.Next() {
+		var expense domain.Expense
+		var split domain.SplitMember
+		if err := rows.Scan(&expense.ID, &expense.Amount, &expense.ApprovalStatus, &split.MemberID, &split.Amount, &split.Vote); err != nil {
+			return nil, err
+		}
+		if len(expenses) == 0 || expenses[len(expenses)-1].ID != expense.ID {
+			expenses = append(expenses, &expense)
+		}
+		last := expenses[len(expenses)-1]
+		last.SplitMembers = append(last.SplitMembers, split)
+	}
+
+	return expenses, rows.Err()
+}
+
+// GetMemberMerges lists the member merges of a group, newest first
+func (r *GroupRepository) GetMemberMerges(groupID string) ([]*domain.MemberMerge, error) {
+	rows, err := r.db.Query(`
+		SELECT id, group_id, kept_member_id, kept_member_name, merged_member_id, merged_member_name,
+		       expense_count, merged_by, merged_at
+		FROM member_merges WHERE group_id = $1
+		ORDER BY merged_at DESC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var merges []*domain.MemberMerge
+	for rows.Next() {
+		var merge domain.MemberMerge
+		var mergedBy sql.NullString
+		err := rows.Scan(&merge.ID, &merge.GroupID, &merge.KeptMemberID, &merge.KeptMemberName,
+			&merge.MergedMemberID, &merge.MergedMemberName, &merge.ExpenseCount, &mergedBy, &merge.MergedAt)
+		if err != nil {
+			return nil, err
+		}
+		merge.MergedBy = mergedBy.String
+		merges = append(merges, &merge)
+	}
+
+	return merges, rows.Err()
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupRepository_MergeMembers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	keptID := uuid.New()
+	otherID := uuid.New()
+	merge := &domain.MemberMerge{
+		ID:               uuid.New().String(),
+		GroupID:          uuid.New().String(),
+		KeptMemberID:     keptID.String(),
+		KeptMemberName:   "Taro",
+		MergedMemberID:   uuid.New().String(),
+		MergedMemberName: "たろう",
+		ExpenseCount:     3,
+		MergedAt:         now,
+	}
+	combined := []domain.CombinedSplit{{
+		ExpenseID: uuid.New(),
+		Splits: []domain.SplitMember{
+			{MemberID: keptID, Amount: 667, Vote: domain.ApprovalVoteApprove},
+			{MemberID: otherID, Amount: 333, Vote: domain.ApprovalVoteApprove},
+		},
+		ApprovalStatus: domain.ApprovalStatusApproved,
+	}}
+
+	mergedID := uuid.MustParse(merge.MergedMemberID)
+	var combinedFrom []*domain.Expense
+	combine := func(expense *domain.Expense) (domain.CombinedSplit, bool) {
+		combinedFrom = append(combinedFrom, expense)
+		return combined[0], true
+	}
+	expectLockedMembers := func(found int) {
+		rows := sqlmock.NewRows([]string{"id"})
+		for _, id := range []string{merge.KeptMemberID, merge.MergedMemberID}[:found] {
+			rows.AddRow(id)
+		}
+		mock.ExpectQuery(`SELECT id FROM members WHERE group_id = \$1 AND id IN \(\$2, \$3\) AND archived_at IS NULL FOR UPDATE`).
+			WithArgs(merge.GroupID, merge.KeptMemberID, merge.MergedMemberID).
+			WillReturnRows(rows)
+	}
+	expectClosedPeriodCheck := func(locked bool) {
+		mock.ExpectQuery(`SELECT EXISTS \( SELECT 1 FROM expenses e JOIN closed_periods cp`).
+			WithArgs(merge.GroupID, merge.KeptMemberID, merge.MergedMemberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(locked))
+	}
+
+	mock.ExpectBegin()
+	expectLockedMembers(2)
+	expectClosedPeriodCheck(false)
+	mock.ExpectQuery(`SELECT e.id, e.amount, e.approval_status, es.member_id, es.amount, es.vote FROM expenses e .* FOR UPDATE OF e, es`).
+		WithArgs(merge.GroupID, merge.KeptMemberID, merge.MergedMemberID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "amount", "approval_status", "member_id", "amount", "vote"}).
+			AddRow(combined[0].ExpenseID, int64(1000), "disputed", otherID, int64(333), "approve").
+			AddRow(combined[0].ExpenseID, int64(1000), "disputed", keptID, int64(333), "approve").
+			AddRow(combined[0].ExpenseID, int64(1000), "disputed", mergedID, int64(333), "dispute"))
+	mock.ExpectExec(`UPDATE members SET archived_at = NOW\(\) WHERE id = \$1 AND group_id = \$2`).
+		WithArgs(merge.MergedMemberID, merge.GroupID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM expense_splits WHERE expense_id = \$1 AND member_id = \$2`).
+		WithArgs(combined[0].ExpenseID, merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE expense_splits SET amount = \$3`).
+		WithArgs(combined[0].ExpenseID, keptID, int64(667)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE expense_splits SET amount = \$3`).
+		WithArgs(combined[0].ExpenseID, otherID, int64(333)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE expenses SET approval_status = \$2 WHERE id = \$1`).
+		WithArgs(combined[0].ExpenseID, domain.ApprovalStatusApproved).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE expense_template_members kept SET shares = kept.shares \+ merged.shares`).
+		WithArgs(merge.KeptMemberID, merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM expense_template_members WHERE member_id = \$2`).
+		WithArgs(merge.KeptMemberID, merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	for _, query := range []string{
+		`UPDATE expenses SET paid_by_id = \$1 WHERE paid_by_id = \$2`,
+		`UPDATE expenses SET deleted_by = \$1 WHERE deleted_by = \$2`,
+		`UPDATE expense_splits SET member_id = \$1 WHERE member_id = \$2`,
+		`UPDATE expense_comments SET member_id = \$1 WHERE member_id = \$2`,
+		`UPDATE expense_revisions SET changed_by = \$1 WHERE changed_by = \$2`,
+		`UPDATE expense_templates SET paid_by_id = \$1 WHERE paid_by_id = \$2`,
+		`UPDATE expense_template_members SET member_id = \$1 WHERE member_id = \$2`,
+		`UPDATE group_settings SET admin_member_id = \$1 WHERE admin_member_id = \$2`,
+		`UPDATE closed_periods SET closed_by = \$1 WHERE closed_by = \$2`,
+		`UPDATE closed_periods SET reopened_by = \$1 WHERE reopened_by = \$2`,
+	} {
+		mock.ExpectExec(query).
+			WithArgs(merge.KeptMemberID, merge.MergedMemberID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(`DELETE FROM member_bank_accounts WHERE member_id = \$1`).
+		WithArgs(merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE household_members SET member_id = \$1`).
+		WithArgs(merge.KeptMemberID, merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM household_members WHERE member_id = \$1`).
+		WithArgs(merge.MergedMemberID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO member_merges`).
+		WithArgs(merge.ID, merge.GroupID, merge.KeptMemberID, "Taro", merge.MergedMemberID, "たろう", 3, nil, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, NewGroupRepository(db).MergeMembers(merge, combine))
+
+	// The shares are combined from the expense as locked, splits in order
+	require.Len(t, combinedFrom, 1)
+	assert.Equal(t, int64(1000), combinedFrom[0].Amount)
+	assert.Equal(t, domain.ApprovalStatusDisputed, combinedFrom[0].ApprovalStatus)
+	assert.Equal(t, []domain.SplitMember{
+		{MemberID: otherID, Amount: 333, Vote: domain.ApprovalVoteApprove},
+		{MemberID: keptID, Amount: 333, Vote: domain.ApprovalVoteApprove},
+		{MemberID: mergedID, Amount: 333, Vote: domain.ApprovalVoteDispute},
+	}, combinedFrom[0].SplitMembers)
+
+	// Nothing changes when the merged member is gone already
+	mock.ExpectBegin()
+	expectLockedMembers(1)
+	mock.ExpectRollback()
+
+	assert.EqualError(t, NewGroupRepository(db).MergeMembers(merge, combine), "member not found")
+
+	// Nor when either member has expenses in a closed period
+	mock.ExpectBegin()
+	expectLockedMembers(2)
+	expectClosedPeriodCheck(true)
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, NewGroupRepository(db).MergeMembers(merge, combine), domain.ErrMemberMergeLocked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_GetMemberMerges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	groupID := uuid.New().String()
+	mergedBy := uuid.New().String()
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "group_id", "kept_member_id", "kept_member_name", "merged_member_id", "merged_member_name",
+		"expense_count", "merged_by", "merged_at",
+	}).
+		AddRow("m2", groupID, "k2", "Hanako", "d2", "はなこ", 0, nil, now).
+		AddRow("m1", groupID, "k1", "Taro", "d1", "たろう", 4, mergedBy, now.Add(-time.Hour))
+	mock.ExpectQuery(`FROM member_merges WHERE group_id = \$1 ORDER BY merged_at DESC`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	merges, err := NewGroupRepository(db).GetMemberMerges(groupID)
+
+	require.NoError(t, err)
+	require.Len(t, merges, 2)
+	assert.Equal(t, "はなこ", merges[0].MergedMemberName)
+	assert.Empty(t, merges[0].MergedBy)
+	assert.Equal(t, 4, merges[1].ExpenseCount)
+	assert.Equal(t, mergedBy, merges[1].MergedBy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
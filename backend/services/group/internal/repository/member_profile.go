@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// GetMemberProfiles returns the profile of every member of a group, in the
+// order they joined
+func (r *GroupRepository) GetMemberProfiles(groupID string) ([]*domain.MemberProfile, error) {
+	rows, err := r.db.Query(`
+		SELECT id, name, email, color, payment_handle
+		FROM members WHERE group_id = $1 AND archived_at IS NULL
+		ORDER BY joined_at ASC
+	`, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*domain.MemberProfile
+	for rows.Next() {
+		var profile domain.MemberProfile
+		var email, color, paymentHandle sql.NullString
+		if err := rows.Scan(&profile.MemberID, &profile.Name, &email, &color, &paymentHandle); err != nil {
+			return nil, err
+		}
+		profile.Email = email.String
+		profile.Color = color.String
+		profile.PaymentHandle = paymentHandle.String
+		profiles = append(profiles, &profile)
+	}
+
+	return profiles, rows.Err()
+}
+
+// UpdateMember saves a member's profile. Expenses show the member's current
+// name, so a new name shows up on them too.
+func (r *GroupRepository) UpdateMember(groupID string, profile *domain.MemberProfile) error {
+	result, err := r.db.Exec(`
+		UPDATE members
+		SET name = $3, email = NULLIF($4, ''), color = NULLIF($5, ''), payment_handle = NULLIF($6, '')
+		WHERE id = $1 AND group_id = $2 AND archived_at IS NULL
+	`, profile.MemberID, groupID, profile.Name, profile.Email, profile.Color, profile.PaymentHandle)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("member not found")
+	}
+
+	return nil
+}
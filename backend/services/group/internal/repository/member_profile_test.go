@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupRepository_GetMemberProfiles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "color", "payment_handle"}).
+		AddRow(aliceID, "Alice", "alice@example.com", "#1E90FF", "alice-paypay").
+		AddRow(bobID, "Bob", nil, nil, nil)
+	mock.ExpectQuery(`SELECT id, name, email, color, payment_handle FROM members WHERE group_id = \$1 AND archived_at IS NULL`).
+		WithArgs(groupID).
+		WillReturnRows(rows)
+
+	profiles, err := repo.GetMemberProfiles(groupID)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.MemberProfile{
+		{MemberID: aliceID, Name: "Alice", Email: "alice@example.com", Color: "#1E90FF", PaymentHandle: "alice-paypay"},
+		{MemberID: bobID, Name: "Bob"},
+	}, profiles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGroupRepository_UpdateMember(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewGroupRepository(db)
+	groupID := uuid.New().String()
+	profile := &domain.MemberProfile{MemberID: uuid.New().String(), Name: "Alice", Email: "alice@example.com"}
+
+	mock.ExpectExec(`UPDATE members SET name = \$3, email = NULLIF\(\$4, ''\), color = NULLIF\(\$5, ''\), payment_handle = NULLIF\(\$6, ''\)`).
+		WithArgs(profile.MemberID, groupID, "Alice", "alice@example.com", "", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.UpdateMember(groupID, profile))
+
+	// A removed member or one of another group is not found
+	mock.ExpectExec(`UPDATE members SET name = \$3`).
+		WithArgs(profile.MemberID, groupID, "Alice", "alice@example.com", "", "").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.EqualError(t, repo.UpdateMember(groupID, profile), "member not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
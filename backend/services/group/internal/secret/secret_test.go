@@ -0,0 +1,51 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCipher_SealOpen(t *testing.T) {
+	c, err := NewCipher(bytes.Repeat([]byte{1}, KeySize))
+	require.NoError(t, err)
+
+	plaintext := []byte(`{"account_number":"1234567"}`)
+	sealed, err := c.Seal(plaintext, []byte("member-1"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "1234567")
+
+	// A fresh nonce every time
+	again, err := c.Seal(plaintext, []byte("member-1"))
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+
+	opened, err := c.Open(sealed, []byte("member-1"))
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, opened)
+
+	_, err = c.Open(sealed, []byte("member-2"))
+	assert.ErrorIs(t, err, ErrDecrypt)
+
+	other, err := NewCipher(bytes.Repeat([]byte{2}, KeySize))
+	require.NoError(t, err)
+	_, err = other.Open(sealed, []byte("member-1"))
+	assert.ErrorIs(t, err, ErrDecrypt)
+
+	_, err = c.Open([]byte("short"), nil)
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestNewCipherFromBase64(t *testing.T) {
+	_, err := NewCipherFromBase64(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, KeySize)))
+	assert.NoError(t, err)
+
+	_, err = NewCipherFromBase64(base64.StdEncoding.EncodeToString([]byte("too short")))
+	assert.Error(t, err)
+
+	_, err = NewCipherFromBase64("not base64!")
+	assert.Error(t, err)
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errBlobStoreNotConfigured = errors.New("添付ファイルの保存先が設定されていません")
+
+func (s *GroupService) UploadAttachment(ctx context.Context, metadata *groupv1.AttachmentMetadata, content io.Reader) (*groupv1.UploadAttachmentResponse, error) {
+	if s.blobStore == nil {
+		return nil, errBlobStoreNotConfigured
+	}
+
+	// 入力値検証
+	if err := validator.ValidateUUID(metadata.GetExpenseId()); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if err := validator.ValidateAttachmentFileName(metadata.GetFileName()); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateAttachmentContentType(metadata.GetContentType()); err != nil {
+		return nil, err
+	}
+
+	expenseID, err := uuid.Parse(metadata.GetExpenseId())
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	// Validate expense exists
+	if _, err := s.expenseRepo.FindByID(ctx, expenseID); err != nil {
+		return nil, err
+	}
+
+	contentType := strings.ToLower(strings.TrimSpace(metadata.GetContentType()))
+
+	// Reject files whose bytes don't match the declared type
+	body := bufio.NewReaderSize(content, 512)
+	head, err := body.Peek(512)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, validator.ValidateAttachmentSize(0)
+	}
+	if !contentMatches(contentType, head) {
+		return nil, validator.ValidationError{Field: "contentType", Message: "ファイルの内容が形式と一致しません"}
+	}
+
+	attachmentID := uuid.New()
+	attachment := &domain.Attachment{
+		ID:          attachmentID,
+		ExpenseID:   expenseID,
+		FileName:    strings.TrimSpace(metadata.GetFileName()),
+		ContentType: contentType,
+		StorageKey:  "expenses/" + expenseID.String() + "/" + attachmentID.String(),
+		CreatedAt:   time.Now(),
+	}
+
+	// Read at most one byte past the limit so oversized uploads can be detected
+	counter := &countingReader{r: io.LimitReader(body, validator.MaxAttachmentSize+1)}
+	if err := s.blobStore.Put(ctx, attachment.StorageKey, counter, contentType); err != nil {
+		return nil, err
+	}
+	attachment.SizeBytes = counter.n
+
+	if err := validator.ValidateAttachmentSize(attachment.SizeBytes); err != nil {
+		s.deleteBlob(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	if err := s.expenseRepo.CreateAttachment(ctx, attachment); err != nil {
+		s.deleteBlob(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	return &groupv1.UploadAttachmentResponse{
+		Attachment: toProtoAttachment(attachment),
+	}, nil
+}
+
+func (s *GroupService) ListExpenseAttachments(ctx context.Context, req *groupv1.ListExpenseAttachmentsRequest) (*groupv1.ListExpenseAttachmentsResponse, error) {
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	attachments, err := s.expenseRepo.FindAttachmentsByExpenseID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAttachments := make([]*groupv1.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		protoAttachments[i] = toProtoAttachment(attachment)
+	}
+
+	return &groupv1.ListExpenseAttachmentsResponse{
+		Attachments: protoAttachments,
+	}, nil
+}
+
+// OpenAttachment returns the attachment metadata and a reader for its content.
+// The caller must close the reader.
+func (s *GroupService) OpenAttachment(ctx context.Context, req *groupv1.DownloadAttachmentRequest) (*groupv1.Attachment, io.ReadCloser, error) {
+	if s.blobStore == nil {
+		return nil, nil, errBlobStoreNotConfigured
+	}
+
+	if err := validator.ValidateUUID(req.AttachmentId); err != nil {
+		return nil, nil, errors.New("添付ファイルIDが無効です")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return nil, nil, errors.New("invalid attachment ID")
+	}
+
+	attachment, err := s.expenseRepo.FindAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return toProtoAttachment(attachment), content, nil
+}
+
+func (s *GroupService) DeleteAttachment(ctx context.Context, req *groupv1.DeleteAttachmentRequest) (*groupv1.DeleteAttachmentResponse, error) {
+	if s.blobStore == nil {
+		return nil, errBlobStoreNotConfigured
+	}
+
+	if err := validator.ValidateUUID(req.AttachmentId); err != nil {
+		return nil, errors.New("添付ファイルIDが無効です")
+	}
+
+	attachmentID, err := uuid.Parse(req.AttachmentId)
+	if err != nil {
+		return nil, errors.New("invalid attachment ID")
+	}
+
+	attachment, err := s.expenseRepo.FindAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.expenseRepo.DeleteAttachment(ctx, attachmentID); err != nil {
+		return nil, err
+	}
+
+	// The metadata row is gone, so a leftover blob is only wasted space
+	s.deleteBlob(ctx, attachment.StorageKey)
+
+	return &groupv1.DeleteAttachmentResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *GroupService) deleteBlob(ctx context.Context, key string) {
+	if err := s.blobStore.Delete(ctx, key); err != nil {
+		log.Printf("Failed to delete blob %s: %v", key, err)
+	}
+}
+
+// contentMatches checks the declared content type against the sniffed file
+// header. HEIC cannot be sniffed by net/http and is accepted as declared.
+func contentMatches(contentType string, head []byte) bool {
+	if contentType == "image/heic" {
+		return true
+	}
+	return http.DetectContentType(head) == contentType
+}
+
+func toProtoAttachment(attachment *domain.Attachment) *groupv1.Attachment {
+	return &groupv1.Attachment{
+		Id:          attachment.ID.String(),
+		ExpenseId:   attachment.ExpenseID.String(),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
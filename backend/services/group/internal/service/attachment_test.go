@@ -0,0 +1,172 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/storage"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestGroupService_UploadAttachment(t *testing.T) {
+	expenseID := uuid.New()
+
+	tests := []struct {
+		name          string
+		metadata      *groupv1.AttachmentMetadata
+		content       []byte
+		setupMocks    func(*MockExpenseRepository)
+		expectedError string
+	}{
+		{
+			name:     "successful upload",
+			metadata: &groupv1.AttachmentMetadata{ExpenseId: expenseID.String(), FileName: "receipt.png", ContentType: "image/png"},
+			content:  append(append([]byte{}, pngHeader...), []byte("image data")...),
+			setupMocks: func(expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID}, nil)
+				expenseRepo.On("CreateAttachment", mock.Anything, mock.MatchedBy(func(attachment *domain.Attachment) bool {
+					return attachment.ExpenseID == expenseID &&
+						attachment.FileName == "receipt.png" &&
+						attachment.ContentType == "image/png" &&
+						attachment.SizeBytes == int64(len(pngHeader)+len("image data"))
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "unsupported content type",
+			metadata:      &groupv1.AttachmentMetadata{ExpenseId: expenseID.String(), FileName: "page.html", ContentType: "text/html"},
+			content:       []byte("<html></html>"),
+			setupMocks:    func(expenseRepo *MockExpenseRepository) {},
+			expectedError: "サポートされていないファイル形式です",
+		},
+		{
+			name:     "content does not match declared type",
+			metadata: &groupv1.AttachmentMetadata{ExpenseId: expenseID.String(), FileName: "receipt.png", ContentType: "image/png"},
+			content:  []byte("just some text"),
+			setupMocks: func(expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID}, nil)
+			},
+			expectedError: "ファイルの内容が形式と一致しません",
+		},
+		{
+			name:     "file too large",
+			metadata: &groupv1.AttachmentMetadata{ExpenseId: expenseID.String(), FileName: "receipt.png", ContentType: "image/png"},
+			content:  append(append([]byte{}, pngHeader...), make([]byte, validator.MaxAttachmentSize)...),
+			setupMocks: func(expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID}, nil)
+			},
+			expectedError: "ファイルサイズが大きすぎます",
+		},
+		{
+			name:     "expense not found",
+			metadata: &groupv1.AttachmentMetadata{ExpenseId: expenseID.String(), FileName: "receipt.png", ContentType: "image/png"},
+			content:  pngHeader,
+			setupMocks: func(expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(nil, domain.ErrExpenseNotFound)
+			},
+			expectedError: "expense not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			blobStore, err := storage.NewLocalBlobStore(dir)
+			require.NoError(t, err)
+
+			mockExpenseRepo := new(MockExpenseRepository)
+			tt.setupMocks(mockExpenseRepo)
+
+			service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo, WithBlobStore(blobStore))
+
+			resp, err := service.UploadAttachment(context.Background(), tt.metadata, bytes.NewReader(tt.content))
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+
+				// Nothing may be left behind in the blob store
+				assert.Zero(t, countFiles(t, dir))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, expenseID.String(), resp.Attachment.ExpenseId)
+
+				content, err := blobStore.Get(context.Background(), "expenses/"+expenseID.String()+"/"+resp.Attachment.Id)
+				require.NoError(t, err)
+				defer content.Close()
+				body, err := io.ReadAll(content)
+				require.NoError(t, err)
+				assert.Equal(t, tt.content, body)
+			}
+
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_UploadAttachment_NoBlobStore(t *testing.T) {
+	service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+	resp, err := service.UploadAttachment(context.Background(), &groupv1.AttachmentMetadata{
+		ExpenseId:   uuid.New().String(),
+		FileName:    "receipt.png",
+		ContentType: "image/png",
+	}, bytes.NewReader(pngHeader))
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestGroupService_DeleteAttachment(t *testing.T) {
+	blobStore, err := storage.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	attachment := &domain.Attachment{
+		ID:         uuid.New(),
+		ExpenseID:  uuid.New(),
+		StorageKey: "expenses/e/a",
+	}
+	require.NoError(t, blobStore.Put(context.Background(), attachment.StorageKey, strings.NewReader("x"), "image/png"))
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindAttachmentByID", mock.Anything, attachment.ID).Return(attachment, nil)
+	mockExpenseRepo.On("DeleteAttachment", mock.Anything, attachment.ID).Return(nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo, WithBlobStore(blobStore))
+
+	resp, err := service.DeleteAttachment(context.Background(), &groupv1.DeleteAttachmentRequest{AttachmentId: attachment.ID.String()})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = blobStore.Get(context.Background(), attachment.StorageKey)
+	assert.ErrorIs(t, err, storage.ErrBlobNotFound)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func countFiles(t *testing.T, dir string) int {
+	count := 0
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			count++
+		}
+		return err
+	})
+	require.NoError(t, err)
+	return count
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+// algorithmExpenses converts saved expenses for balance calculation. Shares
+// are kept as stored when they add up to the amount; equal splits store the
+// rounded-down share, so those are split again with the remainder spread the
+// same way CalculateSettlements does.
+func algorithmExpenses(expenses []*domain.Expense) []algorithm.Expense {
+	algExpenses := make([]algorithm.Expense, len(expenses))
+	for i, expense := range expenses {
+		algExpense := algorithm.Expense{
+			ID:      expense.ID.String(),
+			PayerID: expense.PaidByID.String(),
+			Amount:  expense.Amount,
+			Income:  expense.Kind == domain.ExpenseKindIncome,
+		}
+		var total int64
+		for _, split := range expense.SplitMembers {
+			algExpense.SplitBetween = append(algExpense.SplitBetween, split.MemberID.String())
+			algExpense.SplitAmounts = append(algExpense.SplitAmounts, split.Amount)
+			total += split.Amount
+		}
+		if total != expense.Amount {
+			algExpense.SplitAmounts = nil
+		}
+		algExpenses[i] = algExpense
+	}
+	return algExpenses
+}
+
+// memberBalances calculates the balance of every member of the group from
+// saved expenses, in the group's member order. Only approved expenses count.
+func memberBalances(group *groupv1.Group, expenses []*domain.Expense) []algorithm.Balance {
+	algMembers := make([]algorithm.Member, len(group.Members))
+	for i, member := range group.Members {
+		algMembers[i] = algorithm.Member{ID: member.Id, Name: member.Name}
+	}
+
+	byMember := make(map[string]algorithm.Balance, len(group.Members))
+	for _, balance := range algorithm.CalculateMemberBalances(algorithmExpenses(approvedExpenses(expenses)), algMembers) {
+		byMember[balance.MemberID] = balance
+	}
+
+	balances := make([]algorithm.Balance, len(group.Members))
+	for i, member := range group.Members {
+		balances[i] = byMember[member.Id]
+	}
+	return balances
+}
+
+// approvedExpenses leaves out the expenses still waiting for or refused
+// approval, which do not count towards balances
+func approvedExpenses(expenses []*domain.Expense) []*domain.Expense {
+	approved := make([]*domain.Expense, 0, len(expenses))
+	for _, expense := range expenses {
+		if expense.IsApproved() {
+			approved = append(approved, expense)
+		}
+	}
+	return approved
+}
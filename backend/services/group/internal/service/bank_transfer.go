@@ -0,0 +1,341 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/secret"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"github.com/jt-chihara/warikan/services/group/internal/zengin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errBankAccountCipherNotConfigured = errors.New("口座情報の暗号鍵が設定されていません")
+
+// WithBankAccountCipher sets the cipher member bank accounts are encrypted
+// with. Without it bank accounts cannot be saved or read.
+func WithBankAccountCipher(cipher *secret.Cipher) Option {
+	return func(s *GroupService) {
+		s.bankAccountCipher = cipher
+	}
+}
+
+// SetMemberBankAccount saves the account a member receives settlement
+// transfers at, replacing any saved before
+func (s *GroupService) SetMemberBankAccount(ctx context.Context, req *groupv1.SetMemberBankAccountRequest) (*groupv1.SetMemberBankAccountResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := validator.ValidateBankAccount(req.BankCode, req.BranchCode, int32(req.AccountType), req.AccountNumber); err != nil {
+		return nil, err
+	}
+
+	account := &domain.BankAccount{
+		BankCode:      req.BankCode,
+		BranchCode:    req.BranchCode,
+		AccountType:   int(req.AccountType),
+		AccountNumber: req.AccountNumber,
+	}
+	var err error
+	if account.BankName, err = normalizeKana("bankName", "金融機関名", req.BankName, zengin.BankNameLength); err != nil {
+		return nil, err
+	}
+	if account.BranchName, err = normalizeKana("branchName", "支店名", req.BranchName, zengin.BranchNameLength); err != nil {
+		return nil, err
+	}
+	if account.AccountHolder, err = normalizeKana("accountHolder", "口座名義", req.AccountHolder, zengin.RecipientNameLength); err != nil {
+		return nil, err
+	}
+	if account.AccountHolder == "" {
+		return nil, validator.ValidationError{Field: "accountHolder", Message: "口座名義は必須です"}
+	}
+
+	if s.bankAccountCipher == nil {
+		return nil, errBankAccountCipherNotConfigured
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	member := findMember(group, req.MemberId)
+	if member == nil {
+		return nil, errors.New("メンバーが見つかりません")
+	}
+
+	details, err := json.Marshal(account)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := s.bankAccountCipher.Seal(details, []byte(req.MemberId))
+	if err != nil {
+		return nil, err
+	}
+
+	stored := &domain.SealedBankAccount{MemberID: req.MemberId, Details: sealed, UpdatedAt: time.Now()}
+	if err := s.repo.SaveBankAccount(req.GroupId, stored); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.SetMemberBankAccountResponse{
+		BankAccount: toProtoBankAccount(member, account, stored.UpdatedAt),
+	}, nil
+}
+
+// DeleteMemberBankAccount removes a member's saved account
+func (s *GroupService) DeleteMemberBankAccount(ctx context.Context, req *groupv1.DeleteMemberBankAccountRequest) (*groupv1.DeleteMemberBankAccountResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := s.repo.DeleteBankAccount(req.GroupId, req.MemberId); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.DeleteMemberBankAccountResponse{Success: true}, nil
+}
+
+// ListBankAccounts returns the saved accounts of the group's members in
+// member order, with only the last digits of the account numbers
+func (s *GroupService) ListBankAccounts(ctx context.Context, req *groupv1.ListBankAccountsRequest) (*groupv1.ListBankAccountsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.bankAccounts(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var bankAccounts []*groupv1.BankAccount
+	for _, member := range group.Members {
+		if account, ok := accounts[member.Id]; ok {
+			bankAccounts = append(bankAccounts, toProtoBankAccount(member, account.BankAccount, account.updatedAt))
+		}
+	}
+
+	return &groupv1.ListBankAccountsResponse{BankAccounts: bankAccounts}, nil
+}
+
+// ExportZenginTransfers writes a bulk transfer file in which the payer pays
+// every other member their positive balance, which is what the computed
+// settlements pay them. Members who owe money repay the payer separately.
+func (s *GroupService) ExportZenginTransfers(ctx context.Context, req *groupv1.ExportZenginTransfersRequest) (*groupv1.ExportZenginTransfersResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.PayerMemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := validator.ValidateRequesterCode(req.RequesterCode); err != nil {
+		return nil, err
+	}
+
+	requesterName, err := normalizeKana("requesterName", "依頼人名", req.RequesterName, zengin.RequesterNameLength)
+	if err != nil {
+		return nil, err
+	}
+
+	transferDate := time.Now()
+	if req.TransferDate != nil {
+		transferDate = req.TransferDate.AsTime().Local()
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if group.Currency != "JPY" {
+		return nil, errors.New("振込ファイルは日本円のグループのみ作成できます")
+	}
+	if findMember(group, req.PayerMemberId) == nil {
+		return nil, errors.New("メンバーが見つかりません")
+	}
+
+	accounts, err := s.bankAccounts(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	payer, ok := accounts[req.PayerMemberId]
+	if !ok {
+		return nil, errors.New("支払う人の口座情報が登録されていません")
+	}
+	if requesterName == "" {
+		requesterName = payer.AccountHolder
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []zengin.Transfer
+	var missing []string
+	var total int64
+	for _, balance := range memberBalances(group, expenses) {
+		if balance.MemberID == req.PayerMemberId || balance.Amount <= 0 {
+			continue
+		}
+
+		account, ok := accounts[balance.MemberID]
+		if !ok {
+			missing = append(missing, balance.Name)
+			continue
+		}
+
+		transfers = append(transfers, zengin.Transfer{
+			BankCode:      account.BankCode,
+			BankName:      account.BankName,
+			BranchCode:    account.BranchCode,
+			BranchName:    account.BranchName,
+			AccountType:   zengin.AccountType(account.AccountType),
+			AccountNumber: account.AccountNumber,
+			RecipientName: account.AccountHolder,
+			Amount:        balance.Amount,
+		})
+		total += balance.Amount
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("口座情報が登録されていないメンバーがいます: %s", strings.Join(missing, "、"))
+	}
+	if len(transfers) == 0 {
+		return nil, errors.New("振込が必要なメンバーがいません")
+	}
+
+	var content bytes.Buffer
+	err = zengin.Write(&content, zengin.Header{
+		RequesterCode: req.RequesterCode,
+		RequesterName: requesterName,
+		TransferDate:  transferDate,
+		BankCode:      payer.BankCode,
+		BankName:      payer.BankName,
+		BranchCode:    payer.BranchCode,
+		BranchName:    payer.BranchName,
+		AccountType:   zengin.AccountType(payer.AccountType),
+		AccountNumber: payer.AccountNumber,
+	}, transfers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.ExportZenginTransfersResponse{
+		FileName:      fmt.Sprintf("%s_総合振込_%s.txt", group.Name, transferDate.Format("20060102")),
+		ContentType:   "text/plain; charset=Shift_JIS",
+		Content:       content.Bytes(),
+		TransferCount: int32(len(transfers)),
+		TotalAmount:   total,
+	}, nil
+}
+
+type openedBankAccount struct {
+	*domain.BankAccount
+	updatedAt time.Time
+}
+
+// bankAccounts decrypts the saved accounts of the group by member ID
+func (s *GroupService) bankAccounts(groupID string) (map[string]openedBankAccount, error) {
+	if s.bankAccountCipher == nil {
+		return nil, errBankAccountCipherNotConfigured
+	}
+
+	sealed, err := s.repo.GetBankAccounts(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make(map[string]openedBankAccount, len(sealed))
+	for _, stored := range sealed {
+		details, err := s.bankAccountCipher.Open(stored.Details, []byte(stored.MemberID))
+		if err != nil {
+			return nil, fmt.Errorf("bank account of member %s: %w", stored.MemberID, err)
+		}
+
+		var account domain.BankAccount
+		if err := json.Unmarshal(details, &account); err != nil {
+			return nil, fmt.Errorf("bank account of member %s: %w", stored.MemberID, err)
+		}
+		accounts[stored.MemberID] = openedBankAccount{&account, stored.UpdatedAt}
+	}
+	return accounts, nil
+}
+
+// normalizeKana converts an optional name to half-width kana for transfer
+// files and checks it fits its field
+func normalizeKana(field, label, value string, maxLength int) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	normalized, err := zengin.NormalizeName(value)
+	if err != nil {
+		return "", validator.ValidationError{Field: field, Message: label + "はカナ・英数字で入力してください"}
+	}
+	if utf8.RuneCountInString(normalized) > maxLength {
+		return "", validator.ValidationError{Field: field, Message: fmt.Sprintf("%sは半角%d文字以内で入力してください", label, maxLength)}
+	}
+	return normalized, nil
+}
+
+func findMember(group *groupv1.Group, memberID string) *groupv1.Member {
+	for _, member := range group.Members {
+		if member.Id == memberID {
+			return member
+		}
+	}
+	return nil
+}
+
+func toProtoBankAccount(member *groupv1.Member, account *domain.BankAccount, updatedAt time.Time) *groupv1.BankAccount {
+	last4 := account.AccountNumber
+	if len(last4) > 4 {
+		last4 = last4[len(last4)-4:]
+	}
+
+	return &groupv1.BankAccount{
+		MemberId:           member.Id,
+		MemberName:         member.Name,
+		BankCode:           account.BankCode,
+		BankName:           account.BankName,
+		BranchCode:         account.BranchCode,
+		BranchName:         account.BranchName,
+		AccountType:        groupv1.BankAccountType(account.AccountType),
+		AccountNumberLast4: last4,
+		AccountHolder:      account.AccountHolder,
+		UpdatedAt:          timestamppb.New(updatedAt),
+	}
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/secret"
+)
+
+func testBankAccountCipher(t *testing.T) *secret.Cipher {
+	cipher, err := secret.NewCipher(bytes.Repeat([]byte{7}, secret.KeySize))
+	require.NoError(t, err)
+	return cipher
+}
+
+func sealBankAccount(t *testing.T, cipher *secret.Cipher, memberID string, account domain.BankAccount) *domain.SealedBankAccount {
+	details, err := json.Marshal(account)
+	require.NoError(t, err)
+	sealed, err := cipher.Seal(details, []byte(memberID))
+	require.NoError(t, err)
+	return &domain.SealedBankAccount{MemberID: memberID, Details: sealed, UpdatedAt: time.Now()}
+}
+
+func TestGroupService_SetMemberBankAccount(t *testing.T) {
+	groupID := uuid.New().String()
+	memberID := uuid.New().String()
+	group := &groupv1.Group{Id: groupID, Members: []*groupv1.Member{{Id: memberID, Name: "山田"}}}
+	cipher := testBankAccountCipher(t)
+
+	validRequest := func() *groupv1.SetMemberBankAccountRequest {
+		return &groupv1.SetMemberBankAccountRequest{
+			GroupId:       groupID,
+			MemberId:      memberID,
+			BankCode:      "0001",
+			BankName:      "ミズホ",
+			BranchCode:    "100",
+			AccountType:   groupv1.BankAccountType_BANK_ACCOUNT_TYPE_ORDINARY,
+			AccountNumber: "1234567",
+			AccountHolder: "ヤマダ　タロウ",
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+		var saved *domain.SealedBankAccount
+		mockRepo.On("SaveBankAccount", groupID, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*domain.SealedBankAccount)
+		}).Return(nil)
+
+		service := NewGroupService(mockRepo, new(MockExpenseRepository), WithBankAccountCipher(cipher))
+		resp, err := service.SetMemberBankAccount(context.Background(), validRequest())
+
+		require.NoError(t, err)
+		assert.Equal(t, "山田", resp.BankAccount.MemberName)
+		assert.Equal(t, "ﾐｽﾞﾎ", resp.BankAccount.BankName)
+		assert.Equal(t, "ﾔﾏﾀﾞ ﾀﾛｳ", resp.BankAccount.AccountHolder)
+		assert.Equal(t, "4567", resp.BankAccount.AccountNumberLast4)
+
+		// Stored encrypted and bound to the member
+		require.NotNil(t, saved)
+		assert.Equal(t, memberID, saved.MemberID)
+		assert.NotContains(t, string(saved.Details), "1234567")
+		details, err := cipher.Open(saved.Details, []byte(memberID))
+		require.NoError(t, err)
+		assert.Contains(t, string(details), `"account_number":"1234567"`)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			modify  func(*groupv1.SetMemberBankAccountRequest)
+			wantErr string
+		}{
+			{name: "bank code", modify: func(r *groupv1.SetMemberBankAccountRequest) { r.BankCode = "1" }, wantErr: "bankCode: 金融機関コードは4桁の数字で入力してください"},
+			{name: "account type", modify: func(r *groupv1.SetMemberBankAccountRequest) { r.AccountType = 0 }, wantErr: "accountType: 預金種目が無効です"},
+			{name: "kanji holder", modify: func(r *groupv1.SetMemberBankAccountRequest) { r.AccountHolder = "山田太郎" }, wantErr: "accountHolder: 口座名義はカナ・英数字で入力してください"},
+			{name: "missing holder", modify: func(r *groupv1.SetMemberBankAccountRequest) { r.AccountHolder = "" }, wantErr: "accountHolder: 口座名義は必須です"},
+			{name: "long branch name", modify: func(r *groupv1.SetMemberBankAccountRequest) { r.BranchName = strings.Repeat("ガ", 8) }, wantErr: "branchName: 支店名は半角15文字以内で入力してください"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository), WithBankAccountCipher(cipher))
+				req := validRequest()
+				tt.modify(req)
+
+				_, err := service.SetMemberBankAccount(context.Background(), req)
+				assert.EqualError(t, err, tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("no key configured", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		_, err := service.SetMemberBankAccount(context.Background(), validRequest())
+		assert.Equal(t, errBankAccountCipherNotConfigured, err)
+	})
+
+	t.Run("member of another group", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{Id: groupID}, nil)
+
+		service := NewGroupService(mockRepo, new(MockExpenseRepository), WithBankAccountCipher(cipher))
+		_, err := service.SetMemberBankAccount(context.Background(), validRequest())
+		assert.EqualError(t, err, "メンバーが見つかりません")
+		mockRepo.AssertNotCalled(t, "SaveBankAccount", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGroupService_ListBankAccounts(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	cipher := testBankAccountCipher(t)
+
+	mockRepo := new(MockGroupRepositoryInterface)
+	mockRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{Id: groupID, Members: []*groupv1.Member{
+		{Id: aliceID, Name: "Alice"},
+		{Id: bobID, Name: "Bob"},
+	}}, nil)
+	mockRepo.On("GetBankAccounts", groupID).Return([]*domain.SealedBankAccount{
+		sealBankAccount(t, cipher, bobID, domain.BankAccount{BankCode: "0005", BranchCode: "001", AccountType: 1, AccountNumber: "0012345", AccountHolder: "ﾎﾞﾌﾞ"}),
+	}, nil)
+
+	service := NewGroupService(mockRepo, new(MockExpenseRepository), WithBankAccountCipher(cipher))
+	resp, err := service.ListBankAccounts(context.Background(), &groupv1.ListBankAccountsRequest{GroupId: groupID})
+
+	require.NoError(t, err)
+	require.Len(t, resp.BankAccounts, 1)
+	assert.Equal(t, "Bob", resp.BankAccounts[0].MemberName)
+	assert.Equal(t, "2345", resp.BankAccounts[0].AccountNumberLast4)
+
+	// A ciphertext moved to another member does not decrypt
+	moved := sealBankAccount(t, cipher, bobID, domain.BankAccount{AccountNumber: "1"})
+	moved.MemberID = aliceID
+	mockRepo.ExpectedCalls = nil
+	mockRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{Id: groupID}, nil)
+	mockRepo.On("GetBankAccounts", groupID).Return([]*domain.SealedBankAccount{moved}, nil)
+
+	_, err = service.ListBankAccounts(context.Background(), &groupv1.ListBankAccountsRequest{GroupId: groupID})
+	assert.ErrorIs(t, err, secret.ErrDecrypt)
+}
+
+func TestGroupService_ExportZenginTransfers(t *testing.T) {
+	groupID := uuid.New()
+	treasurerID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	cipher := testBankAccountCipher(t)
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Name:     "部署",
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: treasurerID.String(), Name: "会計"},
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+	// Alice paid 9000 for all three and the treasurer 3000: Alice is owed 5000,
+	// the treasurer owes 1000 and Bob 4000
+	expenses := []*domain.Expense{
+		{
+			Amount: 9000, Kind: domain.ExpenseKindExpense, PaidByID: aliceID,
+			SplitMembers: []domain.SplitMember{{MemberID: treasurerID, Amount: 3000}, {MemberID: aliceID, Amount: 3000}, {MemberID: bobID, Amount: 3000}},
+		},
+		{
+			Amount: 3000, Kind: domain.ExpenseKindExpense, PaidByID: treasurerID,
+			SplitMembers: []domain.SplitMember{{MemberID: treasurerID, Amount: 1000}, {MemberID: aliceID, Amount: 1000}, {MemberID: bobID, Amount: 1000}},
+		},
+	}
+	accounts := []*domain.SealedBankAccount{
+		sealBankAccount(t, cipher, treasurerID.String(), domain.BankAccount{BankCode: "0001", BankName: "ﾐｽﾞﾎ", BranchCode: "100", AccountType: 2, AccountNumber: "7654321", AccountHolder: "ｶ)ﾌﾞｼﾖ"}),
+		sealBankAccount(t, cipher, aliceID.String(), domain.BankAccount{BankCode: "0005", BranchCode: "001", AccountType: 1, AccountNumber: "1234567", AccountHolder: "ｱﾘｽ"}),
+	}
+
+	newService := func(accounts ...*domain.SealedBankAccount) *GroupService {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockRepo.On("GetBankAccounts", groupID.String()).Return(accounts, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(expenses, nil)
+		return NewGroupService(mockRepo, mockExpenseRepo, WithBankAccountCipher(cipher))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		resp, err := newService(accounts...).ExportZenginTransfers(context.Background(), &groupv1.ExportZenginTransfersRequest{
+			GroupId:       groupID.String(),
+			PayerMemberId: treasurerID.String(),
+			RequesterCode: "0000012345",
+			TransferDate:  timestamppb.New(time.Date(2024, 6, 25, 0, 0, 0, 0, time.Local)),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "部署_総合振込_20240625.txt", resp.FileName)
+		assert.Equal(t, int32(1), resp.TransferCount)
+		assert.Equal(t, int64(5000), resp.TotalAmount)
+
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(resp.Content)
+		require.NoError(t, err)
+		records := strings.Split(strings.TrimSuffix(string(decoded), "\r\n"), "\r\n")
+		require.Len(t, records, 4)
+		assert.True(t, strings.HasPrefix(records[0], "12100000012345ｶ)ﾌﾞｼﾖ"))
+		assert.Contains(t, records[0], "0625"+"0001"+"ﾐｽﾞﾎ")
+		assert.True(t, strings.HasPrefix(records[1], "20005"))
+		assert.Contains(t, records[1], "1"+"1234567"+"ｱﾘｽ")
+		assert.Contains(t, records[1], "0000005000")
+		assert.True(t, strings.HasPrefix(records[2], "8000001000000005000"))
+	})
+
+	t.Run("payer without account", func(t *testing.T) {
+		_, err := newService(accounts...).ExportZenginTransfers(context.Background(), &groupv1.ExportZenginTransfersRequest{
+			GroupId:       groupID.String(),
+			PayerMemberId: bobID.String(),
+			RequesterCode: "0000012345",
+		})
+		assert.EqualError(t, err, "支払う人の口座情報が登録されていません")
+	})
+
+	t.Run("recipient without account", func(t *testing.T) {
+		_, err := newService(accounts[0]).ExportZenginTransfers(context.Background(), &groupv1.ExportZenginTransfersRequest{
+			GroupId:       groupID.String(),
+			PayerMemberId: treasurerID.String(),
+			RequesterCode: "0000012345",
+		})
+		assert.EqualError(t, err, "口座情報が登録されていないメンバーがいます: Alice")
+	})
+
+	t.Run("invalid requester code", func(t *testing.T) {
+		_, err := newService(accounts...).ExportZenginTransfers(context.Background(), &groupv1.ExportZenginTransfersRequest{
+			GroupId:       groupID.String(),
+			PayerMemberId: treasurerID.String(),
+			RequesterCode: "123",
+		})
+		assert.EqualError(t, err, "requesterCode: 依頼人コードは10桁の数字で入力してください")
+	})
+}
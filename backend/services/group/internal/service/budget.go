@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// budgetThresholds are the percentages of a budget that raise an alert when
+// spending reaches them
+var budgetThresholds = []int32{80, 100}
+
+// BudgetAlertNotifier is told about every budget threshold an expense
+// crosses, e.g. to message the group
+type BudgetAlertNotifier interface {
+	NotifyBudgetAlert(ctx context.Context, groupID string, alert *groupv1.BudgetAlert)
+}
+
+// WithBudgetAlertNotifier sets where budget alerts are sent besides the
+// AddExpense and UpdateExpense responses
+func WithBudgetAlertNotifier(notifier BudgetAlertNotifier) Option {
+	return func(s *GroupService) {
+		s.budgetAlertNotifier = notifier
+	}
+}
+
+// GetBudgets returns the group's budgets, the whole group first
+func (s *GroupService) GetBudgets(ctx context.Context, req *groupv1.GetBudgetsRequest) (*groupv1.GetBudgetsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	budgets, err := s.repo.GetBudgets(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetBudgetsResponse{Budgets: budgets}, nil
+}
+
+// UpdateBudgets replaces the group's budgets
+func (s *GroupService) UpdateBudgets(ctx context.Context, req *groupv1.UpdateBudgetsRequest) (*groupv1.UpdateBudgetsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	budgets := make([]*groupv1.Budget, len(req.Budgets))
+	seen := make(map[string]bool, len(req.Budgets))
+	for i, budget := range req.Budgets {
+		category := strings.TrimSpace(budget.Category)
+		if err := validator.ValidateExpenseCategory(category); err != nil {
+			return nil, err
+		}
+		if seen[category] {
+			if category == "" {
+				return nil, validator.ValidationError{Field: "category", Message: "グループ全体の予算が重複しています"}
+			}
+			return nil, validator.ValidationError{Field: "category", Message: fmt.Sprintf("カテゴリ「%s」の予算が重複しています", category)}
+		}
+		seen[category] = true
+
+		if err := validator.ValidateBudgetAmount(budget.Amount); err != nil {
+			return nil, err
+		}
+
+		period := budget.Period
+		switch period {
+		case groupv1.BudgetPeriod_BUDGET_PERIOD_UNSPECIFIED:
+			period = groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL
+		case groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL, groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY, groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		default:
+			return nil, validator.ValidationError{Field: "period", Message: "予算の期間が無効です"}
+		}
+
+		budgets[i] = &groupv1.Budget{Category: category, Amount: budget.Amount, Period: period}
+	}
+	slices.SortFunc(budgets, func(a, b *groupv1.Budget) int {
+		return strings.Compare(a.Category, b.Category)
+	})
+
+	// Check the group exists
+	if _, err := s.repo.GetGroupByID(req.GroupId); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceBudgets(req.GroupId, budgets); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateBudgetsResponse{Budgets: budgets}, nil
+}
+
+// GetBudgetStatus compares what the group spent in the current period of
+// each budget, or the period containing req.At, with the budget
+func (s *GroupService) GetBudgetStatus(ctx context.Context, req *groupv1.GetBudgetStatusRequest) (*groupv1.GetBudgetStatusResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	at := time.Now()
+	if req.At != nil {
+		at = req.At.AsTime()
+	}
+
+	budgets, err := s.repo.GetBudgets(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*groupv1.BudgetStatus, len(budgets))
+	for i, budget := range budgets {
+		start, end := budgetPeriod(budget.Period, at)
+		spent := budgetSpent(budget, start, end, expenses)
+
+		status := &groupv1.BudgetStatus{
+			Budget:      budget,
+			Spent:       spent,
+			Remaining:   budget.Amount - spent,
+			PercentUsed: int32(max(spent, 0) * 100 / budget.Amount),
+		}
+		if !start.IsZero() {
+			status.PeriodStart = timestamppb.New(start)
+			status.PeriodEnd = timestamppb.New(end)
+		}
+		statuses[i] = status
+	}
+
+	return &groupv1.GetBudgetStatusResponse{Statuses: statuses}, nil
+}
+
+// budgetAlerts returns the budget thresholds that saving expense crossed,
+// previous being the expense before an update and nil for a new one, and
+// sends them to the notifier. Alerts are advisory, so failing to work them
+// out is logged rather than failing the saved expense.
+func (s *GroupService) budgetAlerts(ctx context.Context, previous, expense *domain.Expense) []*groupv1.BudgetAlert {
+	budgets, err := s.repo.GetBudgets(expense.GroupID.String())
+	if err != nil {
+		log.Printf("Failed to get budgets of group %s: %v", expense.GroupID, err)
+		return nil
+	}
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	after, err := s.expenseRepo.FindByGroupID(ctx, expense.GroupID)
+	if err != nil {
+		log.Printf("Failed to get expenses of group %s: %v", expense.GroupID, err)
+		return nil
+	}
+
+	before := make([]*domain.Expense, 0, len(after))
+	for _, e := range after {
+		if e.ID != expense.ID {
+			before = append(before, e)
+		}
+	}
+	if previous != nil {
+		before = append(before, previous)
+	}
+
+	var alerts []*groupv1.BudgetAlert
+	for _, budget := range budgets {
+		start, end := budgetPeriod(budget.Period, expense.CreatedAt)
+		spentBefore := budgetSpent(budget, start, end, before)
+		spentAfter := budgetSpent(budget, start, end, after)
+
+		for _, threshold := range budgetThresholds {
+			limit := int64(threshold) * budget.Amount
+			if spentBefore*100 >= limit || spentAfter*100 < limit {
+				continue
+			}
+
+			alert := &groupv1.BudgetAlert{
+				Budget:           budget,
+				ThresholdPercent: threshold,
+				Spent:            spentAfter,
+				ExpenseId:        expense.ID.String(),
+			}
+			if !start.IsZero() {
+				alert.PeriodStart = timestamppb.New(start)
+			}
+			alerts = append(alerts, alert)
+
+			if s.budgetAlertNotifier != nil {
+				s.budgetAlertNotifier.NotifyBudgetAlert(ctx, expense.GroupID.String(), alert)
+			}
+		}
+	}
+
+	return alerts
+}
+
+// budgetPeriod returns the period of a budget containing at, in the server's
+// time zone, with an exclusive end. Total budgets have no period, so both are
+// zero.
+func budgetPeriod(period groupv1.BudgetPeriod, at time.Time) (time.Time, time.Time) {
+	at = at.In(time.Local)
+	switch period {
+	case groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY:
+		start := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, time.Local)
+		return start, start.AddDate(0, 1, 0)
+	case groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY:
+		daysSinceMonday := (int(at.Weekday()) + 6) % 7
+		start := time.Date(at.Year(), at.Month(), at.Day()-daysSinceMonday, 0, 0, 0, 0, time.Local)
+		return start, start.AddDate(0, 0, 7)
+	default:
+		return time.Time{}, time.Time{}
+	}
+}
+
+// budgetSpent totals what counts against a budget between start and end:
+// expenses less income in the budget's category, or in every category for a
+// whole group budget. Settle-up payments only move money between members.
+func budgetSpent(budget *groupv1.Budget, start, end time.Time, expenses []*domain.Expense) int64 {
+	var spent int64
+	for _, expense := range expenses {
+		if budget.Category != "" && expense.Category != budget.Category {
+			continue
+		}
+		if !start.IsZero() && (expense.CreatedAt.Before(start) || !expense.CreatedAt.Before(end)) {
+			continue
+		}
+
+		switch expense.Kind {
+		case domain.ExpenseKindIncome:
+			spent -= expense.Amount
+		case domain.ExpenseKindPayment:
+		default:
+			spent += expense.Amount
+		}
+	}
+	return spent
+}
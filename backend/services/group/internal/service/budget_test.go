@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+type recordingBudgetAlertNotifier struct {
+	alerts []*groupv1.BudgetAlert
+}
+
+func (n *recordingBudgetAlertNotifier) NotifyBudgetAlert(ctx context.Context, groupID string, alert *groupv1.BudgetAlert) {
+	n.alerts = append(n.alerts, alert)
+}
+
+func TestGroupService_UpdateBudgets(t *testing.T) {
+	groupID := uuid.New().String()
+
+	tests := []struct {
+		name          string
+		budgets       []*groupv1.Budget
+		expected      []*groupv1.Budget
+		expectedError string
+	}{
+		{
+			name: "whole group first and total by default",
+			budgets: []*groupv1.Budget{
+				{Category: " 食費 ", Amount: 30000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+				{Amount: 100000},
+			},
+			expected: []*groupv1.Budget{
+				{Amount: 100000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL},
+				{Category: "食費", Amount: 30000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+			},
+		},
+		{
+			name:          "duplicate category",
+			budgets:       []*groupv1.Budget{{Category: "食費", Amount: 1000}, {Category: "食費 ", Amount: 2000}},
+			expectedError: "カテゴリ「食費」の予算が重複しています",
+		},
+		{
+			name:          "two whole group budgets",
+			budgets:       []*groupv1.Budget{{Amount: 1000}, {Amount: 2000}},
+			expectedError: "グループ全体の予算が重複しています",
+		},
+		{
+			name:          "zero amount",
+			budgets:       []*groupv1.Budget{{Category: "食費"}},
+			expectedError: "予算は1円以上で入力してください",
+		},
+		{
+			name:          "unknown period",
+			budgets:       []*groupv1.Budget{{Amount: 1000, Period: groupv1.BudgetPeriod(9)}},
+			expectedError: "予算の期間が無効です",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			if tt.expectedError == "" {
+				mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{Id: groupID}, nil)
+				mockGroupRepo.On("ReplaceBudgets", groupID, tt.expected).Return(nil)
+			}
+
+			service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+			resp, err := service.UpdateBudgets(context.Background(), &groupv1.UpdateBudgetsRequest{
+				GroupId: groupID,
+				Budgets: tt.budgets,
+			})
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, resp.Budgets)
+			}
+
+			mockGroupRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_GetBudgetStatus(t *testing.T) {
+	groupID := uuid.New()
+
+	budgets := []*groupv1.Budget{
+		{Amount: 20000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL},
+		{Category: "食費", Amount: 10000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+	}
+	expenses := []*domain.Expense{
+		{Amount: 9000, Category: "食費", Kind: domain.ExpenseKindExpense, CreatedAt: time.Date(2024, 5, 31, 20, 0, 0, 0, time.Local)},
+		{Amount: 1000, Category: "食費", Kind: domain.ExpenseKindIncome, CreatedAt: time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)},
+		{Amount: 3000, Category: "食費", Kind: domain.ExpenseKindExpense, CreatedAt: time.Date(2024, 4, 30, 12, 0, 0, 0, time.Local)},
+		{Amount: 5000, Category: "交通費", Kind: domain.ExpenseKindExpense, CreatedAt: time.Date(2024, 5, 2, 12, 0, 0, 0, time.Local)},
+		{Amount: 4000, Kind: domain.ExpenseKindPayment, CreatedAt: time.Date(2024, 5, 3, 12, 0, 0, 0, time.Local)},
+	}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockGroupRepo.On("GetBudgets", groupID.String()).Return(budgets, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(expenses, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.GetBudgetStatus(context.Background(), &groupv1.GetBudgetStatusRequest{
+		GroupId: groupID.String(),
+		At:      timestamppb.New(time.Date(2024, 5, 15, 12, 0, 0, 0, time.Local)),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Statuses, 2)
+
+	total := resp.Statuses[0]
+	assert.Equal(t, int64(16000), total.Spent)
+	assert.Equal(t, int64(4000), total.Remaining)
+	assert.Equal(t, int32(80), total.PercentUsed)
+	assert.Nil(t, total.PeriodStart)
+
+	food := resp.Statuses[1]
+	assert.Equal(t, int64(8000), food.Spent)
+	assert.Equal(t, int32(80), food.PercentUsed)
+	assert.True(t, food.PeriodStart.AsTime().Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)))
+	assert.True(t, food.PeriodEnd.AsTime().Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local)))
+}
+
+func TestGroupService_UpdateExpense_BudgetAlerts(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+	createdAt := time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+
+	group := &groupv1.Group{
+		Id:      groupID.String(),
+		Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}},
+	}
+	budgets := []*groupv1.Budget{
+		{Amount: 10000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL},
+		{Category: "食費", Amount: 20000, Period: groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+	}
+
+	existing := &domain.Expense{
+		ID:        expenseID,
+		GroupID:   groupID,
+		Amount:    5000,
+		Category:  "食費",
+		Kind:      domain.ExpenseKindExpense,
+		PaidByID:  aliceID,
+		CreatedAt: createdAt,
+	}
+	other := &domain.Expense{
+		ID:        uuid.New(),
+		GroupID:   groupID,
+		Amount:    2000,
+		Kind:      domain.ExpenseKindExpense,
+		CreatedAt: createdAt.AddDate(0, 0, -1),
+	}
+	updated := *existing
+	updated.Amount = 9000
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(existing, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+	mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+	mockExpenseRepo.On("Update", mock.Anything, mock.Anything, domain.ExpenseChange{}).Return(nil)
+	mockGroupRepo.On("GetBudgets", groupID.String()).Return(budgets, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{&updated, other}, nil)
+
+	notifier := &recordingBudgetAlertNotifier{}
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo, WithBudgetAlertNotifier(notifier))
+
+	resp, err := service.UpdateExpense(context.Background(), &groupv1.UpdateExpenseRequest{
+		ExpenseId:      expenseID.String(),
+		Amount:         9000,
+		Description:    "焼肉",
+		Category:       "食費",
+		PaidById:       aliceID.String(),
+		SplitMemberIds: []string{aliceID.String()},
+	})
+
+	require.NoError(t, err)
+
+	// 7000 -> 11000 of the whole group budget crosses both thresholds, while
+	// 5000 -> 9000 of the food budget stays under 80%
+	require.Len(t, resp.BudgetAlerts, 2)
+	assert.Equal(t, int32(80), resp.BudgetAlerts[0].ThresholdPercent)
+	assert.Equal(t, int32(100), resp.BudgetAlerts[1].ThresholdPercent)
+	assert.Equal(t, int64(11000), resp.BudgetAlerts[1].Spent)
+	assert.Equal(t, expenseID.String(), resp.BudgetAlerts[1].ExpenseId)
+	assert.Equal(t, resp.BudgetAlerts, notifier.alerts)
+}
+
+func TestBudgetPeriod(t *testing.T) {
+	// Wednesday
+	at := time.Date(2024, 5, 15, 12, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name      string
+		period    groupv1.BudgetPeriod
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{name: "total", period: groupv1.BudgetPeriod_BUDGET_PERIOD_TOTAL},
+		{
+			name:      "monthly",
+			period:    groupv1.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+			wantStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local),
+			wantEnd:   time.Date(2024, 6, 1, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:      "weekly from Monday",
+			period:    groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY,
+			wantStart: time.Date(2024, 5, 13, 0, 0, 0, 0, time.Local),
+			wantEnd:   time.Date(2024, 5, 20, 0, 0, 0, 0, time.Local),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := budgetPeriod(tt.period, at)
+			assert.True(t, tt.wantStart.Equal(start), "start = %v", start)
+			assert.True(t, tt.wantEnd.Equal(end), "end = %v", end)
+		})
+	}
+
+	t.Run("Sunday belongs to the week before", func(t *testing.T) {
+		start, _ := budgetPeriod(groupv1.BudgetPeriod_BUDGET_PERIOD_WEEKLY, time.Date(2024, 5, 19, 23, 0, 0, 0, time.Local))
+		assert.True(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.Local).Equal(start))
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGroupService_CalculateSettlements_Success(t *testing.T) {
@@ -34,6 +36,7 @@ func TestGroupService_CalculateSettlements_Success(t *testing.T) {
 	}
 
 	mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse(groupID)).Return(nil, nil)
 
 	req := &groupv1.CalculateSettlementsRequest{
 		GroupId:  groupID,
@@ -72,7 +75,7 @@ func TestGroupService_CalculateSettlements_EmptyGroupID(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "group ID is required")
+	assert.Contains(t, err.Error(), "グループIDが無効です")
 }
 
 func TestGroupService_CalculateSettlements_GroupNotFound(t *testing.T) {
@@ -80,7 +83,7 @@ func TestGroupService_CalculateSettlements_GroupNotFound(t *testing.T) {
 	mockExpenseRepo := new(MockExpenseRepository)
 	service := NewGroupService(mockRepo, mockExpenseRepo)
 
-	groupID := "nonexistent-group"
+	groupID := uuid.New().String()
 	req := &groupv1.CalculateSettlementsRequest{
 		GroupId:  groupID,
 		Expenses: []*groupv1.Expense{},
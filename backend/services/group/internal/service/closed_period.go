@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ClosePeriod closes the books of a group up to a date. The balances and
+// settlements at that point are kept, expenses created up to the date can
+// no longer change, and only the net balances carry over into the next
+// period.
+func (s *GroupService) ClosePeriod(ctx context.Context, req *groupv1.ClosePeriodRequest) (*groupv1.ClosePeriodResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if req.ClosedBy != "" {
+		if err := validator.ValidateUUID(req.ClosedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+	}
+
+	now := time.Now()
+	until := now
+	if req.Until != nil {
+		until = req.Until.AsTime()
+		if until.After(now) {
+			return nil, errors.New("締め日に未来の日時は指定できません")
+		}
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ClosedBy != "" && findMember(group, req.ClosedBy) == nil {
+		return nil, errors.New("締めたメンバーがグループに存在しません")
+	}
+
+	previous, err := s.activeClosedPeriod(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	period := &domain.ClosedPeriod{
+		ID:          uuid.New().String(),
+		GroupID:     req.GroupId,
+		ClosedUntil: until,
+		ClosedBy:    req.ClosedBy,
+		ClosedAt:    now,
+	}
+	if previous != nil {
+		if !until.After(previous.ClosedUntil) {
+			return nil, errors.New("前回の締め日より後の日時を指定してください")
+		}
+		period.PeriodStart = &previous.ClosedUntil
+		period.OpeningBalances = previous.Balances
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var periodExpenses []*domain.Expense
+	for _, expense := range expenses {
+		if expense.CreatedAt.After(until) || (previous != nil && previous.Locks(expense.CreatedAt)) {
+			continue
+		}
+		// Whatever is still under review would change the balances later
+		if !expense.IsApproved() {
+			return nil, errors.New("承認待ちの支払いがあるため締められません")
+		}
+		periodExpenses = append(periodExpenses, expense)
+	}
+
+	balances := carriedBalances(period.OpeningBalances, memberBalances(group, periodExpenses))
+
+	settlements, err := algorithm.CalculateOptimalSettlements(balances)
+	if err != nil {
+		return nil, err
+	}
+
+	period.Balances = make([]domain.PeriodBalance, len(balances))
+	for i, balance := range balances {
+		period.Balances[i] = domain.PeriodBalance{
+			MemberID:   balance.MemberID,
+			MemberName: balance.Name,
+			Balance:    balance.Amount,
+		}
+	}
+
+	period.Settlements = make([]domain.PeriodSettlement, len(settlements))
+	for i, settlement := range settlements {
+		period.Settlements[i] = domain.PeriodSettlement{
+			FromMemberID: settlement.FromMemberID,
+			FromName:     settlement.FromName,
+			ToMemberID:   settlement.ToMemberID,
+			ToName:       settlement.ToName,
+			Amount:       settlement.Amount,
+		}
+	}
+
+	if err := s.repo.CreateClosedPeriod(period); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.ClosePeriodResponse{Period: toProtoClosedPeriod(period)}, nil
+}
+
+func (s *GroupService) ListClosedPeriods(ctx context.Context, req *groupv1.ListClosedPeriodsRequest) (*groupv1.ListClosedPeriodsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	periods, err := s.repo.GetClosedPeriods(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoPeriods := make([]*groupv1.ClosedPeriod, len(periods))
+	for i, period := range periods {
+		protoPeriods[i] = toProtoClosedPeriod(period)
+	}
+
+	return &groupv1.ListClosedPeriodsResponse{Periods: protoPeriods}, nil
+}
+
+// ReopenPeriod unlocks the latest closed period of a group again. Only the
+// group's admin may reopen, and earlier periods stay closed until the ones
+// after them are reopened.
+func (s *GroupService) ReopenPeriod(ctx context.Context, req *groupv1.ReopenPeriodRequest) (*groupv1.ReopenPeriodResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.PeriodId); err != nil {
+		return nil, errors.New("締め期間IDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.ReopenedBy); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	period, err := s.repo.GetClosedPeriod(req.PeriodId)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.repo.GetGroupSettings(period.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.AdminMemberId == "" {
+		return nil, errors.New("グループの管理者が設定されていません")
+	}
+	if settings.AdminMemberId != req.ReopenedBy {
+		return nil, errors.New("締め期間を再開できるのはグループの管理者のみです")
+	}
+
+	latest, err := s.activeClosedPeriod(period.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if latest == nil || latest.ID != period.ID {
+		return nil, errors.New("再開できるのは最新の締め期間のみです")
+	}
+
+	if err := s.repo.ReopenClosedPeriod(period.ID, req.ReopenedBy); err != nil {
+		return nil, err
+	}
+
+	reopenedAt := time.Now()
+	period.ReopenedAt = &reopenedAt
+	period.ReopenedBy = req.ReopenedBy
+
+	return &groupv1.ReopenPeriodResponse{Period: toProtoClosedPeriod(period)}, nil
+}
+
+// activeClosedPeriod returns the latest closed period of a group that was not
+// reopened, or nil when none of the group's expenses are locked
+func (s *GroupService) activeClosedPeriod(groupID string) (*domain.ClosedPeriod, error) {
+	periods, err := s.repo.GetClosedPeriods(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, period := range periods {
+		if period.ReopenedAt == nil {
+			return period, nil
+		}
+	}
+	return nil, nil
+}
+
+// checkExpenseUnlocked refuses changes to expenses of a closed period
+func (s *GroupService) checkExpenseUnlocked(expense *domain.Expense) error {
+	period, err := s.activeClosedPeriod(expense.GroupID.String())
+	if err != nil {
+		return err
+	}
+
+	if period != nil && period.Locks(expense.CreatedAt) {
+		return domain.ErrExpenseLocked
+	}
+	return nil
+}
+
+// carriedBalances adds the balances carried forward from the previous period
+// to the balances of the current one. Members who left the group keep their
+// carried balance so the total still adds up to zero.
+func carriedBalances(opening []domain.PeriodBalance, balances []algorithm.Balance) []algorithm.Balance {
+	index := make(map[string]int, len(balances))
+	for i, balance := range balances {
+		index[balance.MemberID] = i
+	}
+
+	for _, carried := range opening {
+		if i, ok := index[carried.MemberID]; ok {
+			balances[i].Amount += carried.Balance
+			continue
+		}
+		index[carried.MemberID] = len(balances)
+		balances = append(balances, algorithm.Balance{
+			MemberID: carried.MemberID,
+			Name:     carried.MemberName,
+			Amount:   carried.Balance,
+		})
+	}
+	return balances
+}
+
+func toProtoClosedPeriod(period *domain.ClosedPeriod) *groupv1.ClosedPeriod {
+	protoPeriod := &groupv1.ClosedPeriod{
+		Id:              period.ID,
+		GroupId:         period.GroupID,
+		ClosedUntil:     timestamppb.New(period.ClosedUntil),
+		OpeningBalances: toProtoPeriodBalances(period.OpeningBalances),
+		Balances:        toProtoPeriodBalances(period.Balances),
+		Settlements:     make([]*groupv1.Settlement, len(period.Settlements)),
+		ClosedBy:        period.ClosedBy,
+		ClosedAt:        timestamppb.New(period.ClosedAt),
+		ReopenedBy:      period.ReopenedBy,
+	}
+	if period.PeriodStart != nil {
+		protoPeriod.PeriodStart = timestamppb.New(*period.PeriodStart)
+	}
+	if period.ReopenedAt != nil {
+		protoPeriod.ReopenedAt = timestamppb.New(*period.ReopenedAt)
+	}
+
+	for i, settlement := range period.Settlements {
+		protoPeriod.Settlements[i] = &groupv1.Settlement{
+			FromMemberId: settlement.FromMemberID,
+			ToMemberId:   settlement.ToMemberID,
+			Amount:       settlement.Amount,
+			FromName:     settlement.FromName,
+			ToName:       settlement.ToName,
+		}
+	}
+
+	return protoPeriod
+}
+
+func toProtoPeriodBalances(balances []domain.PeriodBalance) []*groupv1.MemberBalance {
+	protoBalances := make([]*groupv1.MemberBalance, len(balances))
+	for i, balance := range balances {
+		protoBalances[i] = &groupv1.MemberBalance{
+			MemberId:   balance.MemberID,
+			MemberName: balance.MemberName,
+			Balance:    balance.Balance,
+		}
+	}
+	return protoBalances
+}
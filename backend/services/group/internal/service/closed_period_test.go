@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_ClosePeriod(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	group := &groupv1.Group{
+		Id: groupID.String(),
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+
+	april := time.Date(2024, 4, 30, 23, 59, 59, 0, time.Local)
+	may := time.Date(2024, 5, 31, 23, 59, 59, 0, time.Local)
+	previous := &domain.ClosedPeriod{
+		ID:          uuid.New().String(),
+		GroupID:     groupID.String(),
+		ClosedUntil: april,
+		Balances: []domain.PeriodBalance{
+			{MemberID: aliceID.String(), MemberName: "Alice", Balance: 2000},
+			{MemberID: bobID.String(), MemberName: "Bob", Balance: -2000},
+		},
+	}
+
+	expense := func(payerID uuid.UUID, amount int64, createdAt time.Time, status domain.ApprovalStatus) *domain.Expense {
+		return &domain.Expense{
+			ID:             uuid.New(),
+			GroupID:        groupID,
+			Amount:         amount,
+			Kind:           domain.ExpenseKindExpense,
+			PaidByID:       payerID,
+			CreatedAt:      createdAt,
+			ApprovalStatus: status,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, Amount: amount / 2},
+				{MemberID: bobID, Amount: amount / 2},
+			},
+		}
+	}
+
+	t.Run("carries the previous balances forward", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return([]*domain.ClosedPeriod{previous}, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{
+			// Already part of the previous period's balances
+			expense(aliceID, 4000, april.AddDate(0, 0, -3), domain.ApprovalStatusApproved),
+			expense(bobID, 1000, may.AddDate(0, 0, -10), domain.ApprovalStatusApproved),
+			// Belongs to the next period
+			expense(bobID, 9000, may.Add(time.Hour), domain.ApprovalStatusPending),
+		}, nil)
+		mockGroupRepo.On("CreateClosedPeriod", mock.AnythingOfType("*domain.ClosedPeriod")).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.ClosePeriod(context.Background(), &groupv1.ClosePeriodRequest{
+			GroupId:  groupID.String(),
+			Until:    timestamppb.New(may),
+			ClosedBy: aliceID.String(),
+		})
+
+		require.NoError(t, err)
+		period := resp.Period
+		assert.True(t, april.Equal(period.PeriodStart.AsTime()))
+		assert.True(t, may.Equal(period.ClosedUntil.AsTime()))
+		require.Len(t, period.OpeningBalances, 2)
+		assert.Equal(t, int64(2000), period.OpeningBalances[0].Balance)
+		require.Len(t, period.Balances, 2)
+		assert.Equal(t, int64(1500), period.Balances[0].Balance)
+		assert.Equal(t, int64(-1500), period.Balances[1].Balance)
+		require.Len(t, period.Settlements, 1)
+		assert.Equal(t, bobID.String(), period.Settlements[0].FromMemberId)
+		assert.Equal(t, int64(1500), period.Settlements[0].Amount)
+		assert.Equal(t, aliceID.String(), period.ClosedBy)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("pending expenses", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{
+			expense(bobID, 1000, may.AddDate(0, 0, -10), domain.ApprovalStatusPending),
+		}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		_, err := service.ClosePeriod(context.Background(), &groupv1.ClosePeriodRequest{
+			GroupId: groupID.String(),
+			Until:   timestamppb.New(may),
+		})
+
+		assert.EqualError(t, err, "承認待ちの支払いがあるため締められません")
+		mockGroupRepo.AssertNotCalled(t, "CreateClosedPeriod", mock.Anything)
+	})
+
+	t.Run("not after the previous period", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return([]*domain.ClosedPeriod{previous}, nil)
+
+		service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+		_, err := service.ClosePeriod(context.Background(), &groupv1.ClosePeriodRequest{
+			GroupId: groupID.String(),
+			Until:   timestamppb.New(april),
+		})
+
+		assert.EqualError(t, err, "前回の締め日より後の日時を指定してください")
+	})
+
+	t.Run("future date", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		_, err := service.ClosePeriod(context.Background(), &groupv1.ClosePeriodRequest{
+			GroupId: groupID.String(),
+			Until:   timestamppb.New(time.Now().Add(time.Hour)),
+		})
+
+		assert.EqualError(t, err, "締め日に未来の日時は指定できません")
+	})
+}
+
+func TestGroupService_ReopenPeriod(t *testing.T) {
+	groupID := uuid.New().String()
+	adminID := uuid.New().String()
+	latest := &domain.ClosedPeriod{ID: uuid.New().String(), GroupID: groupID, ClosedUntil: time.Now()}
+	earlier := &domain.ClosedPeriod{ID: uuid.New().String(), GroupID: groupID, ClosedUntil: time.Now().AddDate(0, -1, 0)}
+
+	tests := []struct {
+		name          string
+		period        *domain.ClosedPeriod
+		reopenedBy    string
+		settings      *groupv1.GroupSettings
+		expectedError string
+	}{
+		{
+			name:       "admin reopens the latest period",
+			period:     latest,
+			reopenedBy: adminID,
+			settings:   &groupv1.GroupSettings{AdminMemberId: adminID},
+		},
+		{
+			name:          "earlier period",
+			period:        earlier,
+			reopenedBy:    adminID,
+			settings:      &groupv1.GroupSettings{AdminMemberId: adminID},
+			expectedError: "再開できるのは最新の締め期間のみです",
+		},
+		{
+			name:          "not the admin",
+			period:        latest,
+			reopenedBy:    uuid.New().String(),
+			settings:      &groupv1.GroupSettings{AdminMemberId: adminID},
+			expectedError: "締め期間を再開できるのはグループの管理者のみです",
+		},
+		{
+			name:          "no admin",
+			period:        latest,
+			reopenedBy:    adminID,
+			settings:      &groupv1.GroupSettings{},
+			expectedError: "グループの管理者が設定されていません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Reopening marks the period the repository returned
+			period := *tt.period
+			periods := []*domain.ClosedPeriod{latest, earlier}
+			for i, p := range periods {
+				if p.ID == period.ID {
+					periods[i] = &period
+				}
+			}
+
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			mockGroupRepo.On("GetClosedPeriod", tt.period.ID).Return(&period, nil)
+			mockGroupRepo.On("GetGroupSettings", groupID).Return(tt.settings, nil)
+			mockGroupRepo.On("GetClosedPeriods", groupID).Return(periods, nil)
+			if tt.expectedError == "" {
+				mockGroupRepo.On("ReopenClosedPeriod", tt.period.ID, tt.reopenedBy).Return(nil)
+			}
+
+			service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+			resp, err := service.ReopenPeriod(context.Background(), &groupv1.ReopenPeriodRequest{
+				PeriodId:   tt.period.ID,
+				ReopenedBy: tt.reopenedBy,
+			})
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				mockGroupRepo.AssertNotCalled(t, "ReopenClosedPeriod", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, resp.Period.ReopenedAt)
+			assert.Equal(t, adminID, resp.Period.ReopenedBy)
+			mockGroupRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_ClosedPeriodLocksExpenses(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	closedUntil := time.Now().AddDate(0, 0, -1)
+	existing := &domain.Expense{
+		ID:        expenseID,
+		GroupID:   groupID,
+		Amount:    3000,
+		CreatedAt: closedUntil.Add(-time.Hour),
+	}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(existing, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return([]*domain.ClosedPeriod{
+		{ID: uuid.New().String(), GroupID: groupID.String(), ClosedUntil: closedUntil},
+	}, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	_, err := service.UpdateExpense(context.Background(), &groupv1.UpdateExpenseRequest{
+		ExpenseId:      expenseID.String(),
+		Amount:         2500,
+		Description:    "Lunch",
+		PaidById:       uuid.New().String(),
+		SplitMemberIds: []string{uuid.New().String()},
+	})
+	assert.ErrorIs(t, err, domain.ErrExpenseLocked)
+
+	_, err = service.DeleteExpense(context.Background(), &groupv1.DeleteExpenseRequest{
+		ExpenseId: expenseID.String(),
+	})
+	assert.ErrorIs(t, err, domain.ErrExpenseLocked)
+	mockExpenseRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	mockExpenseRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errNotCommentAuthor = errors.New("コメントを編集・削除できるのは投稿したメンバーのみです")
+
+func (s *GroupService) AddExpenseComment(ctx context.Context, req *groupv1.AddExpenseCommentRequest) (*groupv1.AddExpenseCommentResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := validator.ValidateCommentBody(req.Body); err != nil {
+		return nil, err
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	memberID, err := uuid.Parse(req.MemberId)
+	if err != nil {
+		return nil, errors.New("invalid member ID")
+	}
+
+	expense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only members of the expense's group may comment
+	group, err := s.repo.GetGroupByID(expense.GroupID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var memberName string
+	for _, member := range group.Members {
+		if member.Id == memberID.String() {
+			memberName = member.Name
+			break
+		}
+	}
+	if memberName == "" {
+		return nil, errors.New("コメントできるのはグループのメンバーのみです")
+	}
+
+	now := time.Now()
+	comment := &domain.Comment{
+		ID:         uuid.New(),
+		ExpenseID:  expenseID,
+		MemberID:   memberID,
+		MemberName: memberName,
+		Body:       strings.TrimSpace(req.Body),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.expenseRepo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.AddExpenseCommentResponse{
+		Comment: toProtoComment(comment),
+	}, nil
+}
+
+func (s *GroupService) UpdateExpenseComment(ctx context.Context, req *groupv1.UpdateExpenseCommentRequest) (*groupv1.UpdateExpenseCommentResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.CommentId); err != nil {
+		return nil, errors.New("コメントIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := validator.ValidateCommentBody(req.Body); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.findOwnComment(ctx, req.CommentId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Body = strings.TrimSpace(req.Body)
+	comment.UpdatedAt = time.Now()
+
+	if err := s.expenseRepo.UpdateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateExpenseCommentResponse{
+		Comment: toProtoComment(comment),
+	}, nil
+}
+
+func (s *GroupService) DeleteExpenseComment(ctx context.Context, req *groupv1.DeleteExpenseCommentRequest) (*groupv1.DeleteExpenseCommentResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.CommentId); err != nil {
+		return nil, errors.New("コメントIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	comment, err := s.findOwnComment(ctx, req.CommentId, req.MemberId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.expenseRepo.DeleteComment(ctx, comment.ID); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.DeleteExpenseCommentResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *GroupService) ListExpenseComments(ctx context.Context, req *groupv1.ListExpenseCommentsRequest) (*groupv1.ListExpenseCommentsResponse, error) {
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	comments, err := s.expenseRepo.FindCommentsByExpenseID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoComments := make([]*groupv1.Comment, len(comments))
+	for i, comment := range comments {
+		protoComments[i] = toProtoComment(comment)
+	}
+
+	return &groupv1.ListExpenseCommentsResponse{
+		Comments: protoComments,
+	}, nil
+}
+
+// findOwnComment loads a comment and checks that memberID is its author
+func (s *GroupService) findOwnComment(ctx context.Context, commentID, memberID string) (*domain.Comment, error) {
+	id, err := uuid.Parse(commentID)
+	if err != nil {
+		return nil, errors.New("invalid comment ID")
+	}
+
+	comment, err := s.expenseRepo.FindCommentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(comment.MemberID.String(), memberID) {
+		return nil, errNotCommentAuthor
+	}
+
+	return comment, nil
+}
+
+func toProtoComment(comment *domain.Comment) *groupv1.Comment {
+	return &groupv1.Comment{
+		Id:         comment.ID.String(),
+		ExpenseId:  comment.ExpenseID.String(),
+		MemberId:   comment.MemberID.String(),
+		MemberName: comment.MemberName,
+		Body:       comment.Body,
+		CreatedAt:  timestamppb.New(comment.CreatedAt),
+		UpdatedAt:  timestamppb.New(comment.UpdatedAt),
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_AddExpenseComment(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	memberID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:      groupID.String(),
+		Members: []*groupv1.Member{{Id: memberID.String(), Name: "Alice"}},
+	}
+
+	tests := []struct {
+		name          string
+		request       *groupv1.AddExpenseCommentRequest
+		setupMocks    func(*MockGroupRepositoryInterface, *MockExpenseRepository)
+		expectedError string
+	}{
+		{
+			name:    "successful comment",
+			request: &groupv1.AddExpenseCommentRequest{ExpenseId: expenseID.String(), MemberId: memberID.String(), Body: "  I didn't drink, remove me  "},
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, GroupID: groupID}, nil)
+				groupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				expenseRepo.On("CreateComment", mock.Anything, mock.MatchedBy(func(comment *domain.Comment) bool {
+					return comment.ExpenseID == expenseID &&
+						comment.MemberID == memberID &&
+						comment.Body == "I didn't drink, remove me"
+				})).Return(nil)
+			},
+		},
+		{
+			name:          "empty body",
+			request:       &groupv1.AddExpenseCommentRequest{ExpenseId: expenseID.String(), MemberId: memberID.String(), Body: " "},
+			setupMocks:    func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {},
+			expectedError: "コメントは必須です",
+		},
+		{
+			name:    "member outside the group",
+			request: &groupv1.AddExpenseCommentRequest{ExpenseId: expenseID.String(), MemberId: uuid.New().String(), Body: "hello"},
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, GroupID: groupID}, nil)
+				groupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+			},
+			expectedError: "グループのメンバーのみ",
+		},
+		{
+			name:    "expense not found",
+			request: &groupv1.AddExpenseCommentRequest{ExpenseId: expenseID.String(), MemberId: memberID.String(), Body: "hello"},
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				expenseRepo.On("FindByID", mock.Anything, expenseID).Return(nil, domain.ErrExpenseNotFound)
+			},
+			expectedError: "expense not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			mockExpenseRepo := new(MockExpenseRepository)
+			tt.setupMocks(mockGroupRepo, mockExpenseRepo)
+
+			service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+			resp, err := service.AddExpenseComment(context.Background(), tt.request)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "Alice", resp.Comment.MemberName)
+				assert.Equal(t, "I didn't drink, remove me", resp.Comment.Body)
+			}
+
+			mockGroupRepo.AssertExpectations(t)
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_UpdateExpenseComment(t *testing.T) {
+	authorID := uuid.New()
+	comment := &domain.Comment{ID: uuid.New(), ExpenseID: uuid.New(), MemberID: authorID, MemberName: "Alice", Body: "before"}
+
+	t.Run("author can edit", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindCommentByID", mock.Anything, comment.ID).Return(&domain.Comment{
+			ID: comment.ID, ExpenseID: comment.ExpenseID, MemberID: authorID, MemberName: "Alice", Body: "before",
+		}, nil)
+		mockExpenseRepo.On("UpdateComment", mock.Anything, mock.MatchedBy(func(c *domain.Comment) bool {
+			return c.ID == comment.ID && c.Body == "after"
+		})).Return(nil)
+
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+		resp, err := service.UpdateExpenseComment(context.Background(), &groupv1.UpdateExpenseCommentRequest{
+			CommentId: comment.ID.String(),
+			MemberId:  authorID.String(),
+			Body:      "after",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "after", resp.Comment.Body)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("other member cannot edit", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindCommentByID", mock.Anything, comment.ID).Return(comment, nil)
+
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+		resp, err := service.UpdateExpenseComment(context.Background(), &groupv1.UpdateExpenseCommentRequest{
+			CommentId: comment.ID.String(),
+			MemberId:  uuid.New().String(),
+			Body:      "after",
+		})
+
+		assert.ErrorIs(t, err, errNotCommentAuthor)
+		assert.Nil(t, resp)
+		mockExpenseRepo.AssertNotCalled(t, "UpdateComment", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGroupService_DeleteExpenseComment(t *testing.T) {
+	authorID := uuid.New()
+	comment := &domain.Comment{ID: uuid.New(), MemberID: authorID}
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindCommentByID", mock.Anything, comment.ID).Return(comment, nil)
+	mockExpenseRepo.On("DeleteComment", mock.Anything, comment.ID).Return(nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+	_, err := service.DeleteExpenseComment(context.Background(), &groupv1.DeleteExpenseCommentRequest{
+		CommentId: comment.ID.String(),
+		MemberId:  uuid.New().String(),
+	})
+	assert.ErrorIs(t, err, errNotCommentAuthor)
+
+	resp, err := service.DeleteExpenseComment(context.Background(), &groupv1.DeleteExpenseCommentRequest{
+		CommentId: comment.ID.String(),
+		MemberId:  authorID.String(),
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	mockExpenseRepo.AssertNumberOfCalls(t, "DeleteComment", 1)
+}
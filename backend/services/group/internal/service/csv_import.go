@@ -0,0 +1,395 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"golang.org/x/text/width"
+)
+
+const defaultParticipantSeparator = ";"
+
+// Accepted date formats of the date column, read in the server's time zone
+var csvDateLayouts = []string{"2006-01-02", "2006/01/02", "2006/1/2", "2006-1-2"}
+
+// csvColumns holds the header names of the columns to read
+type csvColumns struct {
+	amount       string
+	description  string
+	paidBy       string
+	participants string
+	category     string
+	date         string
+}
+
+// csvRecord is one data row of the file, by logical column
+type csvRecord struct {
+	line         int
+	amount       string
+	description  string
+	paidBy       string
+	participants string
+	category     string
+	date         string
+}
+
+func (s *GroupService) ImportExpensesCsv(ctx context.Context, req *groupv1.ImportExpensesCsvRequest) (*groupv1.ImportExpensesCsvResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateImportFileSize(len(req.Csv)); err != nil {
+		return nil, err
+	}
+
+	columns := csvColumnsFromProto(req.Mapping)
+	records, err := readCsvRecords(req.Csv, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateImportRowCount(len(records)); err != nil {
+		return nil, err
+	}
+
+	separator := req.ParticipantSeparator
+	if separator == "" {
+		separator = defaultParticipantSeparator
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := s.repo.GetMemberActivePeriods(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	closedPeriod, err := s.activeClosedPeriod(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	membersByName := make(map[string]*groupv1.Member, len(group.Members))
+	for _, member := range group.Members {
+		membersByName[normalizeMemberName(member.Name)] = member
+	}
+
+	resp := &groupv1.ImportExpensesCsvResponse{TotalRows: int32(len(records))}
+	unmatched := make(map[string]bool)
+	addUnmatched := func(name string) {
+		if !unmatched[name] {
+			unmatched[name] = true
+			resp.UnmatchedNames = append(resp.UnmatchedNames, name)
+		}
+	}
+
+	now := time.Now()
+	expenses := make([]*domain.Expense, 0, len(records))
+	for i, record := range records {
+		rowError := func(column, message string) {
+			resp.Errors = append(resp.Errors, &groupv1.CsvRowError{
+				Line:    int32(record.line),
+				Column:  column,
+				Message: message,
+			})
+		}
+		valid := true
+
+		amount, err := parseCsvAmount(record.amount)
+		if err != nil {
+			rowError(columns.amount, "金額を数値として読み取れません")
+			valid = false
+		}
+
+		entry := &groupv1.AddExpenseRequest{
+			Amount:      amount,
+			Description: strings.TrimSpace(record.description),
+			Category:    record.category,
+		}
+
+		paidBy := strings.TrimSpace(record.paidBy)
+		if payer, found := membersByName[normalizeMemberName(paidBy)]; found {
+			entry.PaidById = payer.Id
+		} else if paidBy == "" {
+			rowError(columns.paidBy, "支払者は必須です")
+			valid = false
+		} else {
+			addUnmatched(paidBy)
+			rowError(columns.paidBy, fmt.Sprintf("メンバー「%s」がグループにいません", paidBy))
+			valid = false
+		}
+
+		participants := splitParticipants(record.participants, separator)
+		for _, name := range participants {
+			member, found := membersByName[normalizeMemberName(name)]
+			if !found {
+				addUnmatched(name)
+				rowError(columns.participants, fmt.Sprintf("メンバー「%s」がグループにいません", name))
+				valid = false
+				continue
+			}
+			entry.SplitMemberIds = append(entry.SplitMemberIds, member.Id)
+		}
+
+		// Keep the file order among rows of the same day
+		createdAt := now.Add(time.Duration(i) * time.Microsecond)
+		if strings.TrimSpace(record.date) != "" {
+			date, err := parseCsvDate(record.date)
+			if err != nil {
+				rowError(columns.date, "日付はYYYY-MM-DDの形式で入力してください")
+				valid = false
+			} else {
+				createdAt = date.Add(time.Duration(i) * time.Microsecond)
+			}
+		}
+
+		// The books are closed up to the close date
+		if closedPeriod != nil && closedPeriod.Locks(createdAt) {
+			rowError(columns.date, "締め済みの期間の日付では取り込めません")
+			valid = false
+		}
+
+		if len(participants) == 0 {
+			// An empty participants cell splits among everybody present that day
+			entry.SplitMemberIds, err = presentMemberIDs(periods, createdAt)
+			if err != nil {
+				rowError(columns.participants, "この日に在籍しているメンバーがいません")
+				valid = false
+			}
+		}
+
+		if !valid {
+			continue
+		}
+
+		kind, err := validateNewExpense(entry)
+		if err == nil {
+			var expense *domain.Expense
+			expense, err = newExpense(group, groupID, entry, kind, createdAt)
+			if err == nil {
+				expenses = append(expenses, expense)
+				continue
+			}
+		}
+
+		var validationErr validator.ValidationError
+		if errors.As(err, &validationErr) {
+			rowError(columns.forField(validationErr.Field), validationErr.Message)
+		} else {
+			rowError("", err.Error())
+		}
+	}
+
+	approvalStatus, err := s.newExpenseApprovalStatus(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, expense := range expenses {
+		expense.ApprovalStatus = approvalStatus
+	}
+
+	resp.Expenses = make([]*groupv1.ExpenseWithDetails, len(expenses))
+	for i, expense := range expenses {
+		resp.Expenses[i] = toProtoExpense(expense)
+	}
+
+	if req.DryRun || len(resp.Errors) > 0 {
+		return resp, nil
+	}
+
+	if err := s.expenseRepo.CreateBatch(ctx, expenses); err != nil {
+		return nil, err
+	}
+	resp.Committed = true
+
+	return resp, nil
+}
+
+func csvColumnsFromProto(mapping *groupv1.CsvColumnMapping) csvColumns {
+	columns := csvColumns{
+		amount:       "amount",
+		description:  "description",
+		paidBy:       "paid_by",
+		participants: "participants",
+		category:     "category",
+		date:         "date",
+	}
+	if mapping == nil {
+		return columns
+	}
+
+	set := func(column *string, name string) {
+		if name = strings.TrimSpace(name); name != "" {
+			*column = name
+		}
+	}
+	set(&columns.amount, mapping.Amount)
+	set(&columns.description, mapping.Description)
+	set(&columns.paidBy, mapping.PaidBy)
+	set(&columns.participants, mapping.Participants)
+	set(&columns.category, mapping.Category)
+	set(&columns.date, mapping.Date)
+	return columns
+}
+
+// forField maps a validation error field of an expense to its CSV column
+func (c csvColumns) forField(field string) string {
+	switch field {
+	case "amount":
+		return c.amount
+	case "description":
+		return c.description
+	case "paidById":
+		return c.paidBy
+	case "splitMemberIds":
+		return c.participants
+	case "category":
+		return c.category
+	default:
+		return ""
+	}
+}
+
+// readCsvRecords reads the data rows of a CSV file by the given header
+// names. Category and date are optional columns; blank lines are skipped.
+func readCsvRecords(data []byte, columns csvColumns) ([]csvRecord, error) {
+	// Spreadsheet applications often prepend a byte order mark
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, validator.ValidationError{Field: "csv", Message: "ヘッダー行がありません"}
+	}
+	if err != nil {
+		return nil, validator.ValidationError{Field: "csv", Message: "CSVを読み込めません: " + err.Error()}
+	}
+
+	headerIndex := make(map[string]int, len(header))
+	for i, name := range header {
+		headerIndex[normalizeMemberName(name)] = i
+	}
+	columnIndex := func(name string, required bool) (int, error) {
+		if i, found := headerIndex[normalizeMemberName(name)]; found {
+			return i, nil
+		}
+		if required {
+			return 0, validator.ValidationError{Field: "mapping", Message: fmt.Sprintf("列「%s」が見つかりません", name)}
+		}
+		return -1, nil
+	}
+
+	var indexes [6]int
+	for i, column := range []struct {
+		name     string
+		required bool
+	}{
+		{columns.amount, true},
+		{columns.description, true},
+		{columns.paidBy, true},
+		{columns.participants, true},
+		{columns.category, false},
+		{columns.date, false},
+	} {
+		if indexes[i], err = columnIndex(column.name, column.required); err != nil {
+			return nil, err
+		}
+	}
+
+	var records []csvRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, validator.ValidationError{Field: "csv", Message: "CSVを読み込めません: " + err.Error()}
+		}
+
+		if strings.TrimSpace(strings.Join(fields, "")) == "" {
+			continue
+		}
+
+		field := func(index int) string {
+			if index < 0 || index >= len(fields) {
+				return ""
+			}
+			return fields[index]
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{
+			line:         line,
+			amount:       field(indexes[0]),
+			description:  field(indexes[1]),
+			paidBy:       field(indexes[2]),
+			participants: field(indexes[3]),
+			category:     field(indexes[4]),
+			date:         field(indexes[5]),
+		})
+	}
+
+	return records, nil
+}
+
+// normalizeMemberName folds case and full-width/half-width variants so that
+// "ＡＬＩＣＥ", "alice" and "Alice" match, as do "ﾀﾛｳ" and "タロウ"
+func normalizeMemberName(name string) string {
+	name = width.Fold.String(strings.TrimSpace(name))
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func splitParticipants(value, separator string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, separator) {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[normalizeMemberName(name)] {
+			continue
+		}
+		seen[normalizeMemberName(name)] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// parseCsvAmount reads amounts such as "1200", "1,200", "¥1,200" or "１２００円"
+func parseCsvAmount(value string) (int64, error) {
+	value = width.Fold.String(value)
+	value = strings.NewReplacer(",", "", "¥", "", "\\", "", "円", "", " ", "").Replace(value)
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func parseCsvDate(value string) (time.Time, error) {
+	value = width.Fold.String(strings.TrimSpace(value))
+	var err error
+	for _, layout := range csvDateLayouts {
+		var date time.Time
+		if date, err = time.ParseInLocation(layout, value, time.Local); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, err
+}
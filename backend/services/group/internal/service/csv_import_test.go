@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_ImportExpensesCsv(t *testing.T) {
+	groupID := "550e8400-e29b-41d4-a716-446655440000"
+	aliceID := "550e8400-e29b-41d4-a716-446655440001"
+	taroID := "550e8400-e29b-41d4-a716-446655440002"
+
+	group := &groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: taroID, Name: "タロウ"},
+		},
+	}
+
+	newService := func(expenseRepo *MockExpenseRepository, closedPeriods ...*domain.ClosedPeriod) *GroupService {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockRepo.On("GetMemberActivePeriods", groupID).Return([]*domain.MemberActivePeriod{
+			{MemberID: aliceID},
+			{MemberID: taroID},
+		}, nil)
+		mockRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{}, nil)
+		mockRepo.On("GetClosedPeriods", groupID).Return(closedPeriods, nil)
+		return NewGroupService(mockRepo, expenseRepo)
+	}
+
+	t.Run("maps columns, matches names and commits", func(t *testing.T) {
+		csv := "\ufeff日付,内容,金額,払った人,対象\n" +
+			"2024/05/01,ランチ,\"¥3,000\",ＡＬＩＣＥ,alice;ﾀﾛｳ\n" +
+			"\n" +
+			"2024-05-02,タクシー,１２００円,タロウ,\n"
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(expenses []*domain.Expense) bool {
+			return len(expenses) == 2 &&
+				expenses[0].Amount == 3000 &&
+				expenses[0].PaidByName == "Alice" &&
+				len(expenses[0].SplitMembers) == 2 &&
+				expenses[0].CreatedAt.Format(time.DateOnly) == "2024-05-01" &&
+				expenses[1].Amount == 1200 &&
+				len(expenses[1].SplitMembers) == 2
+		})).Return(nil)
+
+		resp, err := newService(mockExpenseRepo).ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+			GroupId: groupID,
+			Csv:     []byte(csv),
+			Mapping: &groupv1.CsvColumnMapping{
+				Amount:       "金額",
+				Description:  "内容",
+				PaidBy:       "払った人",
+				Participants: "対象",
+				Date:         "日付",
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.TotalRows)
+		assert.Empty(t, resp.Errors)
+		assert.Empty(t, resp.UnmatchedNames)
+		assert.True(t, resp.Committed)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("reports unmatched names and invalid rows without saving", func(t *testing.T) {
+		csv := "amount,description,paid_by,participants\n" +
+			"1000,Lunch,Alice,Alice;Bob\n" +
+			"abc,Taxi,Carol,Alice\n" +
+			"500,Coffee,Alice,Alice\n"
+
+		mockExpenseRepo := new(MockExpenseRepository)
+
+		resp, err := newService(mockExpenseRepo).ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+			GroupId: groupID,
+			Csv:     []byte(csv),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bob", "Carol"}, resp.UnmatchedNames)
+		assert.Equal(t, []*groupv1.CsvRowError{
+			{Line: 2, Column: "participants", Message: "メンバー「Bob」がグループにいません"},
+			{Line: 3, Column: "amount", Message: "金額を数値として読み取れません"},
+			{Line: 3, Column: "paid_by", Message: "メンバー「Carol」がグループにいません"},
+		}, resp.Errors)
+		require.Len(t, resp.Expenses, 1)
+		assert.Equal(t, "Coffee", resp.Expenses[0].Description)
+		assert.False(t, resp.Committed)
+		mockExpenseRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects rows dated in a closed period", func(t *testing.T) {
+		closedPeriod := &domain.ClosedPeriod{
+			ID:          "550e8400-e29b-41d4-a716-446655440009",
+			GroupID:     groupID,
+			ClosedUntil: time.Date(2024, 5, 1, 23, 59, 59, 0, time.Local),
+		}
+		csv := "date,amount,description,paid_by,participants\n" +
+			"2024-05-01,1000,Lunch,Alice,Alice\n" +
+			"2024-05-02,500,Coffee,Alice,Alice\n"
+
+		mockExpenseRepo := new(MockExpenseRepository)
+
+		resp, err := newService(mockExpenseRepo, closedPeriod).ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+			GroupId: groupID,
+			Csv:     []byte(csv),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []*groupv1.CsvRowError{
+			{Line: 2, Column: "date", Message: "締め済みの期間の日付では取り込めません"},
+		}, resp.Errors)
+		assert.False(t, resp.Committed)
+		mockExpenseRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("dry run does not save", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+
+		resp, err := newService(mockExpenseRepo).ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+			GroupId: groupID,
+			Csv:     []byte("amount,description,paid_by,participants\n1000,Lunch,Alice,\n"),
+			DryRun:  true,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Errors)
+		assert.Len(t, resp.Expenses, 1)
+		assert.False(t, resp.Committed)
+		mockExpenseRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		_, err := newService(new(MockExpenseRepository)).ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+			GroupId: groupID,
+			Csv:     []byte("amount,description,paid_by\n1000,Lunch,Alice\n"),
+		})
+
+		assert.EqualError(t, err, "mapping: 列「participants」が見つかりません")
+	})
+}
+
+func TestGroupService_ImportExpensesCsv_RequiresApproval(t *testing.T) {
+	groupID := "550e8400-e29b-41d4-a716-446655440000"
+	aliceID := "550e8400-e29b-41d4-a716-446655440001"
+
+	mockRepo := new(MockGroupRepositoryInterface)
+	mockRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members:  []*groupv1.Member{{Id: aliceID, Name: "Alice"}},
+	}, nil)
+	mockRepo.On("GetMemberActivePeriods", groupID).Return([]*domain.MemberActivePeriod{{MemberID: aliceID}}, nil)
+	mockRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{RequireExpenseApproval: true}, nil)
+	mockRepo.On("GetClosedPeriods", groupID).Return(nil, nil)
+
+	// Imported expenses wait for approval like ones added by hand
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(expenses []*domain.Expense) bool {
+		return len(expenses) == 1 && expenses[0].ApprovalStatus == domain.ApprovalStatusPending
+	})).Return(nil)
+
+	service := NewGroupService(mockRepo, mockExpenseRepo)
+	resp, err := service.ImportExpensesCsv(context.Background(), &groupv1.ImportExpensesCsvRequest{
+		GroupId: groupID,
+		Csv:     []byte("amount,description,paid_by,participants\n1000,Lunch,Alice,Alice\n"),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Committed)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestNormalizeMemberName(t *testing.T) {
+	assert.Equal(t, normalizeMemberName("Alice"), normalizeMemberName("ＡＬＩＣＥ"))
+	assert.Equal(t, normalizeMemberName("タロウ"), normalizeMemberName("ﾀﾛｳ"))
+	assert.Equal(t, normalizeMemberName("山田 太郎"), normalizeMemberName(" 山田　 太郎 "))
+	assert.NotEqual(t, normalizeMemberName("Alice"), normalizeMemberName("Alicia"))
+}
+
+func TestParseCsvAmount(t *testing.T) {
+	for input, want := range map[string]int64{
+		"1200":   1200,
+		"1,200":  1200,
+		"¥1,200": 1200,
+		"￥１，２００": 1200,
+		"1200円":  1200,
+	} {
+		got, err := parseCsvAmount(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := parseCsvAmount("12.5")
+	assert.Error(t, err)
+}
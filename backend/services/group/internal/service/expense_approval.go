@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+func (s *GroupService) GetGroupSettings(ctx context.Context, req *groupv1.GetGroupSettingsRequest) (*groupv1.GetGroupSettingsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	settings, err := s.repo.GetGroupSettings(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetGroupSettingsResponse{Settings: settings}, nil
+}
+
+// UpdateGroupSettings replaces the settings of a group. Turning approval off
+// leaves expenses already under review as they are.
+func (s *GroupService) UpdateGroupSettings(ctx context.Context, req *groupv1.UpdateGroupSettingsRequest) (*groupv1.UpdateGroupSettingsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if req.Settings == nil {
+		return nil, errors.New("設定を指定してください")
+	}
+
+	// Validate group exists
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Settings.AdminMemberId != "" && findMember(group, req.Settings.AdminMemberId) == nil {
+		return nil, errors.New("管理者に指定したメンバーがグループに存在しません")
+	}
+
+	if err := s.repo.SaveGroupSettings(req.GroupId, req.Settings); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateGroupSettingsResponse{Settings: req.Settings}, nil
+}
+
+// VoteOnExpense records a split member's approval or dispute of an expense
+// under review. The expense counts towards balances once every split member
+// approved it.
+func (s *GroupService) VoteOnExpense(ctx context.Context, req *groupv1.VoteOnExpenseRequest) (*groupv1.VoteOnExpenseResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	vote, err := approvalVoteFromProto(req.Vote)
+	if err != nil {
+		return nil, err
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	memberID, err := uuid.Parse(req.MemberId)
+	if err != nil {
+		return nil, errors.New("invalid member ID")
+	}
+
+	expense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expense.IsApproved() {
+		return nil, domain.ErrExpenseAlreadyApproved
+	}
+
+	now := time.Now()
+	voted := false
+	for i := range expense.SplitMembers {
+		if expense.SplitMembers[i].MemberID == memberID {
+			expense.SplitMembers[i].Vote = vote
+			expense.SplitMembers[i].VotedAt = &now
+			voted = true
+		}
+	}
+	if !voted {
+		return nil, errors.New("分配先のメンバーのみ承認できます")
+	}
+
+	// The status comes from the votes as saved, which may include votes cast
+	// since the expense was read here
+	expense.ApprovalStatus, err = s.expenseRepo.SaveVote(ctx, expenseID, memberID, vote, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.VoteOnExpenseResponse{Expense: toProtoExpense(expense)}, nil
+}
+
+// newExpenseApprovalStatus is the status new expenses of a group start with
+func (s *GroupService) newExpenseApprovalStatus(groupID string) (domain.ApprovalStatus, error) {
+	settings, err := s.repo.GetGroupSettings(groupID)
+	if err != nil {
+		return "", err
+	}
+
+	if settings.RequireExpenseApproval {
+		return domain.ApprovalStatusPending, nil
+	}
+	return domain.ApprovalStatusApproved, nil
+}
+
+// editedExpenseApprovalStatus is the status of an expense after an edit. The
+// votes are cleared by the edit, so an expense already under review starts
+// over even if the group no longer asks for approval.
+func (s *GroupService) editedExpenseApprovalStatus(existing *domain.Expense) (domain.ApprovalStatus, error) {
+	if !existing.IsApproved() {
+		return domain.ApprovalStatusPending, nil
+	}
+	return s.newExpenseApprovalStatus(existing.GroupID.String())
+}
+
+// unapprovedExpenseIDs returns the IDs of the saved expenses of a group that
+// do not count towards balances yet
+func (s *GroupService) unapprovedExpenseIDs(ctx context.Context, groupID string) (map[string]bool, error) {
+	id, err := uuid.Parse(groupID)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unapproved := make(map[string]bool)
+	for _, expense := range expenses {
+		if !expense.IsApproved() {
+			unapproved[expense.ID.String()] = true
+		}
+	}
+	return unapproved, nil
+}
+
+func approvalVoteFromProto(vote groupv1.ApprovalVote) (domain.ApprovalVote, error) {
+	switch vote {
+	case groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE:
+		return domain.ApprovalVoteApprove, nil
+	case groupv1.ApprovalVote_APPROVAL_VOTE_DISPUTE:
+		return domain.ApprovalVoteDispute, nil
+	default:
+		return "", validator.ValidationError{Field: "vote", Message: "承認か差し戻しを選択してください"}
+	}
+}
+
+func toProtoApprovalVote(vote domain.ApprovalVote) groupv1.ApprovalVote {
+	switch vote {
+	case domain.ApprovalVoteApprove:
+		return groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE
+	case domain.ApprovalVoteDispute:
+		return groupv1.ApprovalVote_APPROVAL_VOTE_DISPUTE
+	default:
+		return groupv1.ApprovalVote_APPROVAL_VOTE_UNSPECIFIED
+	}
+}
+
+func toProtoApprovalStatus(status domain.ApprovalStatus) groupv1.ApprovalStatus {
+	switch status {
+	case domain.ApprovalStatusPending:
+		return groupv1.ApprovalStatus_APPROVAL_STATUS_PENDING
+	case domain.ApprovalStatusDisputed:
+		return groupv1.ApprovalStatus_APPROVAL_STATUS_DISPUTED
+	default:
+		return groupv1.ApprovalStatus_APPROVAL_STATUS_APPROVED
+	}
+}
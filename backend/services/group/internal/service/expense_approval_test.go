@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_AddExpense_RequiresApproval(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+		Id:      groupID,
+		Members: []*groupv1.Member{{Id: aliceID, Name: "Alice"}, {Id: bobID, Name: "Bob"}},
+	}, nil)
+	mockGroupRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{RequireExpenseApproval: true}, nil)
+	mockGroupRepo.On("GetBudgets", groupID).Return(nil, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, mock.Anything).Return(nil, nil)
+	mockExpenseRepo.On("Create", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+		return expense.ApprovalStatus == domain.ApprovalStatusPending
+	})).Return(nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+		GroupId:        groupID,
+		Amount:         3000,
+		Description:    "Lunch",
+		PaidById:       aliceID,
+		SplitMemberIds: []string{aliceID, bobID},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, groupv1.ApprovalStatus_APPROVAL_STATUS_PENDING, resp.Expense.ApprovalStatus)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestGroupService_VoteOnExpense(t *testing.T) {
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	pending := func(bobVote domain.ApprovalVote) *domain.Expense {
+		return &domain.Expense{
+			ID:             expenseID,
+			GroupID:        uuid.New(),
+			Amount:         3000,
+			ApprovalStatus: domain.ApprovalStatusPending,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, MemberName: "Alice", Amount: 1500},
+				{MemberID: bobID, MemberName: "Bob", Amount: 1500, Vote: bobVote},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		expense        *domain.Expense
+		memberID       uuid.UUID
+		vote           groupv1.ApprovalVote
+		expectedStatus domain.ApprovalStatus
+		expectedError  string
+	}{
+		{
+			name:           "waits for the other split members",
+			expense:        pending(""),
+			memberID:       aliceID,
+			vote:           groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE,
+			expectedStatus: domain.ApprovalStatusPending,
+		},
+		{
+			name:           "approved by the last split member",
+			expense:        pending(domain.ApprovalVoteApprove),
+			memberID:       aliceID,
+			vote:           groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE,
+			expectedStatus: domain.ApprovalStatusApproved,
+		},
+		{
+			// Bob approved after the expense was read here; the saved votes count
+			name:           "approved by a vote cast in the meantime",
+			expense:        pending(""),
+			memberID:       aliceID,
+			vote:           groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE,
+			expectedStatus: domain.ApprovalStatusApproved,
+		},
+		{
+			name:           "one dispute is enough",
+			expense:        pending(domain.ApprovalVoteApprove),
+			memberID:       aliceID,
+			vote:           groupv1.ApprovalVote_APPROVAL_VOTE_DISPUTE,
+			expectedStatus: domain.ApprovalStatusDisputed,
+		},
+		{
+			name:          "not a split member",
+			expense:       pending(""),
+			memberID:      uuid.New(),
+			vote:          groupv1.ApprovalVote_APPROVAL_VOTE_APPROVE,
+			expectedError: "分配先のメンバーのみ承認できます",
+		},
+		{
+			name:          "already approved",
+			expense:       &domain.Expense{ID: expenseID, ApprovalStatus: domain.ApprovalStatusApproved},
+			memberID:      aliceID,
+			vote:          groupv1.ApprovalVote_APPROVAL_VOTE_DISPUTE,
+			expectedError: "承認済みの支払いです",
+		},
+		{
+			name:          "no vote",
+			memberID:      aliceID,
+			expectedError: "承認か差し戻しを選択してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExpenseRepo := new(MockExpenseRepository)
+			if tt.expense != nil {
+				mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(tt.expense, nil)
+			}
+			if tt.expectedError == "" {
+				mockExpenseRepo.On("SaveVote", mock.Anything, expenseID, tt.memberID, mock.Anything, mock.AnythingOfType("time.Time")).Return(tt.expectedStatus, nil)
+			}
+
+			service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+			resp, err := service.VoteOnExpense(context.Background(), &groupv1.VoteOnExpenseRequest{
+				ExpenseId: expenseID.String(),
+				MemberId:  tt.memberID.String(),
+				Vote:      tt.vote,
+			})
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, toProtoApprovalStatus(tt.expectedStatus), resp.Expense.ApprovalStatus)
+				assert.Equal(t, tt.vote, resp.Expense.SplitMembers[0].Vote)
+				assert.NotNil(t, resp.Expense.SplitMembers[0].VotedAt)
+			}
+
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_UpdateExpense_DisputedGoesBackToPending(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+
+	existing := &domain.Expense{
+		ID:             expenseID,
+		GroupID:        groupID,
+		Amount:         3000,
+		Kind:           domain.ExpenseKindExpense,
+		PaidByID:       aliceID,
+		ApprovalStatus: domain.ApprovalStatusDisputed,
+		CreatedAt:      time.Now(),
+	}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(existing, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{
+		Id:      groupID.String(),
+		Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}},
+	}, nil)
+	mockExpenseRepo.On("Update", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+		return expense.ApprovalStatus == domain.ApprovalStatusPending
+	}), domain.ExpenseChange{}).Return(nil)
+	mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.UpdateExpense(context.Background(), &groupv1.UpdateExpenseRequest{
+		ExpenseId:      expenseID.String(),
+		Amount:         2500,
+		Description:    "Lunch",
+		PaidById:       aliceID.String(),
+		SplitMemberIds: []string{aliceID.String()},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, groupv1.ApprovalStatus_APPROVAL_STATUS_PENDING, resp.Expense.ApprovalStatus)
+	mockGroupRepo.AssertNotCalled(t, "GetGroupSettings", mock.Anything)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestGroupService_CalculateSettlements_SkipsUnapproved(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	pendingID := uuid.New()
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{
+		Id:      groupID.String(),
+		Members: []*groupv1.Member{{Id: aliceID, Name: "Alice"}, {Id: bobID, Name: "Bob"}},
+	}, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{
+		{ID: pendingID, ApprovalStatus: domain.ApprovalStatusPending},
+	}, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.CalculateSettlements(context.Background(), &groupv1.CalculateSettlementsRequest{
+		GroupId: groupID.String(),
+		Expenses: []*groupv1.Expense{
+			{Id: uuid.New().String(), PayerId: aliceID, Amount: 2000, SplitBetween: []string{aliceID, bobID}},
+			{Id: pendingID.String(), PayerId: bobID, Amount: 8000, SplitBetween: []string{aliceID, bobID}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Settlements, 1)
+	assert.Equal(t, bobID, resp.Settlements[0].FromMemberId)
+	assert.Equal(t, int64(1000), resp.Settlements[0].Amount)
+}
+
+func TestMemberBalances_SkipsUnapproved(t *testing.T) {
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	group := &groupv1.Group{Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}, {Id: bobID.String(), Name: "Bob"}}}
+
+	expense := func(amount int64, status domain.ApprovalStatus) *domain.Expense {
+		return &domain.Expense{
+			ID:             uuid.New(),
+			Amount:         amount,
+			PaidByID:       aliceID,
+			ApprovalStatus: status,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, Amount: amount / 2},
+				{MemberID: bobID, Amount: amount / 2},
+			},
+		}
+	}
+
+	balances := memberBalances(group, []*domain.Expense{
+		expense(2000, domain.ApprovalStatusApproved),
+		expense(4000, domain.ApprovalStatusPending),
+		expense(6000, domain.ApprovalStatusDisputed),
+		expense(8000, ""),
+	})
+
+	assert.Equal(t, int64(5000), balances[0].Amount)
+	assert.Equal(t, int64(-5000), balances[1].Amount)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// AddExpenses saves several expenses of a group at once. Every entry is
+// checked before anything is written; if any entry is invalid nothing is
+// saved and the response lists the errors of all invalid entries.
+func (s *GroupService) AddExpenses(ctx context.Context, req *groupv1.AddExpensesRequest) (*groupv1.AddExpensesResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateExpenseBatchSize(len(req.Expenses)); err != nil {
+		return nil, err
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Active periods are only needed when an entry splits among everyone present
+	var periods []*domain.MemberActivePeriod
+	for _, entry := range req.Expenses {
+		if entry.SplitAmongPresent {
+			periods, err = s.repo.GetMemberActivePeriods(req.GroupId)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	var households []*domain.Household
+	for _, entry := range req.Expenses {
+		if entry.SplitByHousehold {
+			households, err = s.repo.GetHouseholds(req.GroupId)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	now := time.Now()
+	expenses := make([]*domain.Expense, 0, len(req.Expenses))
+	var expenseErrors []*groupv1.ExpenseError
+	for i, entry := range req.Expenses {
+		// Space the timestamps so the expenses list in the order they were entered
+		createdAt := now.Add(time.Duration(i) * time.Microsecond)
+
+		if entry.SplitAmongPresent {
+			if err := splitAmongPresent(entry, periods, createdAt); err != nil {
+				expenseErrors = append(expenseErrors, toProtoExpenseError(i, err))
+				continue
+			}
+		}
+
+		if entry.SplitByHousehold {
+			if err := splitByHousehold(entry, households); err != nil {
+				expenseErrors = append(expenseErrors, toProtoExpenseError(i, err))
+				continue
+			}
+		}
+
+		kind, err := validateNewExpense(entry)
+		if err != nil {
+			expenseErrors = append(expenseErrors, toProtoExpenseError(i, err))
+			continue
+		}
+
+		expense, err := newExpense(group, groupID, entry, kind, createdAt)
+		if err != nil {
+			expenseErrors = append(expenseErrors, toProtoExpenseError(i, err))
+			continue
+		}
+		expenses = append(expenses, expense)
+	}
+
+	if len(expenseErrors) > 0 {
+		return &groupv1.AddExpensesResponse{
+			Errors: expenseErrors,
+		}, nil
+	}
+
+	approvalStatus, err := s.newExpenseApprovalStatus(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, expense := range expenses {
+		expense.ApprovalStatus = approvalStatus
+	}
+
+	if err := s.expenseRepo.CreateBatch(ctx, expenses); err != nil {
+		return nil, err
+	}
+
+	protoExpenses := make([]*groupv1.ExpenseWithDetails, len(expenses))
+	for i, expense := range expenses {
+		protoExpenses[i] = toProtoExpense(expense)
+	}
+
+	return &groupv1.AddExpensesResponse{
+		Expenses: protoExpenses,
+	}, nil
+}
+
+// validateNewExpense checks the fields of an expense that can be validated
+// without loading the group, and returns its kind
+func validateNewExpense(req *groupv1.AddExpenseRequest) (domain.ExpenseKind, error) {
+	kind, err := expenseKindFromProto(req.Kind)
+	if err != nil {
+		return "", err
+	}
+
+	if err := validateExpenseEntry(kind, req.Amount, req.Description, req.PaidById, req.SplitMemberIds); err != nil {
+		return "", err
+	}
+
+	if err := validator.ValidateSplitAmounts(req.Amount, len(req.SplitMemberIds), req.SplitAmounts); err != nil {
+		return "", err
+	}
+
+	if err := validator.ValidateExpenseCategory(req.Category); err != nil {
+		return "", err
+	}
+
+	return kind, nil
+}
+
+// newExpense builds a validated expense, checking the payer and split
+// members against the group
+func newExpense(group *groupv1.Group, groupID uuid.UUID, req *groupv1.AddExpenseRequest, kind domain.ExpenseKind, now time.Time) (*domain.Expense, error) {
+	paidByID, err := uuid.Parse(req.PaidById)
+	if err != nil {
+		return nil, errors.New("invalid paid by ID")
+	}
+
+	// Equal split amount, used unless exact shares are given
+	splitAmount := req.Amount / int64(len(req.SplitMemberIds))
+
+	// Create split members
+	var splitMembers []domain.SplitMember
+	var paidByName string
+
+	for i, memberID := range req.SplitMemberIds {
+		memberUUID, err := uuid.Parse(memberID)
+		if err != nil {
+			return nil, errors.New("invalid member ID: " + memberID)
+		}
+
+		// Find member name
+		var memberName string
+		for _, member := range group.Members {
+			if member.Id == memberID {
+				memberName = member.Name
+				break
+			}
+		}
+		if memberName == "" {
+			return nil, errors.New("member not found: " + memberID)
+		}
+
+		// Set paid by name if this is the payer
+		if memberID == req.PaidById {
+			paidByName = memberName
+		}
+
+		amount := splitAmount
+		if len(req.SplitAmounts) > 0 {
+			amount = req.SplitAmounts[i]
+		}
+
+		splitMembers = append(splitMembers, domain.SplitMember{
+			MemberID:   memberUUID,
+			MemberName: memberName,
+			Amount:     amount,
+		})
+	}
+
+	// The payer (or recipient of an income) need not be part of the split
+	if paidByName == "" {
+		for _, member := range group.Members {
+			if member.Id == req.PaidById {
+				paidByName = member.Name
+				break
+			}
+		}
+		if paidByName == "" {
+			return nil, errors.New("paid by member not found in group")
+		}
+	}
+
+	return &domain.Expense{
+		ID:           uuid.New(),
+		GroupID:      groupID,
+		Amount:       req.Amount,
+		Description:  req.Description,
+		Category:     strings.TrimSpace(req.Category),
+		Kind:         kind,
+		Currency:     group.Currency,
+		PaidByID:     paidByID,
+		PaidByName:   paidByName,
+		SplitMembers: splitMembers,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+		// Groups asking for approval set their new expenses to pending
+		ApprovalStatus: domain.ApprovalStatusApproved,
+	}, nil
+}
+
+func toProtoExpenseError(index int, err error) *groupv1.ExpenseError {
+	expenseError := &groupv1.ExpenseError{
+		Index:   int32(index),
+		Message: err.Error(),
+	}
+
+	var validationErr validator.ValidationError
+	if errors.As(err, &validationErr) {
+		expenseError.Field = validationErr.Field
+		expenseError.Message = validationErr.Message
+	}
+
+	return expenseError
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_AddExpenses(t *testing.T) {
+	groupID := "550e8400-e29b-41d4-a716-446655440000"
+	aliceID := "550e8400-e29b-41d4-a716-446655440001"
+	bobID := "550e8400-e29b-41d4-a716-446655440002"
+	outsiderID := "550e8400-e29b-41d4-a716-446655440009"
+
+	group := &groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+		},
+	}
+
+	t.Run("saves every expense in one batch", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{}, nil)
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(expenses []*domain.Expense) bool {
+			return len(expenses) == 2 &&
+				expenses[0].Description == "Lunch" &&
+				expenses[1].Description == "Taxi" &&
+				expenses[0].CreatedAt.Before(expenses[1].CreatedAt) &&
+				expenses[1].PaidByName == "Bob"
+		})).Return(nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.AddExpenses(context.Background(), &groupv1.AddExpensesRequest{
+			GroupId: groupID,
+			Expenses: []*groupv1.AddExpenseRequest{
+				{Amount: 3000, Description: "Lunch", PaidById: aliceID, SplitMemberIds: []string{aliceID, bobID}},
+				{Amount: 2000, Description: "Taxi", PaidById: bobID, SplitMemberIds: []string{aliceID, bobID}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Errors)
+		require.Len(t, resp.Expenses, 2)
+		assert.Equal(t, int64(1500), resp.Expenses[0].SplitMembers[0].Amount)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("reports every invalid entry and saves nothing", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+
+		mockExpenseRepo := new(MockExpenseRepository)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.AddExpenses(context.Background(), &groupv1.AddExpensesRequest{
+			GroupId: groupID,
+			Expenses: []*groupv1.AddExpenseRequest{
+				{Amount: 3000, Description: "Lunch", PaidById: aliceID, SplitMemberIds: []string{aliceID, bobID}},
+				{Amount: 0, Description: "Taxi", PaidById: bobID, SplitMemberIds: []string{aliceID}},
+				{Amount: 1000, Description: "Coffee", PaidById: aliceID, SplitMemberIds: []string{outsiderID}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, resp.Expenses)
+		assert.Equal(t, []*groupv1.ExpenseError{
+			{Index: 1, Field: "amount", Message: "金額は1円以上で入力してください"},
+			{Index: 2, Message: "member not found: " + outsiderID},
+		}, resp.Errors)
+		mockExpenseRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, err := service.AddExpenses(context.Background(), &groupv1.AddExpensesRequest{GroupId: groupID})
+
+		assert.EqualError(t, err, "expenses: 登録する支払いがありません")
+	})
+}
+
+func TestGroupService_AddExpense_SplitAmounts(t *testing.T) {
+	groupID := "550e8400-e29b-41d4-a716-446655440000"
+	aliceID := "550e8400-e29b-41d4-a716-446655440001"
+	bobID := "550e8400-e29b-41d4-a716-446655440002"
+
+	group := &groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+		},
+	}
+
+	t.Run("stores the exact shares", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockRepo.On("GetBudgets", groupID).Return(nil, nil)
+		mockRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{}, nil)
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, mock.Anything).Return(nil, nil)
+		mockExpenseRepo.On("Create", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+			return len(expense.SplitMembers) == 2 &&
+				expense.SplitMembers[0].Amount == 2000 &&
+				expense.SplitMembers[1].Amount == 1000
+		})).Return(nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		_, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         3000,
+			Description:    "Dinner",
+			PaidById:       aliceID,
+			SplitMemberIds: []string{aliceID, bobID},
+			SplitAmounts:   []int64{2000, 1000},
+		})
+
+		require.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("rejects shares that do not add up", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         3000,
+			Description:    "Dinner",
+			PaidById:       aliceID,
+			SplitMemberIds: []string{aliceID, bobID},
+			SplitAmounts:   []int64{2000, 500},
+		})
+
+		assert.ErrorContains(t, err, "分担額の合計が金額と一致しません")
+	})
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// duplicateWindow is how far apart two records of the same expense are
+// expected to be entered
+const duplicateWindow = 48 * time.Hour
+
+// minDescriptionSimilarity is the Dice coefficient of the characters of two
+// descriptions from which they are taken to describe the same thing, so
+// that 焼肉 matches 焼き肉 and "Dinner" matches "dinner "
+const minDescriptionSimilarity = 0.7
+
+// ListDuplicateExpenses lists pairs of recorded expenses that look like the
+// same expense entered twice
+func (s *GroupService) ListDuplicateExpenses(ctx context.Context, req *groupv1.ListDuplicateExpensesRequest) (*groupv1.ListDuplicateExpensesResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	// Newest first, so every later expense is an earlier entry
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs []*groupv1.DuplicateExpensePair
+	for i, duplicate := range expenses {
+		for _, expense := range expenses[i+1:] {
+			if isProbableDuplicate(duplicate, expense) {
+				pairs = append(pairs, &groupv1.DuplicateExpensePair{
+					Expense:   toProtoExpense(expense),
+					Duplicate: toProtoExpense(duplicate),
+				})
+			}
+		}
+	}
+
+	return &groupv1.ListDuplicateExpensesResponse{Pairs: pairs}, nil
+}
+
+// MergeExpenses keeps one of two records of the same expense. The other one
+// goes to the trash and can be restored, but its attachments and comments
+// stay on the expense kept.
+func (s *GroupService) MergeExpenses(ctx context.Context, req *groupv1.MergeExpensesRequest) (*groupv1.MergeExpensesResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.KeepExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.DuplicateExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if req.KeepExpenseId == req.DuplicateExpenseId {
+		return nil, errors.New("同じ支払いは統合できません")
+	}
+
+	var mergedBy *uuid.UUID
+	if req.MergedBy != "" {
+		if err := validator.ValidateUUID(req.MergedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+		memberID := uuid.MustParse(req.MergedBy)
+		mergedBy = &memberID
+	}
+
+	keepID, err := uuid.Parse(req.KeepExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	duplicateID, err := uuid.Parse(req.DuplicateExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	kept, err := s.expenseRepo.FindByID(ctx, keepID)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicate, err := s.expenseRepo.FindByID(ctx, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if kept.GroupID != duplicate.GroupID {
+		return nil, errors.New("別のグループの支払いは統合できません")
+	}
+
+	if err := s.checkExpenseUnlocked(kept); err != nil {
+		return nil, err
+	}
+	if err := s.checkExpenseUnlocked(duplicate); err != nil {
+		return nil, err
+	}
+
+	if mergedBy != nil {
+		group, err := s.repo.GetGroupByID(kept.GroupID.String())
+		if err != nil {
+			return nil, err
+		}
+		if findMember(group, req.MergedBy) == nil {
+			return nil, errors.New("merged by member not found in group")
+		}
+	}
+
+	if err := s.expenseRepo.MergeExpenses(ctx, keepID, duplicateID, mergedBy); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.MergeExpensesResponse{Expense: toProtoExpense(kept)}, nil
+}
+
+// probableDuplicates returns the recorded expenses of the group a new
+// expense looks like
+func (s *GroupService) probableDuplicates(ctx context.Context, expense *domain.Expense) ([]*domain.Expense, error) {
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, expense.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []*domain.Expense
+	for _, recorded := range expenses {
+		if isProbableDuplicate(expense, recorded) {
+			duplicates = append(duplicates, recorded)
+		}
+	}
+	return duplicates, nil
+}
+
+// isProbableDuplicate reports whether two expenses look like the same one
+// recorded twice: the same kind and amount, entered within duplicateWindow,
+// with similar descriptions
+func isProbableDuplicate(a, b *domain.Expense) bool {
+	if a.ID == b.ID || a.Kind != b.Kind || a.Amount != b.Amount {
+		return false
+	}
+
+	gap := a.CreatedAt.Sub(b.CreatedAt)
+	if gap < 0 {
+		gap = -gap
+	}
+	if gap > duplicateWindow {
+		return false
+	}
+
+	return descriptionSimilarity(a.Description, b.Description) >= minDescriptionSimilarity
+}
+
+// descriptionSimilarity is the Dice coefficient of the characters of two
+// descriptions, ignoring width, case and spaces: 1 when they use the same
+// characters and 0 when they share none
+func descriptionSimilarity(a, b string) float64 {
+	countRunes := func(text string) (map[rune]int, int) {
+		counts := make(map[rune]int)
+		total := 0
+		for _, r := range foldText(text) {
+			if unicode.IsSpace(r) {
+				continue
+			}
+			counts[r]++
+			total++
+		}
+		return counts, total
+	}
+
+	countsA, totalA := countRunes(a)
+	countsB, totalB := countRunes(b)
+	if totalA == 0 || totalB == 0 {
+		return 0
+	}
+
+	shared := 0
+	for r, count := range countsA {
+		shared += min(count, countsB[r])
+	}
+	return 2 * float64(shared) / float64(totalA+totalB)
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_AddExpense_ProbableDuplicate(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+	recorded := &domain.Expense{
+		ID:          uuid.New(),
+		GroupID:     groupID,
+		Amount:      12000,
+		Description: "焼き肉",
+		Kind:        domain.ExpenseKindExpense,
+		PaidByID:    aliceID,
+		CreatedAt:   time.Now().Add(-3 * time.Hour),
+	}
+
+	request := func(allowDuplicate bool) *groupv1.AddExpenseRequest {
+		return &groupv1.AddExpenseRequest{
+			GroupId:        groupID.String(),
+			Amount:         12000,
+			Description:    "焼肉",
+			PaidById:       bobID.String(),
+			SplitMemberIds: []string{aliceID.String(), bobID.String()},
+			AllowDuplicate: allowDuplicate,
+		}
+	}
+
+	t.Run("held back and returned", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{recorded}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.AddExpense(context.Background(), request(false))
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Expense)
+		require.Len(t, resp.ProbableDuplicates, 1)
+		assert.Equal(t, recorded.ID.String(), resp.ProbableDuplicates[0].Id)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("saved when allowed", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+		mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+		mockExpenseRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.AddExpense(context.Background(), request(true))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12000), resp.Expense.Amount)
+		assert.Empty(t, resp.ProbableDuplicates)
+		mockExpenseRepo.AssertNotCalled(t, "FindByGroupID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGroupService_ListDuplicateExpenses(t *testing.T) {
+	groupID := uuid.New()
+	now := time.Now()
+
+	expense := func(amount int64, description string, kind domain.ExpenseKind, createdAt time.Time) *domain.Expense {
+		return &domain.Expense{ID: uuid.New(), GroupID: groupID, Amount: amount, Description: description, Kind: kind, CreatedAt: createdAt}
+	}
+
+	// Newest first, as the repository returns them
+	dinnerAgain := expense(8000, "dinner ", domain.ExpenseKindExpense, now)
+	taxi := expense(2000, "タクシー", domain.ExpenseKindExpense, now.Add(-time.Hour))
+	dinner := expense(8000, "Dinner", domain.ExpenseKindExpense, now.Add(-2*time.Hour))
+	refund := expense(8000, "Dinner", domain.ExpenseKindIncome, now.Add(-3*time.Hour))
+	lastWeek := expense(8000, "Dinner", domain.ExpenseKindExpense, now.AddDate(0, 0, -7))
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{dinnerAgain, taxi, dinner, refund, lastWeek}, nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+	resp, err := service.ListDuplicateExpenses(context.Background(), &groupv1.ListDuplicateExpensesRequest{GroupId: groupID.String()})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Pairs, 1)
+	assert.Equal(t, dinner.ID.String(), resp.Pairs[0].Expense.Id)
+	assert.Equal(t, dinnerAgain.ID.String(), resp.Pairs[0].Duplicate.Id)
+}
+
+func TestGroupService_MergeExpenses(t *testing.T) {
+	groupID := uuid.New()
+	keepID := uuid.New()
+	duplicateID := uuid.New()
+	aliceID := uuid.New()
+
+	kept := &domain.Expense{ID: keepID, GroupID: groupID, Amount: 8000, Description: "Dinner"}
+	group := &groupv1.Group{Id: groupID.String(), Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}}}
+
+	tests := []struct {
+		name          string
+		request       *groupv1.MergeExpensesRequest
+		duplicate     *domain.Expense
+		expectedError string
+	}{
+		{
+			name:      "duplicate goes to the trash",
+			request:   &groupv1.MergeExpensesRequest{KeepExpenseId: keepID.String(), DuplicateExpenseId: duplicateID.String(), MergedBy: aliceID.String()},
+			duplicate: &domain.Expense{ID: duplicateID, GroupID: groupID, Amount: 8000, Description: "dinner"},
+		},
+		{
+			name:          "same expense twice",
+			request:       &groupv1.MergeExpensesRequest{KeepExpenseId: keepID.String(), DuplicateExpenseId: keepID.String()},
+			expectedError: "同じ支払いは統合できません",
+		},
+		{
+			name:          "expenses of different groups",
+			request:       &groupv1.MergeExpensesRequest{KeepExpenseId: keepID.String(), DuplicateExpenseId: duplicateID.String()},
+			duplicate:     &domain.Expense{ID: duplicateID, GroupID: uuid.New()},
+			expectedError: "別のグループの支払いは統合できません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			mockExpenseRepo := new(MockExpenseRepository)
+			if tt.duplicate != nil {
+				mockExpenseRepo.On("FindByID", mock.Anything, keepID).Return(kept, nil)
+				mockExpenseRepo.On("FindByID", mock.Anything, duplicateID).Return(tt.duplicate, nil)
+			}
+			if tt.expectedError == "" {
+				mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+				mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				mockExpenseRepo.On("MergeExpenses", mock.Anything, keepID, duplicateID, &aliceID).Return(nil)
+			}
+
+			service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+			resp, err := service.MergeExpenses(context.Background(), tt.request)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, keepID.String(), resp.Expense.Id)
+			}
+
+			mockGroupRepo.AssertExpectations(t)
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDescriptionSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		similar bool
+	}{
+		{a: "焼肉", b: "焼き肉", similar: true},
+		{a: "Dinner", b: "ｄｉｎｎｅｒ ", similar: true},
+		{a: "居酒屋 二次会", b: "居酒屋", similar: false},
+		{a: "ランチ", b: "ディナー", similar: false},
+		{a: "", b: "", similar: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.similar, descriptionSimilarity(tt.a, tt.b) >= minDescriptionSimilarity)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"errors"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// expenseKindFromProto maps the requested kind, defaulting to an expense
+func expenseKindFromProto(kind groupv1.ExpenseKind) (domain.ExpenseKind, error) {
+	switch kind {
+	case groupv1.ExpenseKind_EXPENSE_KIND_UNSPECIFIED, groupv1.ExpenseKind_EXPENSE_KIND_EXPENSE:
+		return domain.ExpenseKindExpense, nil
+	case groupv1.ExpenseKind_EXPENSE_KIND_INCOME:
+		return domain.ExpenseKindIncome, nil
+	case groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT:
+		return domain.ExpenseKindPayment, nil
+	default:
+		return "", validator.ValidationError{Field: "kind", Message: "種別が無効です"}
+	}
+}
+
+func toProtoExpenseKind(kind domain.ExpenseKind) groupv1.ExpenseKind {
+	switch kind {
+	case domain.ExpenseKindIncome:
+		return groupv1.ExpenseKind_EXPENSE_KIND_INCOME
+	case domain.ExpenseKindPayment:
+		return groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT
+	default:
+		return groupv1.ExpenseKind_EXPENSE_KIND_EXPENSE
+	}
+}
+
+// validateExpenseEntry validates the amount, description, payer and split of
+// an expense, or of an income or payment entry which have their own rules and
+// messages
+func validateExpenseEntry(kind domain.ExpenseKind, amount int64, description, paidByID string, splitMemberIds []string) error {
+	switch kind {
+	case domain.ExpenseKindIncome:
+		return validator.ValidateIncome(amount, description, paidByID, splitMemberIds)
+	case domain.ExpenseKindPayment:
+		return validator.ValidatePayment(amount, description, paidByID, splitMemberIds)
+	}
+
+	if err := validator.ValidateExpenseAmount(amount); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateExpenseDescription(description); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateUUID(paidByID); err != nil {
+		return errors.New("支払い者IDが無効です")
+	}
+
+	return validator.ValidateSplitMemberIds(splitMemberIds)
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_AddExpense_Income(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	carolID := uuid.New().String()
+
+	group := &groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+			{Id: carolID, Name: "Carol"},
+		},
+	}
+
+	t.Run("refund received by a member outside the split", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockGroupRepo.On("GetBudgets", groupID).Return(nil, nil)
+		mockGroupRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{}, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse(groupID)).Return(nil, nil)
+		mockExpenseRepo.On("Create", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+			return expense.Kind == domain.ExpenseKindIncome &&
+				expense.PaidByName == "Alice" &&
+				len(expense.SplitMembers) == 2
+		})).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         8000,
+			Description:    "ホテルのキャンセル返金",
+			PaidById:       aliceID,
+			SplitMemberIds: []string{bobID, carolID},
+			Kind:           groupv1.ExpenseKind_EXPENSE_KIND_INCOME,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, groupv1.ExpenseKind_EXPENSE_KIND_INCOME, resp.Expense.Kind)
+		assert.Equal(t, "Alice", resp.Expense.PaidByName)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("income has its own validation", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         8000,
+			Description:    "返金",
+			PaidById:       aliceID,
+			SplitMemberIds: []string{aliceID},
+			Kind:           groupv1.ExpenseKind_EXPENSE_KIND_INCOME,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "受取人以外の分配先")
+		assert.Nil(t, resp)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         8000,
+			Description:    "返金",
+			PaidById:       aliceID,
+			SplitMemberIds: []string{bobID},
+			Kind:           groupv1.ExpenseKind(99),
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "種別が無効です")
+		assert.Nil(t, resp)
+	})
+}
+
+func TestGroupService_CalculateSettlements_Income(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+		Id: groupID,
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+		},
+	}, nil)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse(groupID)).Return(nil, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	// Bob received a 4000 yen refund that belongs to both of them
+	resp, err := service.CalculateSettlements(context.Background(), &groupv1.CalculateSettlementsRequest{
+		GroupId: groupID,
+		Expenses: []*groupv1.Expense{
+			{Id: "inc1", PayerId: bobID, Amount: 4000, SplitBetween: []string{aliceID, bobID}, Kind: groupv1.ExpenseKind_EXPENSE_KIND_INCOME},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Settlements, 1)
+	assert.Equal(t, bobID, resp.Settlements[0].FromMemberId)
+	assert.Equal(t, aliceID, resp.Settlements[0].ToMemberId)
+	assert.Equal(t, int64(2000), resp.Settlements[0].Amount)
+}
+
+func TestGroupService_AddExpense_Payment(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	group := &groupv1.Group{
+		Id:       groupID,
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+		},
+	}
+
+	t.Run("settle-up to another member", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockGroupRepo.On("GetBudgets", groupID).Return(nil, nil)
+		mockGroupRepo.On("GetGroupSettings", groupID).Return(&groupv1.GroupSettings{}, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse(groupID)).Return(nil, nil)
+		mockExpenseRepo.On("Create", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+			return expense.Kind == domain.ExpenseKindPayment &&
+				expense.PaidByName == "Bob" &&
+				len(expense.SplitMembers) == 1 &&
+				expense.SplitMembers[0].Amount == 3000
+		})).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         3000,
+			Description:    "立て替え分の返済",
+			PaidById:       bobID,
+			SplitMemberIds: []string{aliceID},
+			Kind:           groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT, resp.Expense.Kind)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("payment to oneself", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:        groupID,
+			Amount:         3000,
+			PaidById:       bobID,
+			SplitMemberIds: []string{bobID},
+			Kind:           groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT,
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "支払者と受取人が同じです")
+		assert.Nil(t, resp)
+		mockExpenseRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGroupService_CalculateSettlements_SplitAmounts(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+		Id: groupID,
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+		},
+	}, nil)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse(groupID)).Return(nil, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	t.Run("exact shares", func(t *testing.T) {
+		resp, err := service.CalculateSettlements(context.Background(), &groupv1.CalculateSettlementsRequest{
+			GroupId: groupID,
+			Expenses: []*groupv1.Expense{
+				{Id: "exp1", PayerId: aliceID, Amount: 4000, SplitBetween: []string{aliceID, bobID}, SplitAmounts: []int64{1000, 3000}},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Settlements, 1)
+		assert.Equal(t, bobID, resp.Settlements[0].FromMemberId)
+		assert.Equal(t, int64(3000), resp.Settlements[0].Amount)
+	})
+
+	t.Run("shares must add up to the amount", func(t *testing.T) {
+		_, err := service.CalculateSettlements(context.Background(), &groupv1.CalculateSettlementsRequest{
+			GroupId: groupID,
+			Expenses: []*groupv1.Expense{
+				{Id: "exp1", PayerId: aliceID, Amount: 4000, SplitBetween: []string{aliceID, bobID}, SplitAmounts: []int64{1000, 2000}},
+			},
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "分担額の合計が金額と一致しません")
+	})
+}
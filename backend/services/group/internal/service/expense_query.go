@@ -0,0 +1,138 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+var errInvalidPageToken = errors.New("ページトークンが無効です")
+
+// pageCursor is the decoded form of a page token. It records the sort order
+// it was issued for so that a token cannot be reused with a different one.
+type pageCursor struct {
+	SortBy      domain.ExpenseSortField `json:"s"`
+	Ascending   bool                    `json:"a,omitempty"`
+	ID          uuid.UUID               `json:"id"`
+	CreatedAt   time.Time               `json:"t,omitempty"`
+	Amount      int64                   `json:"n,omitempty"`
+	Description string                  `json:"d,omitempty"`
+}
+
+// buildExpenseQuery validates the filter, sort and page token of a listing request
+func buildExpenseQuery(groupID uuid.UUID, req *groupv1.GetGroupExpensesRequest) (domain.ExpenseQuery, error) {
+	query := domain.ExpenseQuery{GroupID: groupID}
+
+	switch req.SortBy {
+	case groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_UNSPECIFIED, groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_CREATED_AT:
+		query.SortBy = domain.SortByCreatedAt
+	case groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_AMOUNT:
+		query.SortBy = domain.SortByAmount
+	case groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_DESCRIPTION:
+		query.SortBy = domain.SortByDescription
+	default:
+		return query, validator.ValidationError{Field: "sortBy", Message: "並び替え項目が無効です"}
+	}
+	query.Ascending = req.SortDirection == groupv1.SortDirection_SORT_DIRECTION_ASC
+
+	if filter := req.Filter; filter != nil {
+		if filter.CreatedFrom != nil {
+			from := filter.CreatedFrom.AsTime()
+			query.CreatedFrom = &from
+		}
+		if filter.CreatedTo != nil {
+			to := filter.CreatedTo.AsTime()
+			query.CreatedTo = &to
+		}
+		if query.CreatedFrom != nil && query.CreatedTo != nil && !query.CreatedFrom.Before(*query.CreatedTo) {
+			return query, validator.ValidationError{Field: "filter", Message: "日付の範囲が無効です"}
+		}
+
+		if filter.PayerId != "" {
+			if err := validator.ValidateUUID(filter.PayerId); err != nil {
+				return query, errors.New("支払い者IDが無効です")
+			}
+			payerID := uuid.MustParse(filter.PayerId)
+			query.PayerID = &payerID
+		}
+		if filter.ParticipantId != "" {
+			if err := validator.ValidateUUID(filter.ParticipantId); err != nil {
+				return query, errors.New("メンバーIDが無効です")
+			}
+			participantID := uuid.MustParse(filter.ParticipantId)
+			query.ParticipantID = &participantID
+		}
+
+		if filter.MinAmount < 0 || filter.MaxAmount < 0 || (filter.MaxAmount > 0 && filter.MinAmount > filter.MaxAmount) {
+			return query, validator.ValidationError{Field: "filter", Message: "金額の範囲が無効です"}
+		}
+		query.MinAmount = filter.MinAmount
+		query.MaxAmount = filter.MaxAmount
+
+		if err := validator.ValidateExpenseCategory(filter.Category); err != nil {
+			return query, err
+		}
+		query.Category = strings.TrimSpace(filter.Category)
+		query.Text = strings.TrimSpace(filter.Text)
+	}
+
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken, query)
+		if err != nil {
+			return query, err
+		}
+		query.After = cursor
+	}
+
+	return query, nil
+}
+
+// encodePageToken returns an opaque token resuming the listing after expense
+func encodePageToken(query domain.ExpenseQuery, expense *domain.Expense) string {
+	cursor := pageCursor{
+		SortBy:    query.SortBy,
+		Ascending: query.Ascending,
+		ID:        expense.ID,
+	}
+	switch query.SortBy {
+	case domain.SortByAmount:
+		cursor.Amount = expense.Amount
+	case domain.SortByDescription:
+		cursor.Description = expense.Description
+	default:
+		cursor.CreatedAt = expense.CreatedAt
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string, query domain.ExpenseQuery) (*domain.ExpenseCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errInvalidPageToken
+	}
+
+	if cursor.SortBy != query.SortBy || cursor.Ascending != query.Ascending || cursor.ID == uuid.Nil {
+		return nil, errInvalidPageToken
+	}
+
+	return &domain.ExpenseCursor{
+		ID:          cursor.ID,
+		CreatedAt:   cursor.CreatedAt,
+		Amount:      cursor.Amount,
+		Description: cursor.Description,
+	}, nil
+}
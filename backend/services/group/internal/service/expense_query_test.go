@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_GetGroupExpenses_Pagination(t *testing.T) {
+	groupID := uuid.New()
+	now := time.Now()
+	expenses := []*domain.Expense{
+		{ID: uuid.New(), GroupID: groupID, Amount: 3000, Description: "Dinner", CreatedAt: now},
+		{ID: uuid.New(), GroupID: groupID, Amount: 2000, Description: "Lunch", CreatedAt: now.Add(-time.Hour)},
+		{ID: uuid.New(), GroupID: groupID, Amount: 1000, Description: "Coffee", CreatedAt: now.Add(-2 * time.Hour)},
+	}
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByQuery", mock.Anything, mock.MatchedBy(func(q domain.ExpenseQuery) bool {
+		return q.After == nil
+	})).Return(expenses, nil).Once()
+	mockExpenseRepo.On("FindByQuery", mock.Anything, mock.MatchedBy(func(q domain.ExpenseQuery) bool {
+		return q.After != nil
+	})).Return(expenses[2:], nil).Once()
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+	first, err := service.GetGroupExpenses(context.Background(), &groupv1.GetGroupExpensesRequest{
+		GroupId:  groupID.String(),
+		PageSize: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, first.Expenses, 2)
+	require.Len(t, first.Cursors, 2)
+	assert.NotEmpty(t, first.NextPageToken)
+	assert.Equal(t, first.Cursors[1], first.NextPageToken)
+
+	second, err := service.GetGroupExpenses(context.Background(), &groupv1.GetGroupExpensesRequest{
+		GroupId:   groupID.String(),
+		PageSize:  2,
+		PageToken: first.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, second.Expenses, 1)
+	assert.Empty(t, second.NextPageToken)
+
+	// The second call resumes after the last expense of the first page
+	calls := mockExpenseRepo.Calls
+	require.Len(t, calls, 2)
+	firstQuery := calls[0].Arguments.Get(1).(domain.ExpenseQuery)
+	secondQuery := calls[1].Arguments.Get(1).(domain.ExpenseQuery)
+	assert.Equal(t, 3, firstQuery.Limit)
+	assert.Equal(t, expenses[1].ID, secondQuery.After.ID)
+	assert.True(t, expenses[1].CreatedAt.Equal(secondQuery.After.CreatedAt))
+}
+
+func TestGroupService_GetGroupExpenses_Filters(t *testing.T) {
+	groupID := uuid.New()
+	payerID := uuid.New()
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByQuery", mock.Anything, mock.MatchedBy(func(q domain.ExpenseQuery) bool {
+		return q.GroupID == groupID &&
+			q.CreatedFrom != nil && q.CreatedFrom.Equal(from) &&
+			q.PayerID != nil && *q.PayerID == payerID &&
+			q.MinAmount == 500 &&
+			q.Category == "食費" &&
+			q.Text == "ランチ" &&
+			q.SortBy == domain.SortByAmount &&
+			q.Ascending &&
+			q.Limit == 0
+	})).Return([]*domain.Expense{}, nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+	resp, err := service.GetGroupExpenses(context.Background(), &groupv1.GetGroupExpensesRequest{
+		GroupId: groupID.String(),
+		Filter: &groupv1.ExpenseFilter{
+			CreatedFrom: timestamppb.New(from),
+			PayerId:     payerID.String(),
+			MinAmount:   500,
+			Category:    " 食費 ",
+			Text:        " ランチ ",
+		},
+		SortBy:        groupv1.ExpenseSortField_EXPENSE_SORT_FIELD_AMOUNT,
+		SortDirection: groupv1.SortDirection_SORT_DIRECTION_ASC,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Expenses)
+	assert.Empty(t, resp.NextPageToken)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestGroupService_GetGroupExpenses_InvalidRequest(t *testing.T) {
+	groupID := uuid.New()
+	amountToken := encodePageToken(domain.ExpenseQuery{SortBy: domain.SortByAmount}, &domain.Expense{ID: uuid.New(), Amount: 100})
+
+	tests := []struct {
+		name          string
+		request       *groupv1.GetGroupExpensesRequest
+		expectedError string
+	}{
+		{
+			name:          "page size too large",
+			request:       &groupv1.GetGroupExpensesRequest{GroupId: groupID.String(), PageSize: 101},
+			expectedError: "ページサイズ",
+		},
+		{
+			name:          "malformed page token",
+			request:       &groupv1.GetGroupExpensesRequest{GroupId: groupID.String(), PageToken: "not-a-token"},
+			expectedError: "ページトークンが無効です",
+		},
+		{
+			name:          "page token from another sort order",
+			request:       &groupv1.GetGroupExpensesRequest{GroupId: groupID.String(), PageToken: amountToken},
+			expectedError: "ページトークンが無効です",
+		},
+		{
+			name: "inverted amount range",
+			request: &groupv1.GetGroupExpensesRequest{
+				GroupId: groupID.String(),
+				Filter:  &groupv1.ExpenseFilter{MinAmount: 5000, MaxAmount: 100},
+			},
+			expectedError: "金額の範囲が無効です",
+		},
+		{
+			name: "invalid participant ID",
+			request: &groupv1.GetGroupExpensesRequest{
+				GroupId: groupID.String(),
+				Filter:  &groupv1.ExpenseFilter{ParticipantId: "bob"},
+			},
+			expectedError: "メンバーIDが無効です",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExpenseRepo := new(MockExpenseRepository)
+			service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+			resp, err := service.GetGroupExpenses(context.Background(), tt.request)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.Nil(t, resp)
+			mockExpenseRepo.AssertNotCalled(t, "FindByQuery", mock.Anything, mock.Anything)
+		})
+	}
+}
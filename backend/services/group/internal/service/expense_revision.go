@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Fields reported in FieldChange
+const (
+	changeFieldAmount      = "amount"
+	changeFieldDescription = "description"
+	changeFieldCategory    = "category"
+	changeFieldKind        = "kind"
+	changeFieldPaidBy      = "paid_by"
+	changeFieldSplit       = "split"
+)
+
+func (s *GroupService) GetExpenseHistory(ctx context.Context, req *groupv1.GetExpenseHistoryRequest) (*groupv1.GetExpenseHistoryResponse, error) {
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	// Expenses in the trash have no history to show
+	if _, err := s.expenseRepo.FindByID(ctx, expenseID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.expenseRepo.FindRevisions(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revisions come oldest first, the response lists the newest first
+	protoRevisions := make([]*groupv1.ExpenseRevision, len(revisions))
+	for i, revision := range revisions {
+		var previous *domain.ExpenseRevision
+		if i > 0 {
+			previous = revisions[i-1]
+		}
+		protoRevisions[len(revisions)-1-i] = toProtoRevision(revision, revisionChanges(previous, revision))
+	}
+
+	return &groupv1.GetExpenseHistoryResponse{
+		Revisions: protoRevisions,
+	}, nil
+}
+
+func (s *GroupService) RevertExpense(ctx context.Context, req *groupv1.RevertExpenseRequest) (*groupv1.RevertExpenseResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	if req.Revision < 1 {
+		return nil, validator.ValidationError{Field: "revision", Message: "リビジョンが無効です"}
+	}
+
+	if req.RevertedBy != "" {
+		if err := validator.ValidateUUID(req.RevertedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	existingExpense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkExpenseUnlocked(existingExpense); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.expenseRepo.FindRevision(ctx, expenseID, int(req.Revision))
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.repo.GetGroupByID(existingExpense.GroupID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	memberMap := make(map[string]string) // ID -> Name
+	for _, member := range group.Members {
+		memberMap[member.Id] = member.Name
+	}
+
+	// Members may have left the group since the revision was saved
+	paidByName, found := memberMap[revision.PaidByID.String()]
+	if !found {
+		return nil, errors.New("paid by member of the revision is no longer in the group")
+	}
+
+	splitMembers := make([]domain.SplitMember, len(revision.SplitMembers))
+	for i, split := range revision.SplitMembers {
+		memberName, found := memberMap[split.MemberID.String()]
+		if !found {
+			return nil, errors.New("split member of the revision is no longer in the group")
+		}
+		splitMembers[i] = domain.SplitMember{
+			MemberID:   split.MemberID,
+			MemberName: memberName,
+			Amount:     split.Amount,
+		}
+	}
+
+	change := domain.ExpenseChange{RevertedFrom: revision.Revision}
+	if req.RevertedBy != "" {
+		if _, found := memberMap[req.RevertedBy]; !found {
+			return nil, errors.New("reverted by member not found in group")
+		}
+		revertedByID := uuid.MustParse(req.RevertedBy)
+		change.ChangedByID = &revertedByID
+	}
+
+	approvalStatus, err := s.editedExpenseApprovalStatus(existingExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	expense := &domain.Expense{
+		ID:             expenseID,
+		GroupID:        existingExpense.GroupID,
+		Amount:         revision.Amount,
+		Description:    revision.Description,
+		Category:       revision.Category,
+		Kind:           revision.Kind,
+		Currency:       existingExpense.Currency,
+		PaidByID:       revision.PaidByID,
+		PaidByName:     paidByName,
+		SplitMembers:   splitMembers,
+		CreatedAt:      existingExpense.CreatedAt,
+		UpdatedAt:      time.Now(),
+		ApprovalStatus: approvalStatus,
+	}
+
+	if err := s.expenseRepo.Update(ctx, expense, change); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.RevertExpenseResponse{
+		Expense: toProtoExpense(expense),
+	}, nil
+}
+
+// revisionChanges lists the fields that differ between two consecutive
+// revisions. Splits are compared per member.
+func revisionChanges(previous, current *domain.ExpenseRevision) []*groupv1.FieldChange {
+	if previous == nil {
+		return nil
+	}
+
+	var changes []*groupv1.FieldChange
+	addChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, &groupv1.FieldChange{
+				Field:    field,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	addChange(changeFieldAmount, strconv.FormatInt(previous.Amount, 10), strconv.FormatInt(current.Amount, 10))
+	addChange(changeFieldDescription, previous.Description, current.Description)
+	addChange(changeFieldCategory, previous.Category, current.Category)
+	addChange(changeFieldKind, string(previous.Kind), string(current.Kind))
+	if previous.PaidByID != current.PaidByID {
+		changes = append(changes, &groupv1.FieldChange{
+			Field:    changeFieldPaidBy,
+			OldValue: previous.PaidByName,
+			NewValue: current.PaidByName,
+		})
+	}
+
+	previousSplits := make(map[uuid.UUID]domain.SplitMember, len(previous.SplitMembers))
+	for _, split := range previous.SplitMembers {
+		previousSplits[split.MemberID] = split
+	}
+
+	for _, split := range current.SplitMembers {
+		oldValue := ""
+		if old, found := previousSplits[split.MemberID]; found {
+			oldValue = strconv.FormatInt(old.Amount, 10)
+			delete(previousSplits, split.MemberID)
+		}
+		newValue := strconv.FormatInt(split.Amount, 10)
+		if oldValue != newValue {
+			changes = append(changes, splitChange(split, oldValue, newValue))
+		}
+	}
+
+	// Whatever is left was removed from the split
+	for _, split := range previous.SplitMembers {
+		if _, removed := previousSplits[split.MemberID]; removed {
+			changes = append(changes, splitChange(split, strconv.FormatInt(split.Amount, 10), ""))
+		}
+	}
+
+	return changes
+}
+
+func splitChange(split domain.SplitMember, oldValue, newValue string) *groupv1.FieldChange {
+	return &groupv1.FieldChange{
+		Field:      changeFieldSplit,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		MemberId:   split.MemberID.String(),
+		MemberName: split.MemberName,
+	}
+}
+
+func toProtoRevision(revision *domain.ExpenseRevision, changes []*groupv1.FieldChange) *groupv1.ExpenseRevision {
+	protoSplitMembers := make([]*groupv1.SplitMember, len(revision.SplitMembers))
+	for i, split := range revision.SplitMembers {
+		protoSplitMembers[i] = &groupv1.SplitMember{
+			MemberId:   split.MemberID.String(),
+			MemberName: split.MemberName,
+			Amount:     split.Amount,
+		}
+	}
+
+	protoRevision := &groupv1.ExpenseRevision{
+		Revision:      int32(revision.Revision),
+		Amount:        revision.Amount,
+		Description:   revision.Description,
+		Category:      revision.Category,
+		Kind:          toProtoExpenseKind(revision.Kind),
+		PaidById:      revision.PaidByID.String(),
+		PaidByName:    revision.PaidByName,
+		SplitMembers:  protoSplitMembers,
+		ChangedByName: revision.ChangedByName,
+		RevertedFrom:  int32(revision.RevertedFrom),
+		CreatedAt:     timestamppb.New(revision.CreatedAt),
+		Changes:       changes,
+	}
+	if revision.ChangedByID != nil {
+		protoRevision.ChangedById = revision.ChangedByID.String()
+	}
+
+	return protoRevision
+}
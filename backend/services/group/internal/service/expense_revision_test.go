@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_GetExpenseHistory(t *testing.T) {
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+	now := time.Now()
+
+	revisions := []*domain.ExpenseRevision{
+		{
+			ExpenseID: expenseID, Revision: 1, Amount: 3000, Description: "Lunch", Kind: domain.ExpenseKindExpense,
+			PaidByID: aliceID, PaidByName: "Alice", CreatedAt: now,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, MemberName: "Alice", Amount: 1500},
+				{MemberID: bobID, MemberName: "Bob", Amount: 1500},
+			},
+		},
+		{
+			ExpenseID: expenseID, Revision: 2, Amount: 3000, Description: "Lunch", Category: "食費", Kind: domain.ExpenseKindExpense,
+			PaidByID: bobID, PaidByName: "Bob", ChangedByID: &bobID, ChangedByName: "Bob", CreatedAt: now,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, MemberName: "Alice", Amount: 1000},
+				{MemberID: carolID, MemberName: "Carol", Amount: 2000},
+			},
+		},
+	}
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID}, nil)
+	mockExpenseRepo.On("FindRevisions", mock.Anything, expenseID).Return(revisions, nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+	resp, err := service.GetExpenseHistory(context.Background(), &groupv1.GetExpenseHistoryRequest{ExpenseId: expenseID.String()})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+
+	latest := resp.Revisions[0]
+	assert.Equal(t, int32(2), latest.Revision)
+	assert.Equal(t, bobID.String(), latest.ChangedById)
+	assert.Equal(t, []*groupv1.FieldChange{
+		{Field: "category", OldValue: "", NewValue: "食費"},
+		{Field: "paid_by", OldValue: "Alice", NewValue: "Bob"},
+		{Field: "split", OldValue: "1500", NewValue: "1000", MemberId: aliceID.String(), MemberName: "Alice"},
+		{Field: "split", OldValue: "", NewValue: "2000", MemberId: carolID.String(), MemberName: "Carol"},
+		{Field: "split", OldValue: "1500", NewValue: "", MemberId: bobID.String(), MemberName: "Bob"},
+	}, latest.Changes)
+
+	assert.Equal(t, int32(1), resp.Revisions[1].Revision)
+	assert.Empty(t, resp.Revisions[1].Changes)
+	assert.Empty(t, resp.Revisions[1].ChangedById)
+}
+
+func TestGroupService_RevertExpense(t *testing.T) {
+	groupID := uuid.New()
+	expenseID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	existing := &domain.Expense{ID: expenseID, GroupID: groupID, Amount: 5000, Description: "Dinner", Currency: "JPY", PaidByID: bobID, CreatedAt: createdAt}
+	revision := &domain.ExpenseRevision{
+		ExpenseID: expenseID, Revision: 1, Amount: 3000, Description: "Lunch", Kind: domain.ExpenseKindExpense,
+		PaidByID: aliceID, PaidByName: "Alice (old name)",
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, MemberName: "Alice (old name)", Amount: 1000},
+			{MemberID: bobID, MemberName: "Bob", Amount: 2000},
+		},
+	}
+
+	t.Run("saves the revision as a new one", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{
+			Id: groupID.String(),
+			Members: []*groupv1.Member{
+				{Id: aliceID.String(), Name: "Alice"},
+				{Id: bobID.String(), Name: "Bob"},
+			},
+		}, nil)
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(existing, nil)
+		mockRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+		mockExpenseRepo.On("FindRevision", mock.Anything, expenseID, 1).Return(revision, nil)
+		mockRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+		mockExpenseRepo.On("Update", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+			return expense.Amount == 3000 &&
+				expense.Description == "Lunch" &&
+				expense.PaidByID == aliceID &&
+				expense.CreatedAt.Equal(createdAt) &&
+				len(expense.SplitMembers) == 2 &&
+				expense.SplitMembers[1].Amount == 2000
+		}), domain.ExpenseChange{ChangedByID: &bobID, RevertedFrom: 1}).Return(nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.RevertExpense(context.Background(), &groupv1.RevertExpenseRequest{
+			ExpenseId:  expenseID.String(),
+			Revision:   1,
+			RevertedBy: bobID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Lunch", resp.Expense.Description)
+		assert.Equal(t, "Alice", resp.Expense.PaidByName)
+		assert.Equal(t, "Alice", resp.Expense.SplitMembers[0].MemberName)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("member has left the group", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{
+			Id:      groupID.String(),
+			Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}},
+		}, nil)
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(existing, nil)
+		mockRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+		mockExpenseRepo.On("FindRevision", mock.Anything, expenseID, 1).Return(revision, nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		_, err := service.RevertExpense(context.Background(), &groupv1.RevertExpenseRequest{
+			ExpenseId: expenseID.String(),
+			Revision:  1,
+		})
+
+		assert.EqualError(t, err, "split member of the revision is no longer in the group")
+		mockExpenseRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid revision", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, err := service.RevertExpense(context.Background(), &groupv1.RevertExpenseRequest{
+			ExpenseId: expenseID.String(),
+		})
+
+		assert.EqualError(t, err, "revision: リビジョンが無効です")
+	})
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+const defaultSearchLimit = 20
+
+// Highlight field names
+const (
+	highlightDescription = "description"
+	highlightComment     = "comment"
+	highlightMember      = "member"
+)
+
+func (s *GroupService) SearchExpenses(ctx context.Context, req *groupv1.SearchExpensesRequest) (*groupv1.SearchExpensesResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateSearchQuery(req.Query); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateSearchLimit(req.Limit); err != nil {
+		return nil, err
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	query := strings.TrimSpace(req.Query)
+	hits, err := s.expenseRepo.SearchExpenses(ctx, groupID, query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := strings.Fields(foldText(query))
+	results := make([]*groupv1.ExpenseSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = &groupv1.ExpenseSearchResult{
+			Expense:    toProtoExpense(hit.Expense),
+			Score:      hit.Score,
+			Highlights: searchHighlights(hit, terms),
+		}
+	}
+
+	return &groupv1.SearchExpensesResponse{
+		Results: results,
+	}, nil
+}
+
+// searchHighlights marks where the query terms occur in the description,
+// matching comments and member names of a hit. Fields that only matched
+// through trigram similarity have no verbatim occurrence and are left out.
+func searchHighlights(hit *domain.ExpenseSearchHit, terms []string) []*groupv1.SearchHighlight {
+	var highlights []*groupv1.SearchHighlight
+
+	if ranges := highlightRanges(hit.Expense.Description, terms); len(ranges) > 0 {
+		highlights = append(highlights, &groupv1.SearchHighlight{
+			Field:  highlightDescription,
+			Text:   hit.Expense.Description,
+			Ranges: ranges,
+		})
+	}
+
+	for _, comment := range hit.Comments {
+		if ranges := highlightRanges(comment.Body, terms); len(ranges) > 0 {
+			highlights = append(highlights, &groupv1.SearchHighlight{
+				Field:     highlightComment,
+				Text:      comment.Body,
+				Ranges:    ranges,
+				CommentId: comment.ID.String(),
+			})
+		}
+	}
+
+	names := []string{hit.Expense.PaidByName}
+	for _, split := range hit.Expense.SplitMembers {
+		names = append(names, split.MemberName)
+	}
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if ranges := highlightRanges(name, terms); len(ranges) > 0 {
+			highlights = append(highlights, &groupv1.SearchHighlight{
+				Field:  highlightMember,
+				Text:   name,
+				Ranges: ranges,
+			})
+		}
+	}
+
+	return highlights
+}
+
+// highlightRanges returns the merged rune ranges of every occurrence of the
+// (already folded) terms in text
+func highlightRanges(text string, terms []string) []*groupv1.TextRange {
+	haystack := []rune(foldText(text))
+
+	type span struct{ start, end int }
+	var spans []span
+	for _, term := range terms {
+		needle := []rune(term)
+		for i := 0; i+len(needle) <= len(haystack); i++ {
+			if string(haystack[i:i+len(needle)]) == term {
+				spans = append(spans, span{i, i + len(needle)})
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	var ranges []*groupv1.TextRange
+	current := spans[0]
+	for _, sp := range spans[1:] {
+		if sp.start <= current.end {
+			if sp.end > current.end {
+				current.end = sp.end
+			}
+			continue
+		}
+		ranges = append(ranges, &groupv1.TextRange{Start: int32(current.start), End: int32(current.end)})
+		current = sp
+	}
+	ranges = append(ranges, &groupv1.TextRange{Start: int32(current.start), End: int32(current.end)})
+
+	return ranges
+}
+
+// foldText normalizes text for matching without changing its length in
+// runes: full-width ASCII becomes half-width (Ｔａｘｉ -> taxi), the
+// ideographic space becomes a regular one and letters are lowercased
+func foldText(text string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '！' && r <= '～':
+			r -= '！' - '!'
+		case r == '　':
+			r = ' '
+		}
+		return unicode.ToLower(r)
+	}, text)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_SearchExpenses(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	commentID := uuid.New()
+
+	hit := &domain.ExpenseSearchHit{
+		Expense: &domain.Expense{
+			ID:          uuid.New(),
+			GroupID:     groupID,
+			Amount:      2400,
+			Description: "Ｔａｘｉ to the hotel",
+			PaidByID:    aliceID,
+			PaidByName:  "Alice",
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, MemberName: "Alice", Amount: 1200},
+				{MemberID: uuid.New(), MemberName: "Bob", Amount: 1200},
+			},
+		},
+		Comments: []*domain.Comment{
+			{ID: commentID, Body: "2日目のタクシー、taxi代は私が払いました"},
+		},
+		Score: 1,
+	}
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("SearchExpenses", mock.Anything, groupID, "taxi", defaultSearchLimit).
+		Return([]*domain.ExpenseSearchHit{hit}, nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+	resp, err := service.SearchExpenses(context.Background(), &groupv1.SearchExpensesRequest{
+		GroupId: groupID.String(),
+		Query:   "  taxi ",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	result := resp.Results[0]
+	assert.Equal(t, hit.Expense.ID.String(), result.Expense.Id)
+	assert.Equal(t, 1.0, result.Score)
+
+	// Full-width text is matched and ranges are counted in runes
+	require.Len(t, result.Highlights, 2)
+	assert.Equal(t, "description", result.Highlights[0].Field)
+	assert.Equal(t, []*groupv1.TextRange{{Start: 0, End: 4}}, result.Highlights[0].Ranges)
+	assert.Equal(t, "comment", result.Highlights[1].Field)
+	assert.Equal(t, commentID.String(), result.Highlights[1].CommentId)
+	assert.Equal(t, []*groupv1.TextRange{{Start: 9, End: 13}}, result.Highlights[1].Ranges)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestGroupService_SearchExpenses_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       *groupv1.SearchExpensesRequest
+		expectedError string
+	}{
+		{
+			name:          "invalid group ID",
+			request:       &groupv1.SearchExpensesRequest{GroupId: "trip", Query: "taxi"},
+			expectedError: "グループIDが無効です",
+		},
+		{
+			name:          "empty query",
+			request:       &groupv1.SearchExpensesRequest{GroupId: uuid.New().String(), Query: " "},
+			expectedError: "検索キーワードは必須です",
+		},
+		{
+			name:          "limit too large",
+			request:       &groupv1.SearchExpensesRequest{GroupId: uuid.New().String(), Query: "taxi", Limit: 101},
+			expectedError: "検索件数",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExpenseRepo := new(MockExpenseRepository)
+			service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+			resp, err := service.SearchExpenses(context.Background(), tt.request)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+			assert.Nil(t, resp)
+			mockExpenseRepo.AssertNotCalled(t, "SearchExpenses", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHighlightRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		terms    []string
+		expected []*groupv1.TextRange
+	}{
+		{
+			name:     "case insensitive",
+			text:     "Taxi and TAXI",
+			terms:    []string{"taxi"},
+			expected: []*groupv1.TextRange{{Start: 0, End: 4}, {Start: 9, End: 13}},
+		},
+		{
+			name:     "japanese offsets in runes",
+			text:     "空港からのタクシー",
+			terms:    []string{"タクシー"},
+			expected: []*groupv1.TextRange{{Start: 5, End: 9}},
+		},
+		{
+			name:     "overlapping terms are merged",
+			text:     "dinner",
+			terms:    []string{"din", "inner"},
+			expected: []*groupv1.TextRange{{Start: 0, End: 6}},
+		},
+		{
+			name:     "no occurrence",
+			text:     "Lunch",
+			terms:    []string{"taxi"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, highlightRanges(tt.text, tt.terms))
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateExpenseTemplate saves a template for an expense that is entered
+// repeatedly. The amount may be left out to be entered each time.
+func (s *GroupService) CreateExpenseTemplate(ctx context.Context, req *groupv1.CreateExpenseTemplateRequest) (*groupv1.CreateExpenseTemplateResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	kind, splitMode, err := validateExpenseTemplate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTemplateNameUnique(ctx, groupID, uuid.Nil, req.Name); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &domain.ExpenseTemplate{
+		ID:        uuid.New(),
+		GroupID:   groupID,
+		CreatedAt: now,
+	}
+	if err := fillExpenseTemplate(template, group, req, kind, splitMode, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.expenseRepo.CreateTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.CreateExpenseTemplateResponse{
+		Template: toProtoExpenseTemplate(template),
+	}, nil
+}
+
+// UpdateExpenseTemplate replaces every field of a template
+func (s *GroupService) UpdateExpenseTemplate(ctx context.Context, req *groupv1.UpdateExpenseTemplateRequest) (*groupv1.UpdateExpenseTemplateResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.TemplateId); err != nil {
+		return nil, errors.New("テンプレートIDが無効です")
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, errors.New("invalid template ID")
+	}
+
+	template, err := s.expenseRepo.FindTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := &groupv1.CreateExpenseTemplateRequest{
+		GroupId:        template.GroupID.String(),
+		Name:           req.Name,
+		Description:    req.Description,
+		Amount:         req.Amount,
+		Category:       req.Category,
+		Kind:           req.Kind,
+		PaidById:       req.PaidById,
+		SplitMemberIds: req.SplitMemberIds,
+		SplitMode:      req.SplitMode,
+		SplitShares:    req.SplitShares,
+	}
+	kind, splitMode, err := validateExpenseTemplate(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.repo.GetGroupByID(fields.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTemplateNameUnique(ctx, template.GroupID, template.ID, req.Name); err != nil {
+		return nil, err
+	}
+
+	if err := fillExpenseTemplate(template, group, fields, kind, splitMode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.expenseRepo.UpdateTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateExpenseTemplateResponse{
+		Template: toProtoExpenseTemplate(template),
+	}, nil
+}
+
+func (s *GroupService) DeleteExpenseTemplate(ctx context.Context, req *groupv1.DeleteExpenseTemplateRequest) (*groupv1.DeleteExpenseTemplateResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.TemplateId); err != nil {
+		return nil, errors.New("テンプレートIDが無効です")
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, errors.New("invalid template ID")
+	}
+
+	if err := s.expenseRepo.DeleteTemplate(ctx, templateID); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.DeleteExpenseTemplateResponse{
+		Success: true,
+	}, nil
+}
+
+// ListExpenseTemplates returns the templates of a group ordered by name
+func (s *GroupService) ListExpenseTemplates(ctx context.Context, req *groupv1.ListExpenseTemplatesRequest) (*groupv1.ListExpenseTemplatesResponse, error) {
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	templates, err := s.expenseRepo.FindTemplatesByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoTemplates := make([]*groupv1.ExpenseTemplate, len(templates))
+	for i, template := range templates {
+		protoTemplates[i] = toProtoExpenseTemplate(template)
+	}
+
+	return &groupv1.ListExpenseTemplatesResponse{
+		Templates: protoTemplates,
+	}, nil
+}
+
+// AddExpenseFromTemplate adds an expense filled in from a template, with any
+// fields given in the request taking the place of the template's. The expense
+// is added the same way as by AddExpense.
+func (s *GroupService) AddExpenseFromTemplate(ctx context.Context, req *groupv1.AddExpenseFromTemplateRequest) (*groupv1.AddExpenseFromTemplateResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.TemplateId); err != nil {
+		return nil, errors.New("テンプレートIDが無効です")
+	}
+
+	templateID, err := uuid.Parse(req.TemplateId)
+	if err != nil {
+		return nil, errors.New("invalid template ID")
+	}
+
+	template, err := s.expenseRepo.FindTemplateByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	addReq := &groupv1.AddExpenseRequest{
+		GroupId:        template.GroupID.String(),
+		Amount:         template.Amount,
+		Description:    template.Description,
+		PaidById:       template.PaidByID.String(),
+		Category:       template.Category,
+		Kind:           toProtoExpenseKind(template.Kind),
+		AllowDuplicate: req.AllowDuplicate,
+	}
+	if req.Amount != 0 {
+		addReq.Amount = req.Amount
+	}
+	if req.Description != "" {
+		addReq.Description = req.Description
+	}
+	if req.PaidById != "" {
+		addReq.PaidById = req.PaidById
+	}
+	if req.Category != "" {
+		addReq.Category = req.Category
+	}
+
+	shares := make(map[string]int32, len(template.Members))
+	for _, member := range template.Members {
+		addReq.SplitMemberIds = append(addReq.SplitMemberIds, member.MemberID.String())
+		shares[member.MemberID.String()] = member.Shares
+	}
+	if len(req.SplitMemberIds) > 0 {
+		addReq.SplitMemberIds = req.SplitMemberIds
+	}
+
+	if template.SplitMode == domain.SplitModeShares && addReq.Amount > 0 {
+		memberShares := make([]int32, len(addReq.SplitMemberIds))
+		for i, memberID := range addReq.SplitMemberIds {
+			memberShares[i] = cmp.Or(shares[memberID], 1)
+		}
+		addReq.SplitAmounts = splitByShares(addReq.Amount, memberShares)
+	}
+
+	resp, err := s.AddExpense(ctx, addReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.AddExpenseFromTemplateResponse{
+		Expense:            resp.Expense,
+		BudgetAlerts:       resp.BudgetAlerts,
+		ProbableDuplicates: resp.ProbableDuplicates,
+	}, nil
+}
+
+// validateExpenseTemplate checks a template as the expense it fills in, with
+// a missing amount left to be entered later
+func validateExpenseTemplate(req *groupv1.CreateExpenseTemplateRequest) (domain.ExpenseKind, domain.SplitMode, error) {
+	if err := validator.ValidateTemplateName(req.Name); err != nil {
+		return "", "", err
+	}
+
+	kind, err := expenseKindFromProto(req.Kind)
+	if err != nil {
+		return "", "", err
+	}
+
+	amount := req.Amount
+	if amount == 0 {
+		amount = validator.MinExpenseAmount
+	}
+	if err := validateExpenseEntry(kind, amount, req.Description, req.PaidById, req.SplitMemberIds); err != nil {
+		return "", "", err
+	}
+
+	if err := validator.ValidateExpenseCategory(req.Category); err != nil {
+		return "", "", err
+	}
+
+	switch req.SplitMode {
+	case groupv1.SplitMode_SPLIT_MODE_UNSPECIFIED, groupv1.SplitMode_SPLIT_MODE_EQUAL:
+		return kind, domain.SplitModeEqual, nil
+	case groupv1.SplitMode_SPLIT_MODE_SHARES:
+		if err := validator.ValidateSplitShares(len(req.SplitMemberIds), req.SplitShares); err != nil {
+			return "", "", err
+		}
+		return kind, domain.SplitModeShares, nil
+	default:
+		return "", "", validator.ValidationError{Field: "splitMode", Message: "分け方が無効です"}
+	}
+}
+
+// fillExpenseTemplate sets the fields of a template from a validated request,
+// checking the payer and split members against the group
+func fillExpenseTemplate(template *domain.ExpenseTemplate, group *groupv1.Group, req *groupv1.CreateExpenseTemplateRequest, kind domain.ExpenseKind, splitMode domain.SplitMode, now time.Time) error {
+	payer := findMember(group, req.PaidById)
+	if payer == nil {
+		return errors.New("paid by member not found in group")
+	}
+
+	members := make([]domain.TemplateMember, len(req.SplitMemberIds))
+	for i, memberID := range req.SplitMemberIds {
+		member := findMember(group, memberID)
+		if member == nil {
+			return errors.New("member not found: " + memberID)
+		}
+
+		members[i] = domain.TemplateMember{
+			MemberID:   uuid.MustParse(member.Id),
+			MemberName: member.Name,
+			Shares:     1,
+		}
+		if splitMode == domain.SplitModeShares {
+			members[i].Shares = req.SplitShares[i]
+		}
+	}
+
+	template.Name = strings.TrimSpace(req.Name)
+	template.Description = strings.TrimSpace(req.Description)
+	template.Amount = req.Amount
+	template.Category = strings.TrimSpace(req.Category)
+	template.Kind = kind
+	template.PaidByID = uuid.MustParse(payer.Id)
+	template.PaidByName = payer.Name
+	template.SplitMode = splitMode
+	template.Members = members
+	template.UpdatedAt = now
+	return nil
+}
+
+// checkTemplateNameUnique rejects a name already used by another template of
+// the group
+func (s *GroupService) checkTemplateNameUnique(ctx context.Context, groupID, templateID uuid.UUID, name string) error {
+	templates, err := s.expenseRepo.FindTemplatesByGroupID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	for _, template := range templates {
+		if template.Name == name && template.ID != templateID {
+			return validator.ValidationError{Field: "name", Message: fmt.Sprintf("テンプレート「%s」は既に存在します", name)}
+		}
+	}
+	return nil
+}
+
+// splitByShares divides an amount in proportion to the shares. The yen left
+// over from rounding down go one each to the largest remainders, the earlier
+// member first on a tie, so the amounts always add up.
+func splitByShares(amount int64, shares []int32) []int64 {
+	var total int64
+	for _, share := range shares {
+		total += int64(share)
+	}
+
+	amounts := make([]int64, len(shares))
+	if total == 0 {
+		return amounts
+	}
+
+	remainders := make([]int64, len(shares))
+	left := amount
+	for i, share := range shares {
+		amounts[i] = amount * int64(share) / total
+		remainders[i] = amount * int64(share) % total
+		left -= amounts[i]
+	}
+
+	order := make([]int, len(shares))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+	for _, i := range order[:left] {
+		amounts[i]++
+	}
+
+	return amounts
+}
+
+func toProtoSplitMode(mode domain.SplitMode) groupv1.SplitMode {
+	if mode == domain.SplitModeShares {
+		return groupv1.SplitMode_SPLIT_MODE_SHARES
+	}
+	return groupv1.SplitMode_SPLIT_MODE_EQUAL
+}
+
+func toProtoExpenseTemplate(template *domain.ExpenseTemplate) *groupv1.ExpenseTemplate {
+	members := make([]*groupv1.TemplateMember, len(template.Members))
+	for i, member := range template.Members {
+		members[i] = &groupv1.TemplateMember{
+			MemberId:   member.MemberID.String(),
+			MemberName: member.MemberName,
+			Shares:     member.Shares,
+		}
+	}
+
+	return &groupv1.ExpenseTemplate{
+		Id:          template.ID.String(),
+		GroupId:     template.GroupID.String(),
+		Name:        template.Name,
+		Description: template.Description,
+		Amount:      template.Amount,
+		Category:    template.Category,
+		Kind:        toProtoExpenseKind(template.Kind),
+		PaidById:    template.PaidByID.String(),
+		PaidByName:  template.PaidByName,
+		SplitMode:   toProtoSplitMode(template.SplitMode),
+		Members:     members,
+		CreatedAt:   timestamppb.New(template.CreatedAt),
+		UpdatedAt:   timestamppb.New(template.UpdatedAt),
+	}
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_CreateExpenseTemplate(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+
+	validRequest := func() *groupv1.CreateExpenseTemplateRequest {
+		return &groupv1.CreateExpenseTemplateRequest{
+			GroupId:        groupID.String(),
+			Name:           " 週末の買い出し ",
+			Description:    "スーパー",
+			Category:       "食費",
+			PaidById:       aliceID.String(),
+			SplitMemberIds: []string{aliceID.String(), bobID.String()},
+			SplitMode:      groupv1.SplitMode_SPLIT_MODE_SHARES,
+			SplitShares:    []int32{2, 1},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		request       func() *groupv1.CreateExpenseTemplateRequest
+		setupMocks    func(*MockGroupRepositoryInterface, *MockExpenseRepository)
+		expectedError string
+	}{
+		{
+			name:    "template without an amount",
+			request: validRequest,
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				groupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				expenseRepo.On("FindTemplatesByGroupID", mock.Anything, groupID).Return([]*domain.ExpenseTemplate{}, nil)
+				expenseRepo.On("CreateTemplate", mock.Anything, mock.MatchedBy(func(template *domain.ExpenseTemplate) bool {
+					return template.Name == "週末の買い出し" &&
+						template.Amount == 0 &&
+						template.PaidByName == "Alice" &&
+						template.SplitMode == domain.SplitModeShares &&
+						len(template.Members) == 2 &&
+						template.Members[0].Shares == 2 &&
+						template.Members[1].MemberName == "Bob"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "shares do not match the members",
+			request: func() *groupv1.CreateExpenseTemplateRequest {
+				req := validRequest()
+				req.SplitShares = []int32{1}
+				return req
+			},
+			setupMocks:    func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {},
+			expectedError: "比率の数が分配先の数と一致しません",
+		},
+		{
+			name: "missing name",
+			request: func() *groupv1.CreateExpenseTemplateRequest {
+				req := validRequest()
+				req.Name = ""
+				return req
+			},
+			setupMocks:    func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {},
+			expectedError: "テンプレート名は必須です",
+		},
+		{
+			name:    "name already used",
+			request: validRequest,
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				groupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				expenseRepo.On("FindTemplatesByGroupID", mock.Anything, groupID).Return([]*domain.ExpenseTemplate{
+					{ID: uuid.New(), Name: "週末の買い出し"},
+				}, nil)
+			},
+			expectedError: "既に存在します",
+		},
+		{
+			name: "member outside the group",
+			request: func() *groupv1.CreateExpenseTemplateRequest {
+				req := validRequest()
+				req.SplitMemberIds = []string{aliceID.String(), uuid.New().String()}
+				return req
+			},
+			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
+				groupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				expenseRepo.On("FindTemplatesByGroupID", mock.Anything, groupID).Return([]*domain.ExpenseTemplate{}, nil)
+			},
+			expectedError: "member not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			mockExpenseRepo := new(MockExpenseRepository)
+			tt.setupMocks(mockGroupRepo, mockExpenseRepo)
+
+			service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+			resp, err := service.CreateExpenseTemplate(context.Background(), tt.request())
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "週末の買い出し", resp.Template.Name)
+				assert.Equal(t, groupv1.SplitMode_SPLIT_MODE_SHARES, resp.Template.SplitMode)
+			}
+
+			mockGroupRepo.AssertExpectations(t)
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupService_UpdateExpenseTemplate_KeepsOwnName(t *testing.T) {
+	groupID := uuid.New()
+	templateID := uuid.New()
+	aliceID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:      groupID.String(),
+		Members: []*groupv1.Member{{Id: aliceID.String(), Name: "Alice"}},
+	}
+	existing := &domain.ExpenseTemplate{ID: templateID, GroupID: groupID, Name: "ガソリン"}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindTemplateByID", mock.Anything, templateID).Return(existing, nil)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+	mockExpenseRepo.On("FindTemplatesByGroupID", mock.Anything, groupID).Return([]*domain.ExpenseTemplate{existing}, nil)
+	mockExpenseRepo.On("UpdateTemplate", mock.Anything, mock.MatchedBy(func(template *domain.ExpenseTemplate) bool {
+		return template.ID == templateID && template.Amount == 5000 && template.SplitMode == domain.SplitModeEqual
+	})).Return(nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.UpdateExpenseTemplate(context.Background(), &groupv1.UpdateExpenseTemplateRequest{
+		TemplateId:     templateID.String(),
+		Name:           "ガソリン",
+		Description:    "ガソリン代",
+		Amount:         5000,
+		PaidById:       aliceID.String(),
+		SplitMemberIds: []string{aliceID.String()},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5000), resp.Template.Amount)
+	assert.Equal(t, groupv1.SplitMode_SPLIT_MODE_EQUAL, resp.Template.SplitMode)
+	mockExpenseRepo.AssertExpectations(t)
+}
+
+func TestGroupService_AddExpenseFromTemplate(t *testing.T) {
+	groupID := uuid.New()
+	templateID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+			{Id: carolID.String(), Name: "Carol"},
+		},
+	}
+
+	template := &domain.ExpenseTemplate{
+		ID:          templateID,
+		GroupID:     groupID,
+		Name:        "週末の買い出し",
+		Description: "スーパー",
+		Category:    "食費",
+		Kind:        domain.ExpenseKindExpense,
+		PaidByID:    aliceID,
+		SplitMode:   domain.SplitModeShares,
+		Members: []domain.TemplateMember{
+			{MemberID: aliceID, MemberName: "Alice", Shares: 2},
+			{MemberID: bobID, MemberName: "Bob", Shares: 1},
+		},
+	}
+
+	splitAmounts := func(expense *domain.Expense) map[uuid.UUID]int64 {
+		amounts := make(map[uuid.UUID]int64)
+		for _, split := range expense.SplitMembers {
+			amounts[split.MemberID] = split.Amount
+		}
+		return amounts
+	}
+
+	tests := []struct {
+		name          string
+		request       *groupv1.AddExpenseFromTemplateRequest
+		check         func(*domain.Expense) bool
+		expectedError string
+	}{
+		{
+			name:    "amount entered, rest from the template",
+			request: &groupv1.AddExpenseFromTemplateRequest{TemplateId: templateID.String(), Amount: 1000},
+			check: func(expense *domain.Expense) bool {
+				amounts := splitAmounts(expense)
+				return expense.Description == "スーパー" &&
+					expense.Category == "食費" &&
+					expense.PaidByID == aliceID &&
+					amounts[aliceID] == 667 && amounts[bobID] == 333
+			},
+		},
+		{
+			name: "overridden members and payer",
+			request: &groupv1.AddExpenseFromTemplateRequest{
+				TemplateId:     templateID.String(),
+				Amount:         1000,
+				Description:    "ドラッグストア",
+				PaidById:       bobID.String(),
+				SplitMemberIds: []string{aliceID.String(), carolID.String()},
+			},
+			check: func(expense *domain.Expense) bool {
+				amounts := splitAmounts(expense)
+				return expense.Description == "ドラッグストア" &&
+					expense.PaidByID == bobID &&
+					len(amounts) == 2 &&
+					amounts[aliceID] == 667 && amounts[carolID] == 333
+			},
+		},
+		{
+			name:          "template without an amount needs one",
+			request:       &groupv1.AddExpenseFromTemplateRequest{TemplateId: templateID.String()},
+			expectedError: "金額は1円以上で入力してください",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockGroupRepo := new(MockGroupRepositoryInterface)
+			mockExpenseRepo := new(MockExpenseRepository)
+			mockExpenseRepo.On("FindTemplateByID", mock.Anything, templateID).Return(template, nil)
+			if tt.check != nil {
+				mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+				mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+				mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+				mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(nil, nil)
+				mockExpenseRepo.On("Create", mock.Anything, mock.MatchedBy(tt.check)).Return(nil)
+			}
+
+			service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+			resp, err := service.AddExpenseFromTemplate(context.Background(), tt.request)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, int64(1000), resp.Expense.Amount)
+			}
+
+			mockGroupRepo.AssertExpectations(t)
+			mockExpenseRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSplitByShares(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		shares []int32
+		want   []int64
+	}{
+		{name: "exact", amount: 900, shares: []int32{2, 1}, want: []int64{600, 300}},
+		{name: "largest remainder gets the yen", amount: 1000, shares: []int32{1, 2}, want: []int64{333, 667}},
+		{name: "tie goes to the earlier member", amount: 100, shares: []int32{1, 1, 1}, want: []int64{34, 33, 33}},
+		{name: "no shares", amount: 100, shares: nil, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, splitByShares(tt.amount, tt.shares))
+		})
+	}
+}
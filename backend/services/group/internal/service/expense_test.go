@@ -54,6 +54,11 @@ func TestGroupService_AddExpense(t *testing.T) {
 					},
 				}, nil)
 
+				groupRepo.On("GetBudgets", "550e8400-e29b-41d4-a716-446655440000").Return(nil, nil)
+
+				groupRepo.On("GetGroupSettings", "550e8400-e29b-41d4-a716-446655440000").Return(&groupv1.GroupSettings{}, nil)
+
+				expenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")).Return(nil, nil)
 				expenseRepo.On("Create", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
 					return expense.GroupID.String() != "" &&
 						expense.Amount == 3000 &&
@@ -73,7 +78,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "550e8400-e29b-41d4-a716-446655440001",
 				SplitMemberIds: []string{"550e8400-e29b-41d4-a716-446655440001"},
 			},
-			expectedError: "group ID is required",
+			expectedError: "グループIDが無効です",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -87,7 +92,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "550e8400-e29b-41d4-a716-446655440001",
 				SplitMemberIds: []string{"550e8400-e29b-41d4-a716-446655440001"},
 			},
-			expectedError: "amount must be positive",
+			expectedError: "金額は1円以上で入力してください",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -101,7 +106,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "550e8400-e29b-41d4-a716-446655440001",
 				SplitMemberIds: []string{"550e8400-e29b-41d4-a716-446655440001"},
 			},
-			expectedError: "description is required",
+			expectedError: "支払いの説明は必須です",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -115,7 +120,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "",
 				SplitMemberIds: []string{"550e8400-e29b-41d4-a716-446655440001"},
 			},
-			expectedError: "paid by ID is required",
+			expectedError: "支払い者IDが無効です",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -129,7 +134,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "550e8400-e29b-41d4-a716-446655440001",
 				SplitMemberIds: []string{},
 			},
-			expectedError: "split members are required",
+			expectedError: "割り勘対象者は必須です",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -143,7 +148,7 @@ func TestGroupService_AddExpense(t *testing.T) {
 				PaidById:       "550e8400-e29b-41d4-a716-446655440001",
 				SplitMemberIds: []string{"550e8400-e29b-41d4-a716-446655440001"},
 			},
-			expectedError: "invalid group ID",
+			expectedError: "グループIDが無効です",
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -222,7 +227,7 @@ func TestGroupService_GetGroupExpenses(t *testing.T) {
 				},
 			},
 			setupMocks: func(expenseRepo *MockExpenseRepository) {
-				expenseRepo.On("FindByGroupID", mock.Anything, uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")).Return([]*domain.Expense{
+				expenseRepo.On("FindByQuery", mock.Anything, domain.ExpenseQuery{GroupID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")}).Return([]*domain.Expense{
 					{
 						ID:          uuid.MustParse("550e8400-e29b-41d4-a716-446655440001"),
 						GroupID:     uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"),
@@ -257,7 +262,7 @@ func TestGroupService_GetGroupExpenses(t *testing.T) {
 			request: &groupv1.GetGroupExpensesRequest{
 				GroupId: "",
 			},
-			expectedError: "group ID is required",
+			expectedError: "グループIDが無効です",
 			setupMocks: func(expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -267,7 +272,7 @@ func TestGroupService_GetGroupExpenses(t *testing.T) {
 			request: &groupv1.GetGroupExpensesRequest{
 				GroupId: "invalid-uuid",
 			},
-			expectedError: "invalid group ID",
+			expectedError: "グループIDが無効です",
 			setupMocks: func(expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
@@ -337,6 +342,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 					PaidByID:    uuid.MustParse("550e8400-e29b-41d4-a716-446655440002"),
 				}
 				expenseRepo.On("FindByID", mock.Anything, uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")).Return(existingExpense, nil)
+				groupRepo.On("GetClosedPeriods", "550e8400-e29b-41d4-a716-446655440000").Return(nil, nil)
 
 				mockGroup := &groupv1.Group{
 					Id:       "550e8400-e29b-41d4-a716-446655440000",
@@ -348,6 +354,8 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 					},
 				}
 				groupRepo.On("GetGroupByID", "550e8400-e29b-41d4-a716-446655440000").Return(mockGroup, nil)
+				groupRepo.On("GetBudgets", "550e8400-e29b-41d4-a716-446655440000").Return(nil, nil)
+				groupRepo.On("GetGroupSettings", "550e8400-e29b-41d4-a716-446655440000").Return(&groupv1.GroupSettings{}, nil)
 
 				expenseRepo.On("Update", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
 					return expense.ID.String() == "550e8400-e29b-41d4-a716-446655440001" &&
@@ -355,7 +363,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 						expense.Description == "Updated Lunch" &&
 						expense.PaidByID.String() == "550e8400-e29b-41d4-a716-446655440002" &&
 						len(expense.SplitMembers) == 2
-				})).Return(nil)
+				}), domain.ExpenseChange{}).Return(nil)
 
 				updatedExpense := &domain.Expense{
 					ID:          uuid.MustParse("550e8400-e29b-41d4-a716-446655440001"),
@@ -393,7 +401,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "expense ID is required",
+			expectedError: "支払いIDが無効です",
 		},
 		{
 			name: "amount must be positive",
@@ -407,7 +415,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "amount must be positive",
+			expectedError: "金額は1円以上で入力してください",
 		},
 		{
 			name: "description required",
@@ -421,7 +429,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "description is required",
+			expectedError: "支払いの説明は必須です",
 		},
 		{
 			name: "paid by ID required",
@@ -435,7 +443,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "paid by ID is required",
+			expectedError: "支払い者IDが無効です",
 		},
 		{
 			name: "split members required",
@@ -449,7 +457,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "split members are required",
+			expectedError: "割り勘対象者は必須です",
 		},
 		{
 			name: "invalid expense ID format",
@@ -463,7 +471,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 			setupMocks: func(groupRepo *MockGroupRepositoryInterface, expenseRepo *MockExpenseRepository) {
 				// No mocks needed for validation errors
 			},
-			expectedError: "invalid expense ID",
+			expectedError: "支払いIDが無効です",
 		},
 		{
 			name: "expense not found",
@@ -494,6 +502,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 					GroupID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"),
 				}
 				expenseRepo.On("FindByID", mock.Anything, uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")).Return(existingExpense, nil)
+				groupRepo.On("GetClosedPeriods", "550e8400-e29b-41d4-a716-446655440000").Return(nil, nil)
 
 				mockGroup := &groupv1.Group{
 					Id:       "550e8400-e29b-41d4-a716-446655440000",
@@ -523,6 +532,7 @@ func TestGroupService_UpdateExpense(t *testing.T) {
 					GroupID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"),
 				}
 				expenseRepo.On("FindByID", mock.Anything, uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")).Return(existingExpense, nil)
+				groupRepo.On("GetClosedPeriods", "550e8400-e29b-41d4-a716-446655440000").Return(nil, nil)
 
 				mockGroup := &groupv1.Group{
 					Id:       "550e8400-e29b-41d4-a716-446655440000",
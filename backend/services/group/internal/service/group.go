@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,6 +11,8 @@ import (
 	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
 	"github.com/jt-chihara/warikan/services/group/internal/domain"
 	"github.com/jt-chihara/warikan/services/group/internal/repository"
+	"github.com/jt-chihara/warikan/services/group/internal/secret"
+	"github.com/jt-chihara/warikan/services/group/internal/storage"
 	"github.com/jt-chihara/warikan/services/group/internal/validator"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -17,13 +20,34 @@ import (
 type GroupService struct {
 	repo        GroupRepositoryInterface
 	expenseRepo repository.ExpenseRepository
+	blobStore   storage.BlobStore
+	// How long deleted expenses stay in the trash; zero keeps them forever
+	trashRetention time.Duration
+	// Encrypts member bank accounts; nil when no key is configured
+	bankAccountCipher *secret.Cipher
+	// Told about budget thresholds crossed by expenses; nil when unset
+	budgetAlertNotifier BudgetAlertNotifier
 }
 
-func NewGroupService(repo GroupRepositoryInterface, expenseRepo repository.ExpenseRepository) *GroupService {
-	return &GroupService{
+// Option configures optional collaborators of GroupService
+type Option func(*GroupService)
+
+// WithBlobStore sets the store used for expense attachments
+func WithBlobStore(blobStore storage.BlobStore) Option {
+	return func(s *GroupService) {
+		s.blobStore = blobStore
+	}
+}
+
+func NewGroupService(repo GroupRepositoryInterface, expenseRepo repository.ExpenseRepository, opts ...Option) *GroupService {
+	s := &GroupService{
 		repo:        repo,
 		expenseRepo: expenseRepo,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *GroupService) CreateGroup(ctx context.Context, req *groupv1.CreateGroupRequest) (*groupv1.CreateGroupResponse, error) {
@@ -68,6 +92,14 @@ func (s *GroupService) GetGroup(ctx context.Context, req *groupv1.GetGroupReques
 		return nil, err
 	}
 
+	if err := s.withMemberActivePeriods(group); err != nil {
+		return nil, err
+	}
+
+	if err := s.withMemberProfiles(group); err != nil {
+		return nil, err
+	}
+
 	return &groupv1.GetGroupResponse{
 		Group: group,
 	}, nil
@@ -126,7 +158,11 @@ func (s *GroupService) AddMember(ctx context.Context, req *groupv1.AddMemberRequ
 		return nil, err
 	}
 
-	member, err := s.repo.AddMember(req.GroupId, req.MemberName)
+	if err := validator.ValidateEmail(req.MemberEmail); err != nil {
+		return nil, err
+	}
+
+	member, err := s.repo.AddMember(req.GroupId, req.MemberName, req.MemberEmail)
 	if err != nil {
 		return nil, err
 	}
@@ -146,13 +182,70 @@ func (s *GroupService) RemoveMember(ctx context.Context, req *groupv1.RemoveMemb
 		return nil, errors.New("メンバーIDが無効です")
 	}
 
-	err := s.repo.RemoveMember(req.GroupId, req.MemberId)
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	member := findMember(group, req.MemberId)
+	if member == nil {
+		return nil, errors.New("メンバーがグループに存在しません")
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// An expense still under review could change the balance after removal
+	for _, expense := range expenses {
+		if !expense.IsApproved() && expenseInvolves(expense, req.MemberId) {
+			return nil, errors.New("承認待ちの支払いに含まれるメンバーは削除できません")
+		}
+	}
+
+	var balance int64
+	for _, b := range memberBalances(group, expenses) {
+		if b.MemberID == req.MemberId {
+			balance = b.Amount
+		}
+	}
+
+	transfers, err := balanceTransfers(group, groupID, member, balance, req.Redistribution, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	removal := &domain.MemberRemoval{
+		GroupID:   req.GroupId,
+		MemberID:  req.MemberId,
+		Transfers: transfers,
+	}
+	for _, expense := range expenses {
+		if expenseInvolves(expense, req.MemberId) {
+			removal.Expenses = append(removal.Expenses, domain.ExpenseVersion{
+				ExpenseID:      expense.ID,
+				UpdatedAt:      expense.UpdatedAt,
+				ApprovalStatus: expense.ApprovalStatus,
+			})
+		}
+	}
+
+	// The transfers are only saved together with the removal, and only if
+	// the expenses behind the balance did not change in the meantime
+	err = s.repo.RemoveMember(removal)
 	if err != nil {
 		return nil, err
 	}
 
 	return &groupv1.RemoveMemberResponse{
-		Success: true,
+		Success:   true,
+		Transfers: toProtoExpenses(transfers),
 	}, nil
 }
 
@@ -167,15 +260,29 @@ func (s *GroupService) CalculateSettlements(ctx context.Context, req *groupv1.Ca
 		return nil, err
 	}
 
+	// Saved expenses still under review must not count yet
+	unapproved, err := s.unapprovedExpenseIDs(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert proto expenses to algorithm format
-	algExpenses := make([]algorithm.Expense, len(req.Expenses))
-	for i, expense := range req.Expenses {
-		algExpenses[i] = algorithm.Expense{
+	algExpenses := make([]algorithm.Expense, 0, len(req.Expenses))
+	for _, expense := range req.Expenses {
+		if err := validator.ValidateSplitAmounts(expense.Amount, len(expense.SplitBetween), expense.SplitAmounts); err != nil {
+			return nil, err
+		}
+		if unapproved[expense.Id] {
+			continue
+		}
+		algExpenses = append(algExpenses, algorithm.Expense{
 			ID:           expense.Id,
 			PayerID:      expense.PayerId,
 			Amount:       expense.Amount,
 			SplitBetween: expense.SplitBetween,
-		}
+			Income:       expense.Kind == groupv1.ExpenseKind_EXPENSE_KIND_INCOME,
+			SplitAmounts: expense.SplitAmounts,
+		})
 	}
 
 	// Convert proto members to algorithm format
@@ -190,8 +297,20 @@ func (s *GroupService) CalculateSettlements(ctx context.Context, req *groupv1.Ca
 	// Calculate member balances
 	balances := algorithm.CalculateMemberBalances(algExpenses, algMembers)
 
+	// Households settle up as one
+	settleBalances := balances
+	var units []*householdUnit
+	if req.ByHousehold {
+		households, err := s.repo.GetHouseholds(req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		units = householdUnits(group, households, balances)
+		settleBalances = unitBalances(units)
+	}
+
 	// Calculate optimal settlements
-	settlements, err := algorithm.CalculateOptimalSettlements(balances)
+	settlements, err := algorithm.CalculateOptimalSettlements(settleBalances)
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +326,7 @@ func (s *GroupService) CalculateSettlements(ctx context.Context, req *groupv1.Ca
 			ToName:       settlement.ToName,
 		}
 	}
+	setSettlementHouseholds(protoSettlements, units)
 
 	protoBalances := make([]*groupv1.MemberBalance, len(balances))
 	for i, balance := range balances {
@@ -218,8 +338,9 @@ func (s *GroupService) CalculateSettlements(ctx context.Context, req *groupv1.Ca
 	}
 
 	return &groupv1.CalculateSettlementsResponse{
-		Settlements: protoSettlements,
-		Balances:    protoBalances,
+		Settlements:       protoSettlements,
+		Balances:          protoBalances,
+		HouseholdBalances: toProtoHouseholdBalances(units),
 	}, nil
 }
 
@@ -229,19 +350,29 @@ func (s *GroupService) AddExpense(ctx context.Context, req *groupv1.AddExpenseRe
 		return nil, errors.New("グループIDが無効です")
 	}
 	
-	if err := validator.ValidateExpenseAmount(req.Amount); err != nil {
-		return nil, err
-	}
-	
-	if err := validator.ValidateExpenseDescription(req.Description); err != nil {
-		return nil, err
+	now := time.Now()
+	if req.SplitAmongPresent {
+		periods, err := s.repo.GetMemberActivePeriods(req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		if err := splitAmongPresent(req, periods, now); err != nil {
+			return nil, err
+		}
 	}
-	
-	if err := validator.ValidateUUID(req.PaidById); err != nil {
-		return nil, errors.New("支払い者IDが無効です")
+
+	if req.SplitByHousehold {
+		households, err := s.repo.GetHouseholds(req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+		if err := splitByHousehold(req, households); err != nil {
+			return nil, err
+		}
 	}
-	
-	if err := validator.ValidateSplitMemberIds(req.SplitMemberIds); err != nil {
+
+	kind, err := validateNewExpense(req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -251,67 +382,34 @@ func (s *GroupService) AddExpense(ctx context.Context, req *groupv1.AddExpenseRe
 		return nil, errors.New("invalid group ID")
 	}
 
-	paidByID, err := uuid.Parse(req.PaidById)
-	if err != nil {
-		return nil, errors.New("invalid paid by ID")
-	}
-
 	// Validate group exists and get members
 	group, err := s.repo.GetGroupByID(req.GroupId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calculate split amount
-	splitAmount := req.Amount / int64(len(req.SplitMemberIds))
-
-	// Create split members
-	var splitMembers []domain.SplitMember
-	var paidByName string
+	// Create expense
+	expense, err := newExpense(group, groupID, req, kind, now)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, memberID := range req.SplitMemberIds {
-		memberUUID, err := uuid.Parse(memberID)
+	// Hold back an expense that looks like one already recorded
+	if !req.AllowDuplicate {
+		duplicates, err := s.probableDuplicates(ctx, expense)
 		if err != nil {
-			return nil, errors.New("invalid member ID: " + memberID)
+			return nil, err
 		}
-
-		// Find member name
-		var memberName string
-		for _, member := range group.Members {
-			if member.Id == memberID {
-				memberName = member.Name
-				break
-			}
-		}
-		if memberName == "" {
-			return nil, errors.New("member not found: " + memberID)
-		}
-
-		// Set paid by name if this is the payer
-		if memberID == req.PaidById {
-			paidByName = memberName
+		if len(duplicates) > 0 {
+			return &groupv1.AddExpenseResponse{
+				ProbableDuplicates: toProtoExpenses(duplicates),
+			}, nil
 		}
-
-		splitMembers = append(splitMembers, domain.SplitMember{
-			MemberID:   memberUUID,
-			MemberName: memberName,
-			Amount:     splitAmount,
-		})
 	}
 
-	// Create expense
-	now := time.Now()
-	expense := &domain.Expense{
-		ID:           uuid.New(),
-		GroupID:      groupID,
-		Amount:       req.Amount,
-		Description:  req.Description,
-		Currency:     group.Currency,
-		PaidByID:     paidByID,
-		PaidByName:   paidByName,
-		SplitMembers: splitMembers,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+	expense.ApprovalStatus, err = s.newExpenseApprovalStatus(req.GroupId)
+	if err != nil {
+		return nil, err
 	}
 
 	// Save expense
@@ -320,27 +418,9 @@ func (s *GroupService) AddExpense(ctx context.Context, req *groupv1.AddExpenseRe
 		return nil, err
 	}
 
-	// Convert to proto format
-	protoSplitMembers := make([]*groupv1.SplitMember, len(splitMembers))
-	for i, split := range splitMembers {
-		protoSplitMembers[i] = &groupv1.SplitMember{
-			MemberId:   split.MemberID.String(),
-			MemberName: split.MemberName,
-			Amount:     split.Amount,
-		}
-	}
-
 	return &groupv1.AddExpenseResponse{
-		Expense: &groupv1.ExpenseWithDetails{
-			Id:           expense.ID.String(),
-			GroupId:      expense.GroupID.String(),
-			Amount:       expense.Amount,
-			Description:  expense.Description,
-			PaidById:     expense.PaidByID.String(),
-			PaidByName:   expense.PaidByName,
-			SplitMembers: protoSplitMembers,
-			CreatedAt:    timestamppb.New(expense.CreatedAt),
-		},
+		Expense:      toProtoExpense(expense),
+		BudgetAlerts: s.budgetAlerts(ctx, nil, expense),
 	}, nil
 }
 
@@ -349,42 +429,53 @@ func (s *GroupService) GetGroupExpenses(ctx context.Context, req *groupv1.GetGro
 		return nil, errors.New("グループIDが無効です")
 	}
 
+	if err := validator.ValidatePageSize(req.PageSize); err != nil {
+		return nil, err
+	}
+
 	groupID, err := uuid.Parse(req.GroupId)
 	if err != nil {
 		return nil, errors.New("invalid group ID")
 	}
 
-	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	query, err := buildExpenseQuery(groupID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to find out whether another page follows
+	pageSize := int(req.PageSize)
+	if pageSize > 0 {
+		query.Limit = pageSize + 1
+	}
+
+	expenses, err := s.expenseRepo.FindByQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
+	hasNextPage := pageSize > 0 && len(expenses) > pageSize
+	if hasNextPage {
+		expenses = expenses[:pageSize]
+	}
+
 	// Convert to proto format
 	protoExpenses := make([]*groupv1.ExpenseWithDetails, len(expenses))
+	cursors := make([]string, len(expenses))
 	for i, expense := range expenses {
-		protoSplitMembers := make([]*groupv1.SplitMember, len(expense.SplitMembers))
-		for j, split := range expense.SplitMembers {
-			protoSplitMembers[j] = &groupv1.SplitMember{
-				MemberId:   split.MemberID.String(),
-				MemberName: split.MemberName,
-				Amount:     split.Amount,
-			}
-		}
+		protoExpenses[i] = toProtoExpense(expense)
+		cursors[i] = encodePageToken(query, expense)
+	}
 
-		protoExpenses[i] = &groupv1.ExpenseWithDetails{
-			Id:           expense.ID.String(),
-			GroupId:      expense.GroupID.String(),
-			Amount:       expense.Amount,
-			Description:  expense.Description,
-			PaidById:     expense.PaidByID.String(),
-			PaidByName:   expense.PaidByName,
-			SplitMembers: protoSplitMembers,
-			CreatedAt:    timestamppb.New(expense.CreatedAt),
-		}
+	var nextPageToken string
+	if hasNextPage {
+		nextPageToken = cursors[len(cursors)-1]
 	}
 
 	return &groupv1.GetGroupExpensesResponse{
-		Expenses: protoExpenses,
+		Expenses:      protoExpenses,
+		NextPageToken: nextPageToken,
+		Cursors:       cursors,
 	}, nil
 }
 
@@ -394,39 +485,73 @@ func (s *GroupService) UpdateExpense(ctx context.Context, req *groupv1.UpdateExp
 		return nil, errors.New("支払いIDが無効です")
 	}
 	
-	if err := validator.ValidateExpenseAmount(req.Amount); err != nil {
+	kind, err := expenseKindFromProto(req.Kind)
+	if err != nil {
 		return nil, err
 	}
-	
-	if err := validator.ValidateExpenseDescription(req.Description); err != nil {
+
+	// Who was present is only known once the expense date is loaded
+	if req.SplitAmongPresent {
+		if len(req.SplitMemberIds) > 0 {
+			return nil, errSplitAmongPresentGiven
+		}
+	} else if err := validateExpenseEntry(kind, req.Amount, req.Description, req.PaidById, req.SplitMemberIds); err != nil {
 		return nil, err
 	}
-	
-	if err := validator.ValidateUUID(req.PaidById); err != nil {
-		return nil, errors.New("支払い者IDが無効です")
-	}
-	
-	if err := validator.ValidateSplitMemberIds(req.SplitMemberIds); err != nil {
+
+	if err := validator.ValidateExpenseCategory(req.Category); err != nil {
 		return nil, err
 	}
 
+	if req.UpdatedBy != "" {
+		if err := validator.ValidateUUID(req.UpdatedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+	}
+
 	// Parse UUIDs
 	expenseID, err := uuid.Parse(req.ExpenseId)
 	if err != nil {
 		return nil, errors.New("invalid expense ID")
 	}
 
-	paidByID, err := uuid.Parse(req.PaidById)
-	if err != nil {
-		return nil, errors.New("invalid paid by ID")
-	}
-
 	// Get existing expense to validate it exists and get group ID
 	existingExpense, err := s.expenseRepo.FindByID(ctx, expenseID)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.checkExpenseUnlocked(existingExpense); err != nil {
+		return nil, err
+	}
+
+	if req.SplitAmongPresent {
+		periods, err := s.repo.GetMemberActivePeriods(existingExpense.GroupID.String())
+		if err != nil {
+			return nil, err
+		}
+		if req.SplitMemberIds, err = presentMemberIDs(periods, existingExpense.CreatedAt); err != nil {
+			return nil, err
+		}
+		if err := validateExpenseEntry(kind, req.Amount, req.Description, req.PaidById, req.SplitMemberIds); err != nil {
+			return nil, err
+		}
+	}
+
+	var householdShareAmounts []int64
+	if req.SplitByHousehold {
+		households, err := s.repo.GetHouseholds(existingExpense.GroupID.String())
+		if err != nil {
+			return nil, err
+		}
+		householdShareAmounts = householdShares(req.Amount, req.SplitMemberIds, households)
+	}
+
+	paidByID, err := uuid.Parse(req.PaidById)
+	if err != nil {
+		return nil, errors.New("invalid paid by ID")
+	}
+
 	// Get group to validate members
 	group, err := s.repo.GetGroupByID(existingExpense.GroupID.String())
 	if err != nil {
@@ -448,6 +573,15 @@ func (s *GroupService) UpdateExpense(ctx context.Context, req *groupv1.UpdateExp
 		return nil, errors.New("paid by member not found in group")
 	}
 
+	var change domain.ExpenseChange
+	if req.UpdatedBy != "" {
+		if _, found := memberMap[req.UpdatedBy]; !found {
+			return nil, errors.New("updated by member not found in group")
+		}
+		updatedByID := uuid.MustParse(req.UpdatedBy)
+		change.ChangedByID = &updatedByID
+	}
+
 	// Validate split members exist
 	splitMembers := make([]domain.SplitMember, 0, len(req.SplitMemberIds))
 	splitAmount := req.Amount / int64(len(req.SplitMemberIds))
@@ -468,6 +602,9 @@ func (s *GroupService) UpdateExpense(ctx context.Context, req *groupv1.UpdateExp
 		if i < int(remainder) {
 			amount++ // Distribute remainder
 		}
+		if householdShareAmounts != nil {
+			amount = householdShareAmounts[i]
+		}
 
 		splitMembers = append(splitMembers, domain.SplitMember{
 			MemberID:   memberUUID,
@@ -476,47 +613,38 @@ func (s *GroupService) UpdateExpense(ctx context.Context, req *groupv1.UpdateExp
 		})
 	}
 
+	// Edits go back to the split members for approval
+	approvalStatus, err := s.editedExpenseApprovalStatus(existingExpense)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update expense
 	expense := &domain.Expense{
-		ID:           expenseID,
-		GroupID:      existingExpense.GroupID,
-		Amount:       req.Amount,
-		Description:  req.Description,
-		Currency:     existingExpense.Currency,
-		PaidByID:     paidByID,
-		PaidByName:   paidByName,
-		SplitMembers: splitMembers,
-		CreatedAt:    existingExpense.CreatedAt,
-		UpdatedAt:    time.Now(),
+		ID:             expenseID,
+		GroupID:        existingExpense.GroupID,
+		Amount:         req.Amount,
+		Description:    req.Description,
+		Category:       strings.TrimSpace(req.Category),
+		Kind:           kind,
+		Currency:       existingExpense.Currency,
+		PaidByID:       paidByID,
+		PaidByName:     paidByName,
+		SplitMembers:   splitMembers,
+		CreatedAt:      existingExpense.CreatedAt,
+		UpdatedAt:      time.Now(),
+		ApprovalStatus: approvalStatus,
 	}
 
 	// Save updated expense
-	err = s.expenseRepo.Update(ctx, expense)
+	err = s.expenseRepo.Update(ctx, expense, change)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert to proto format
-	protoSplitMembers := make([]*groupv1.SplitMember, len(splitMembers))
-	for i, split := range splitMembers {
-		protoSplitMembers[i] = &groupv1.SplitMember{
-			MemberId:   split.MemberID.String(),
-			MemberName: split.MemberName,
-			Amount:     split.Amount,
-		}
-	}
-
 	return &groupv1.UpdateExpenseResponse{
-		Expense: &groupv1.ExpenseWithDetails{
-			Id:           expense.ID.String(),
-			GroupId:      expense.GroupID.String(),
-			Amount:       expense.Amount,
-			Description:  expense.Description,
-			PaidById:     expense.PaidByID.String(),
-			PaidByName:   expense.PaidByName,
-			SplitMembers: protoSplitMembers,
-			CreatedAt:    timestamppb.New(expense.CreatedAt),
-		},
+		Expense:      toProtoExpense(expense),
+		BudgetAlerts: s.budgetAlerts(ctx, existingExpense, expense),
 	}, nil
 }
 
@@ -525,12 +653,30 @@ func (s *GroupService) DeleteExpense(ctx context.Context, req *groupv1.DeleteExp
 		return nil, errors.New("支払いIDが無効です")
 	}
 
+	var deletedBy *uuid.UUID
+	if req.DeletedBy != "" {
+		if err := validator.ValidateUUID(req.DeletedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+		memberID := uuid.MustParse(req.DeletedBy)
+		deletedBy = &memberID
+	}
+
 	expenseID, err := uuid.Parse(req.ExpenseId)
 	if err != nil {
 		return nil, errors.New("invalid expense ID")
 	}
 
-	err = s.expenseRepo.Delete(ctx, expenseID)
+	expense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkExpenseUnlocked(expense); err != nil {
+		return nil, err
+	}
+
+	err = s.expenseRepo.Delete(ctx, expenseID, deletedBy)
 	if err != nil {
 		return nil, err
 	}
@@ -539,3 +685,32 @@ func (s *GroupService) DeleteExpense(ctx context.Context, req *groupv1.DeleteExp
 		Success: true,
 	}, nil
 }
+
+func toProtoExpense(expense *domain.Expense) *groupv1.ExpenseWithDetails {
+	protoSplitMembers := make([]*groupv1.SplitMember, len(expense.SplitMembers))
+	for i, split := range expense.SplitMembers {
+		protoSplitMembers[i] = &groupv1.SplitMember{
+			MemberId:   split.MemberID.String(),
+			MemberName: split.MemberName,
+			Amount:     split.Amount,
+			Vote:       toProtoApprovalVote(split.Vote),
+		}
+		if split.VotedAt != nil {
+			protoSplitMembers[i].VotedAt = timestamppb.New(*split.VotedAt)
+		}
+	}
+
+	return &groupv1.ExpenseWithDetails{
+		Id:             expense.ID.String(),
+		GroupId:        expense.GroupID.String(),
+		Amount:         expense.Amount,
+		Description:    expense.Description,
+		PaidById:       expense.PaidByID.String(),
+		PaidByName:     expense.PaidByName,
+		SplitMembers:   protoSplitMembers,
+		CreatedAt:      timestamppb.New(expense.CreatedAt),
+		Category:       expense.Category,
+		Kind:           toProtoExpenseKind(expense.Kind),
+		ApprovalStatus: toProtoApprovalStatus(expense.ApprovalStatus),
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// exportDateLayout is how expense dates are written, in the server's time zone
+const exportDateLayout = "2006-01-02"
+
+// OpenGroupExport returns the metadata of a group's ledger export and a
+// reader producing the file while it is read. Deleted expenses are left out
+// and expenses are written oldest first. The caller must close the reader.
+func (s *GroupService) OpenGroupExport(ctx context.Context, req *groupv1.ExportGroupRequest) (*groupv1.ExportMetadata, io.ReadCloser, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, nil, validator.ValidationError{Field: "groupId", Message: "グループIDが無効です"}
+	}
+
+	var write func(io.Writer, *groupv1.Group, []*domain.Expense) error
+	var extension, contentType string
+	switch req.Format {
+	case groupv1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, groupv1.ExportFormat_EXPORT_FORMAT_CSV:
+		extension, contentType = "csv", "text/csv; charset=utf-8"
+		switch req.CsvLayout {
+		case groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_UNSPECIFIED, groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_EXPENSES:
+			write = writeExpensesCsv
+		case groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_SPLITS:
+			write = writeSplitsCsv
+		default:
+			return nil, nil, validator.ValidationError{Field: "csvLayout", Message: "CSVのレイアウトが無効です"}
+		}
+	case groupv1.ExportFormat_EXPORT_FORMAT_JSON:
+		extension, contentType = "json", "application/json"
+		write = writeLedgerJSON
+	default:
+		return nil, nil, validator.ValidationError{Field: "format", Message: "出力形式が無効です"}
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Listed newest first
+	slices.Reverse(expenses)
+
+	metadata := &groupv1.ExportMetadata{
+		FileName:    fmt.Sprintf("%s_%s.%s", group.Name, time.Now().Format("20060102"), extension),
+		ContentType: contentType,
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		buffered := bufio.NewWriter(writer)
+		err := write(buffered, group, expenses)
+		if err == nil {
+			err = buffered.Flush()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return metadata, reader, nil
+}
+
+// writeExpensesCsv writes one row per expense followed, after a blank line,
+// by the balance of each member. The byte order mark lets Excel read the file
+// as UTF-8.
+func writeExpensesCsv(w io.Writer, group *groupv1.Group, expenses []*domain.Expense) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	out.Write([]string{"date", "description", "category", "kind", "amount", "currency", "paid_by", "participants"})
+	for _, expense := range expenses {
+		participants := make([]string, len(expense.SplitMembers))
+		for i, split := range expense.SplitMembers {
+			participants[i] = split.MemberName
+		}
+		out.Write([]string{
+			expense.CreatedAt.Local().Format(exportDateLayout),
+			expense.Description,
+			expense.Category,
+			string(expense.Kind),
+			strconv.FormatInt(expense.Amount, 10),
+			expense.Currency,
+			expense.PaidByName,
+			strings.Join(participants, defaultParticipantSeparator),
+		})
+	}
+
+	writeBalancesCsv(out, group, memberBalances(group, expenses))
+	out.Flush()
+	return out.Error()
+}
+
+// writeSplitsCsv writes one row per member share of each expense followed,
+// after a blank line, by the balance of each member
+func writeSplitsCsv(w io.Writer, group *groupv1.Group, expenses []*domain.Expense) error {
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+
+	out := csv.NewWriter(w)
+	out.Write([]string{"date", "description", "category", "kind", "amount", "currency", "paid_by", "member", "share"})
+	for _, expense := range expenses {
+		for _, split := range expense.SplitMembers {
+			out.Write([]string{
+				expense.CreatedAt.Local().Format(exportDateLayout),
+				expense.Description,
+				expense.Category,
+				string(expense.Kind),
+				strconv.FormatInt(expense.Amount, 10),
+				expense.Currency,
+				expense.PaidByName,
+				split.MemberName,
+				strconv.FormatInt(split.Amount, 10),
+			})
+		}
+	}
+
+	writeBalancesCsv(out, group, memberBalances(group, expenses))
+	out.Flush()
+	return out.Error()
+}
+
+func writeBalancesCsv(out *csv.Writer, group *groupv1.Group, balances []algorithm.Balance) {
+	out.Write([]string{})
+	out.Write([]string{"member", "balance", "currency"})
+	for _, balance := range balances {
+		out.Write([]string{balance.Name, strconv.FormatInt(balance.Amount, 10), group.Currency})
+	}
+}
+
+type ledgerMember struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Balance int64  `json:"balance"` // Positive when the member is owed money
+}
+
+type ledgerSplit struct {
+	MemberID   string `json:"member_id"`
+	MemberName string `json:"member_name"`
+	Amount     int64  `json:"amount"`
+}
+
+type ledgerExpense struct {
+	ID          string        `json:"id"`
+	Date        string        `json:"date"`
+	Description string        `json:"description"`
+	Category    string        `json:"category,omitempty"`
+	Kind        string        `json:"kind"`
+	Amount      int64         `json:"amount"`
+	PaidByID    string        `json:"paid_by_id"`
+	PaidByName  string        `json:"paid_by_name"`
+	Splits      []ledgerSplit `json:"splits"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+// writeLedgerJSON writes the group with its members and their balances, then
+// the expenses one at a time so large ledgers are not held in memory twice
+func writeLedgerJSON(w io.Writer, group *groupv1.Group, expenses []*domain.Expense) error {
+	balances := memberBalances(group, expenses)
+	members := make([]ledgerMember, len(balances))
+	for i, balance := range balances {
+		members[i] = ledgerMember{ID: balance.MemberID, Name: balance.Name, Balance: balance.Amount}
+	}
+
+	header, err := json.Marshal(struct {
+		ID          string         `json:"id"`
+		Name        string         `json:"name"`
+		Description string         `json:"description,omitempty"`
+		Currency    string         `json:"currency"`
+		Members     []ledgerMember `json:"members"`
+	}{group.Id, group.Name, group.Description, group.Currency, members})
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, `{"group":%s,"expenses":[`, header); err != nil {
+		return err
+	}
+
+	for i, expense := range expenses {
+		splits := make([]ledgerSplit, len(expense.SplitMembers))
+		for j, split := range expense.SplitMembers {
+			splits[j] = ledgerSplit{MemberID: split.MemberID.String(), MemberName: split.MemberName, Amount: split.Amount}
+		}
+
+		entry, err := json.Marshal(ledgerExpense{
+			ID:          expense.ID.String(),
+			Date:        expense.CreatedAt.Local().Format(exportDateLayout),
+			Description: expense.Description,
+			Category:    expense.Category,
+			Kind:        string(expense.Kind),
+			Amount:      expense.Amount,
+			PaidByID:    expense.PaidByID.String(),
+			PaidByName:  expense.PaidByName,
+			Splits:      splits,
+			CreatedAt:   expense.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(entry); err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
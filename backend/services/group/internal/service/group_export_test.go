@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_OpenGroupExport(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Name:     "旅行",
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+
+	// Listed newest first, as the repository returns them
+	expenses := []*domain.Expense{
+		{
+			ID:           uuid.New(),
+			Amount:       300,
+			Description:  "返済",
+			Kind:         domain.ExpenseKindPayment,
+			Currency:     "JPY",
+			PaidByID:     bobID,
+			PaidByName:   "Bob",
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 300}},
+			CreatedAt:    time.Date(2024, 5, 2, 12, 0, 0, 0, time.Local),
+		},
+		{
+			ID:          uuid.New(),
+			Amount:      1001,
+			Description: "夕食, 居酒屋",
+			Category:    "食費",
+			Kind:        domain.ExpenseKindExpense,
+			Currency:    "JPY",
+			PaidByID:    aliceID,
+			PaidByName:  "Alice",
+			// Equal splits store the rounded-down share
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, MemberName: "Alice", Amount: 500},
+				{MemberID: bobID, MemberName: "Bob", Amount: 500},
+			},
+			CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local),
+		},
+	}
+
+	export := func(t *testing.T, req *groupv1.ExportGroupRequest) (*groupv1.ExportMetadata, string) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(append([]*domain.Expense(nil), expenses...), nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		metadata, content, err := service.OpenGroupExport(context.Background(), req)
+		require.NoError(t, err)
+		defer content.Close()
+
+		body, err := io.ReadAll(content)
+		require.NoError(t, err)
+		return metadata, string(body)
+	}
+
+	t.Run("CSV with one row per expense", func(t *testing.T) {
+		metadata, body := export(t, &groupv1.ExportGroupRequest{GroupId: groupID.String()})
+
+		assert.Equal(t, "text/csv; charset=utf-8", metadata.ContentType)
+		assert.True(t, strings.HasPrefix(metadata.FileName, "旅行_"))
+		assert.True(t, strings.HasSuffix(metadata.FileName, ".csv"))
+		assert.Equal(t, "\ufeff"+
+			"date,description,category,kind,amount,currency,paid_by,participants\n"+
+			"2024-05-01,\"夕食, 居酒屋\",食費,expense,1001,JPY,Alice,Alice;Bob\n"+
+			"2024-05-02,返済,,payment,300,JPY,Bob,Alice\n"+
+			"\n"+
+			"member,balance,currency\n"+
+			"Alice,200,JPY\n"+
+			"Bob,-200,JPY\n", body)
+	})
+
+	t.Run("CSV with one row per split", func(t *testing.T) {
+		_, body := export(t, &groupv1.ExportGroupRequest{
+			GroupId:   groupID.String(),
+			Format:    groupv1.ExportFormat_EXPORT_FORMAT_CSV,
+			CsvLayout: groupv1.ExportCsvLayout_EXPORT_CSV_LAYOUT_SPLITS,
+		})
+
+		lines := strings.Split(strings.TrimSuffix(body, "\n"), "\n")
+		require.Len(t, lines, 8)
+		assert.Equal(t, "\ufeffdate,description,category,kind,amount,currency,paid_by,member,share", lines[0])
+		assert.Equal(t, "2024-05-01,\"夕食, 居酒屋\",食費,expense,1001,JPY,Alice,Bob,500", lines[2])
+		assert.Equal(t, "2024-05-02,返済,,payment,300,JPY,Bob,Alice,300", lines[3])
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		metadata, body := export(t, &groupv1.ExportGroupRequest{
+			GroupId: groupID.String(),
+			Format:  groupv1.ExportFormat_EXPORT_FORMAT_JSON,
+		})
+
+		assert.Equal(t, "application/json", metadata.ContentType)
+
+		var ledger struct {
+			Group struct {
+				Currency string         `json:"currency"`
+				Members  []ledgerMember `json:"members"`
+			} `json:"group"`
+			Expenses []ledgerExpense `json:"expenses"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(body), &ledger))
+		assert.Equal(t, "JPY", ledger.Group.Currency)
+		assert.Equal(t, []ledgerMember{
+			{ID: aliceID.String(), Name: "Alice", Balance: 200},
+			{ID: bobID.String(), Name: "Bob", Balance: -200},
+		}, ledger.Group.Members)
+		require.Len(t, ledger.Expenses, 2)
+		assert.Equal(t, "夕食, 居酒屋", ledger.Expenses[0].Description)
+		assert.Equal(t, "2024-05-01", ledger.Expenses[0].Date)
+		assert.Len(t, ledger.Expenses[0].Splits, 2)
+		assert.Equal(t, "payment", ledger.Expenses[1].Kind)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, _, err := service.OpenGroupExport(context.Background(), &groupv1.ExportGroupRequest{
+			GroupId: groupID.String(),
+			Format:  groupv1.ExportFormat(99),
+		})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "出力形式が無効です")
+	})
+
+	t.Run("invalid group ID", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, _, err := service.OpenGroupExport(context.Background(), &groupv1.ExportGroupRequest{GroupId: "invalid"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "グループIDが無効です")
+	})
+}
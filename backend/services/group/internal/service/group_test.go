@@ -12,6 +12,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
 )
 
 // MockGroupRepository is a mock implementation of the GroupRepository
@@ -56,11 +57,146 @@ func (m *MockGroupRepository) AddMember(groupID, memberName, memberEmail string)
 	return args.Get(0).(*groupv1.Member), args.Error(1)
 }
 
-func (m *MockGroupRepository) RemoveMember(groupID, memberID string) error {
+func (m *MockGroupRepository) RemoveMember(removal *domain.MemberRemoval) error {
+	args := m.Called(removal)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetAccountMappings(groupID string) ([]*groupv1.AccountMapping, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*groupv1.AccountMapping), args.Error(1)
+}
+
+func (m *MockGroupRepository) ReplaceAccountMappings(groupID string, mappings []*groupv1.AccountMapping) error {
+	args := m.Called(groupID, mappings)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) SaveBankAccount(groupID string, account *domain.SealedBankAccount) error {
+	args := m.Called(groupID, account)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetBankAccounts(groupID string) ([]*domain.SealedBankAccount, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SealedBankAccount), args.Error(1)
+}
+
+func (m *MockGroupRepository) DeleteBankAccount(groupID, memberID string) error {
 	args := m.Called(groupID, memberID)
 	return args.Error(0)
 }
 
+func (m *MockGroupRepository) GetBudgets(groupID string) ([]*groupv1.Budget, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*groupv1.Budget), args.Error(1)
+}
+
+func (m *MockGroupRepository) ReplaceBudgets(groupID string, budgets []*groupv1.Budget) error {
+	args := m.Called(groupID, budgets)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetGroupSettings(groupID string) (*groupv1.GroupSettings, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GroupSettings), args.Error(1)
+}
+
+func (m *MockGroupRepository) SaveGroupSettings(groupID string, settings *groupv1.GroupSettings) error {
+	args := m.Called(groupID, settings)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) CreateClosedPeriod(period *domain.ClosedPeriod) error {
+	args := m.Called(period)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetClosedPeriods(groupID string) ([]*domain.ClosedPeriod, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ClosedPeriod), args.Error(1)
+}
+
+func (m *MockGroupRepository) GetClosedPeriod(periodID string) (*domain.ClosedPeriod, error) {
+	args := m.Called(periodID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ClosedPeriod), args.Error(1)
+}
+
+func (m *MockGroupRepository) ReopenClosedPeriod(periodID, reopenedBy string) error {
+	args := m.Called(periodID, reopenedBy)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetMemberActivePeriods(groupID string) ([]*domain.MemberActivePeriod, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberActivePeriod), args.Error(1)
+}
+
+func (m *MockGroupRepository) SetMemberActivePeriod(groupID string, period *domain.MemberActivePeriod) error {
+	args := m.Called(groupID, period)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) MergeMembers(merge *domain.MemberMerge, combine func(expense *domain.Expense) (domain.CombinedSplit, bool)) error {
+	args := m.Called(merge, combine)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetMemberMerges(groupID string) ([]*domain.MemberMerge, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberMerge), args.Error(1)
+}
+
+func (m *MockGroupRepository) GetMemberProfiles(groupID string) ([]*domain.MemberProfile, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberProfile), args.Error(1)
+}
+
+func (m *MockGroupRepository) UpdateMember(groupID string, profile *domain.MemberProfile) error {
+	args := m.Called(groupID, profile)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepository) GetHouseholds(groupID string) ([]*domain.Household, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Household), args.Error(1)
+}
+
+func (m *MockGroupRepository) ReplaceHouseholds(groupID string, households []*domain.Household) error {
+	args := m.Called(groupID, households)
+	return args.Error(0)
+}
+
 func TestGroupService_CreateGroup_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockGroupRepository)
@@ -120,7 +256,7 @@ func TestGroupService_CreateGroup_EmptyName(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "group name is required")
+	assert.Contains(t, err.Error(), "グループ名は必須です")
 
 	// Repository should not be called
 	mockRepo.AssertNotCalled(t, "CreateGroup")
@@ -178,6 +314,8 @@ func TestGroupService_GetGroup_Success(t *testing.T) {
 	}
 
 	mockRepo.On("GetGroupByID", groupID).Return(expectedGroup, nil)
+	mockRepo.On("GetMemberActivePeriods", groupID).Return(nil, nil)
+	mockRepo.On("GetMemberProfiles", groupID).Return(nil, nil)
 
 	// Act
 	resp, err := service.GetGroup(context.Background(), req)
@@ -203,7 +341,7 @@ func TestGroupService_GetGroup_EmptyID(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "group ID is required")
+	assert.Contains(t, err.Error(), "グループIDが無効です")
 
 	mockRepo.AssertNotCalled(t, "GetGroupByID")
 }
@@ -334,7 +472,7 @@ func TestGroupService_AddMember_EmptyName(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "member name is required")
+	assert.Contains(t, err.Error(), "メンバー名は必須です")
 
 	mockRepo.AssertNotCalled(t, "AddMember")
 }
@@ -342,14 +480,22 @@ func TestGroupService_AddMember_EmptyName(t *testing.T) {
 func TestGroupService_RemoveMember_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(MockGroupRepository)
-	service := NewGroupService(mockRepo, nil) // ExpenseRepo not needed for these tests
+	mockExpenseRepo := new(MockExpenseRepository)
+	service := NewGroupService(mockRepo, mockExpenseRepo)
 
+	groupID := uuid.New()
 	req := &groupv1.RemoveMemberRequest{
-		GroupId:  uuid.New().String(),
+		GroupId:  groupID.String(),
 		MemberId: uuid.New().String(),
 	}
 
-	mockRepo.On("RemoveMember", req.GroupId, req.MemberId).Return(nil)
+	// A member without expenses has nothing to settle
+	mockRepo.On("GetGroupByID", req.GroupId).Return(&groupv1.Group{
+		Id:      req.GroupId,
+		Members: []*groupv1.Member{{Id: req.MemberId, Name: "Alice"}},
+	}, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{}, nil)
+	mockRepo.On("RemoveMember", &domain.MemberRemoval{GroupID: req.GroupId, MemberID: req.MemberId}).Return(nil)
 
 	// Act
 	resp, err := service.RemoveMember(context.Background(), req)
@@ -372,7 +518,10 @@ func TestGroupService_DeleteExpense_Success(t *testing.T) {
 		ExpenseId: expenseID.String(),
 	}
 
-	mockExpenseRepo.On("Delete", mock.Anything, expenseID).Return(nil)
+	groupID := uuid.New()
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, GroupID: groupID}, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+	mockExpenseRepo.On("Delete", mock.Anything, expenseID, (*uuid.UUID)(nil)).Return(nil)
 
 	// Act
 	resp, err := service.DeleteExpense(context.Background(), req)
@@ -400,7 +549,7 @@ func TestGroupService_DeleteExpense_EmptyExpenseID(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "expense ID is required")
+	assert.Contains(t, err.Error(), "支払いIDが無効です")
 
 	// Repository should not be called
 	mockExpenseRepo.AssertNotCalled(t, "Delete")
@@ -422,7 +571,7 @@ func TestGroupService_DeleteExpense_InvalidExpenseID(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.Contains(t, err.Error(), "invalid expense ID")
+	assert.Contains(t, err.Error(), "支払いIDが無効です")
 
 	// Repository should not be called
 	mockExpenseRepo.AssertNotCalled(t, "Delete")
@@ -439,7 +588,10 @@ func TestGroupService_DeleteExpense_RepositoryError(t *testing.T) {
 		ExpenseId: expenseID.String(),
 	}
 
-	mockExpenseRepo.On("Delete", mock.Anything, expenseID).Return(errors.New("database error"))
+	groupID := uuid.New()
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, GroupID: groupID}, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+	mockExpenseRepo.On("Delete", mock.Anything, expenseID, (*uuid.UUID)(nil)).Return(errors.New("database error"))
 
 	// Act
 	resp, err := service.DeleteExpense(context.Background(), req)
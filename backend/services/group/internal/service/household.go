@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+func (s *GroupService) GetHouseholds(ctx context.Context, req *groupv1.GetHouseholdsRequest) (*groupv1.GetHouseholdsResponse, error) {
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	households, err := s.repo.GetHouseholds(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetHouseholdsResponse{Households: toProtoHouseholds(households)}, nil
+}
+
+// UpdateHouseholds replaces the households of a group. Expenses already
+// split by household keep their split.
+func (s *GroupService) UpdateHouseholds(ctx context.Context, req *groupv1.UpdateHouseholdsRequest) (*groupv1.UpdateHouseholdsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	for _, household := range req.Households {
+		if err := validator.ValidateHouseholdName(household.Name); err != nil {
+			return nil, err
+		}
+		if household.Id != "" {
+			if err := validator.ValidateUUID(household.Id); err != nil {
+				return nil, errors.New("世帯IDが無効です")
+			}
+		}
+		if len(household.MemberIds) == 0 {
+			return nil, validator.ValidationError{Field: "memberIds", Message: "世帯にはメンバーを1人以上指定してください"}
+		}
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	households, err := newHouseholds(group, req.Households)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceHouseholds(req.GroupId, households); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateHouseholdsResponse{Households: toProtoHouseholds(households)}, nil
+}
+
+// newHouseholds checks the requested households against the group, puts
+// their members in the order they joined and sorts them by name
+func newHouseholds(group *groupv1.Group, requested []*groupv1.Household) ([]*domain.Household, error) {
+	householdOf := make(map[string]*domain.Household)
+	households := make([]*domain.Household, len(requested))
+	seenIDs := make(map[string]bool, len(requested))
+	seenNames := make(map[string]bool, len(requested))
+	for i, household := range requested {
+		name := strings.TrimSpace(household.Name)
+		if seenNames[strings.ToLower(name)] {
+			return nil, validator.ValidationError{Field: "name", Message: fmt.Sprintf("世帯「%s」が重複しています", name)}
+		}
+		seenNames[strings.ToLower(name)] = true
+
+		id := household.Id
+		if id == "" {
+			id = uuid.New().String()
+		}
+		if seenIDs[id] {
+			return nil, validator.ValidationError{Field: "id", Message: "世帯IDが重複しています"}
+		}
+		seenIDs[id] = true
+
+		households[i] = &domain.Household{ID: id, GroupID: group.Id, Name: name}
+		for _, memberID := range household.MemberIds {
+			if findMember(group, memberID) == nil {
+				return nil, errors.New("世帯のメンバーがグループに存在しません")
+			}
+			if householdOf[memberID] != nil {
+				return nil, validator.ValidationError{Field: "memberIds", Message: "メンバーが複数の世帯に含まれています"}
+			}
+			householdOf[memberID] = households[i]
+		}
+	}
+
+	for _, member := range group.Members {
+		if household := householdOf[member.Id]; household != nil {
+			household.MemberIDs = append(household.MemberIDs, member.Id)
+		}
+	}
+	slices.SortFunc(households, func(a, b *domain.Household) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return households, nil
+}
+
+// householdShares splits an amount equally per household of the given
+// members, then each household's part equally among its members. Members
+// outside any household count as a household of their own. Remainders go
+// to the households and members listed first, as with equal splits.
+func householdShares(amount int64, memberIDs []string, households []*domain.Household) []int64 {
+	householdOf := make(map[string]string)
+	for _, household := range households {
+		for _, memberID := range household.MemberIDs {
+			householdOf[memberID] = household.ID
+		}
+	}
+
+	// Units in the order their first member is listed
+	var units []string
+	unitMembers := make(map[string][]int)
+	for i, memberID := range memberIDs {
+		unit, ok := householdOf[memberID]
+		if !ok {
+			unit = memberID
+		}
+		if _, seen := unitMembers[unit]; !seen {
+			units = append(units, unit)
+		}
+		unitMembers[unit] = append(unitMembers[unit], i)
+	}
+
+	shares := make([]int64, len(memberIDs))
+	unitShares := algorithm.Expense{Amount: amount, SplitBetween: units}.Shares()
+	for u, unit := range units {
+		indexes := unitMembers[unit]
+		memberShares := algorithm.Expense{Amount: unitShares[u], SplitBetween: make([]string, len(indexes))}.Shares()
+		for j, i := range indexes {
+			shares[i] = memberShares[j]
+		}
+	}
+	return shares
+}
+
+// splitByHousehold fills in the shares of a new expense split by household
+func splitByHousehold(req *groupv1.AddExpenseRequest, households []*domain.Household) error {
+	if len(req.SplitAmounts) > 0 {
+		return validator.ValidationError{Field: "splitAmounts", Message: "世帯ごとに割る場合は分担額を指定できません"}
+	}
+
+	// Missing members and amounts are reported by the usual validation
+	if len(req.SplitMemberIds) > 0 && req.Amount > 0 {
+		req.SplitAmounts = householdShares(req.Amount, req.SplitMemberIds, households)
+	}
+	return nil
+}
+
+// householdUnit is a household, or a member outside any household,
+// settling up as one
+type householdUnit struct {
+	householdID string // Empty for a member outside any household
+	name        string
+	memberIDs   []string
+	balance     int64
+}
+
+// householdUnits adds up member balances per household, in the order the
+// first member of each unit joined
+func householdUnits(group *groupv1.Group, households []*domain.Household, balances []algorithm.Balance) []*householdUnit {
+	householdOf := make(map[string]*domain.Household)
+	for _, household := range households {
+		for _, memberID := range household.MemberIDs {
+			householdOf[memberID] = household
+		}
+	}
+
+	memberBalance := make(map[string]int64, len(balances))
+	for _, balance := range balances {
+		memberBalance[balance.MemberID] = balance.Amount
+	}
+
+	var units []*householdUnit
+	unitOf := make(map[string]*householdUnit)
+	for _, member := range group.Members {
+		var unit *householdUnit
+		if household := householdOf[member.Id]; household != nil {
+			unit = unitOf[household.ID]
+			if unit == nil {
+				unit = &householdUnit{householdID: household.ID, name: household.Name}
+				unitOf[household.ID] = unit
+				units = append(units, unit)
+			}
+		} else {
+			unit = &householdUnit{name: member.Name}
+			units = append(units, unit)
+		}
+		unit.memberIDs = append(unit.memberIDs, member.Id)
+		unit.balance += memberBalance[member.Id]
+	}
+	return units
+}
+
+// unitBalances are the balances to settle up between household units. Each
+// unit pays and receives through its first member, so the settlements can
+// be recorded as payments between members.
+func unitBalances(units []*householdUnit) []algorithm.Balance {
+	balances := make([]algorithm.Balance, len(units))
+	for i, unit := range units {
+		balances[i] = algorithm.Balance{MemberID: unit.memberIDs[0], Name: unit.name, Amount: unit.balance}
+	}
+	return balances
+}
+
+// setSettlementHouseholds fills in the households settling up with each other
+func setSettlementHouseholds(settlements []*groupv1.Settlement, units []*householdUnit) {
+	householdOf := make(map[string]string, len(units))
+	for _, unit := range units {
+		householdOf[unit.memberIDs[0]] = unit.householdID
+	}
+	for _, settlement := range settlements {
+		settlement.FromHouseholdId = householdOf[settlement.FromMemberId]
+		settlement.ToHouseholdId = householdOf[settlement.ToMemberId]
+	}
+}
+
+func toProtoHouseholdBalances(units []*householdUnit) []*groupv1.HouseholdBalance {
+	protoBalances := make([]*groupv1.HouseholdBalance, len(units))
+	for i, unit := range units {
+		protoBalances[i] = &groupv1.HouseholdBalance{
+			HouseholdId: unit.householdID,
+			Name:        unit.name,
+			MemberIds:   unit.memberIDs,
+			Balance:     unit.balance,
+		}
+	}
+	return protoBalances
+}
+
+func toProtoHouseholds(households []*domain.Household) []*groupv1.Household {
+	protoHouseholds := make([]*groupv1.Household, len(households))
+	for i, household := range households {
+		protoHouseholds[i] = &groupv1.Household{
+			Id:        household.ID,
+			Name:      household.Name,
+			MemberIds: household.MemberIDs,
+		}
+	}
+	return protoHouseholds
+}
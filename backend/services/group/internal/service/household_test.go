@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestHouseholdShares(t *testing.T) {
+	households := []*domain.Household{
+		{ID: "tanaka", MemberIDs: []string{"alice", "bob"}},
+		{ID: "suzuki", MemberIDs: []string{"dave", "erin"}},
+	}
+
+	// Three units: the Tanakas, Carol and Dave; Erin is not part of the split
+	shares := householdShares(1000, []string{"alice", "carol", "bob", "dave"}, households)
+
+	assert.Equal(t, []int64{167, 333, 167, 333}, shares)
+}
+
+func TestGroupService_UpdateHouseholds(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	carolID := uuid.New().String()
+
+	newService := func() (*GroupService, *MockGroupRepositoryInterface) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+			Id: groupID,
+			Members: []*groupv1.Member{
+				{Id: aliceID, Name: "Alice"},
+				{Id: bobID, Name: "Bob"},
+				{Id: carolID, Name: "Carol"},
+			},
+		}, nil)
+		return NewGroupService(mockGroupRepo, nil), mockGroupRepo
+	}
+
+	t.Run("households replaced", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+		var saved []*domain.Household
+		mockGroupRepo.On("ReplaceHouseholds", groupID, mock.Anything).
+			Run(func(args mock.Arguments) { saved = args.Get(1).([]*domain.Household) }).
+			Return(nil)
+
+		resp, err := service.UpdateHouseholds(context.Background(), &groupv1.UpdateHouseholdsRequest{
+			GroupId: groupID,
+			Households: []*groupv1.Household{
+				{Name: " 田中家 ", MemberIds: []string{bobID, aliceID}},
+				{Name: "Carol", MemberIds: []string{carolID}},
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, saved, 2)
+		assert.Equal(t, "Carol", saved[0].Name)
+		assert.Equal(t, "田中家", saved[1].Name)
+		assert.Equal(t, []string{aliceID, bobID}, saved[1].MemberIDs)
+		assert.NotEmpty(t, saved[1].ID)
+		require.Len(t, resp.Households, 2)
+		assert.Equal(t, saved[1].ID, resp.Households[1].Id)
+	})
+
+	t.Run("invalid households", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			households []*groupv1.Household
+			wantErr    string
+		}{
+			{
+				name:       "no name",
+				households: []*groupv1.Household{{Name: "", MemberIds: []string{aliceID}}},
+				wantErr:    "name: 世帯名は必須です",
+			},
+			{
+				name:       "no members",
+				households: []*groupv1.Household{{Name: "田中家"}},
+				wantErr:    "memberIds: 世帯にはメンバーを1人以上指定してください",
+			},
+			{
+				name: "member in two households",
+				households: []*groupv1.Household{
+					{Name: "田中家", MemberIds: []string{aliceID, bobID}},
+					{Name: "鈴木家", MemberIds: []string{bobID, carolID}},
+				},
+				wantErr: "memberIds: メンバーが複数の世帯に含まれています",
+			},
+			{
+				name: "same name",
+				households: []*groupv1.Household{
+					{Name: "田中家", MemberIds: []string{aliceID}},
+					{Name: "田中家", MemberIds: []string{bobID}},
+				},
+				wantErr: "name: 世帯「田中家」が重複しています",
+			},
+			{
+				name:       "member not in group",
+				households: []*groupv1.Household{{Name: "田中家", MemberIds: []string{uuid.New().String()}}},
+				wantErr:    "世帯のメンバーがグループに存在しません",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service, mockGroupRepo := newService()
+
+				_, err := service.UpdateHouseholds(context.Background(), &groupv1.UpdateHouseholdsRequest{
+					GroupId:    groupID,
+					Households: tt.households,
+				})
+
+				assert.EqualError(t, err, tt.wantErr)
+				mockGroupRepo.AssertNotCalled(t, "ReplaceHouseholds", mock.Anything, mock.Anything)
+			})
+		}
+	})
+}
+
+func TestGroupService_SplitByHousehold(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	carolID := uuid.New().String()
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+			{Id: carolID, Name: "Carol"},
+		},
+	}
+	households := []*domain.Household{
+		{ID: uuid.New().String(), Name: "田中家", MemberIDs: []string{aliceID, bobID}},
+	}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockGroupRepo.On("GetHouseholds", groupID.String()).Return(households, nil)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+	mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+	mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(nil, nil)
+	mockExpenseRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Expense")).Return(nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+		GroupId:          groupID.String(),
+		Amount:           3000,
+		Description:      "Cabin",
+		PaidById:         carolID,
+		SplitMemberIds:   []string{aliceID, bobID, carolID},
+		SplitByHousehold: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Expense.SplitMembers, 3)
+	assert.Equal(t, int64(750), resp.Expense.SplitMembers[0].Amount)
+	assert.Equal(t, int64(750), resp.Expense.SplitMembers[1].Amount)
+	assert.Equal(t, int64(1500), resp.Expense.SplitMembers[2].Amount)
+}
+
+func TestGroupService_CalculateSettlements_ByHousehold(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	carolID := uuid.New().String()
+	daveID := uuid.New().String()
+	erinID := uuid.New().String()
+	tanaka := &domain.Household{ID: uuid.New().String(), Name: "田中家", MemberIDs: []string{aliceID, bobID}}
+	suzuki := &domain.Household{ID: uuid.New().String(), Name: "鈴木家", MemberIDs: []string{daveID, erinID}}
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockGroupRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{
+		Id: groupID.String(),
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+			{Id: carolID, Name: "Carol"},
+			{Id: daveID, Name: "Dave"},
+			{Id: erinID, Name: "Erin"},
+		},
+	}, nil)
+	mockGroupRepo.On("GetHouseholds", groupID.String()).Return([]*domain.Household{suzuki, tanaka}, nil)
+	mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(nil, nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	// Alice paid 4000 for four: the Tanakas +2000, Carol -1000, the Suzukis -1000
+	resp, err := service.CalculateSettlements(context.Background(), &groupv1.CalculateSettlementsRequest{
+		GroupId: groupID.String(),
+		Expenses: []*groupv1.Expense{
+			{Id: "exp1", PayerId: aliceID, Amount: 4000, SplitBetween: []string{aliceID, bobID, carolID, daveID}},
+		},
+		ByHousehold: true,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Balances, 5)
+	require.Len(t, resp.HouseholdBalances, 3)
+	assert.Equal(t, tanaka.ID, resp.HouseholdBalances[0].HouseholdId)
+	assert.Equal(t, int64(2000), resp.HouseholdBalances[0].Balance)
+	assert.Equal(t, "", resp.HouseholdBalances[1].HouseholdId)
+	assert.Equal(t, []string{carolID}, resp.HouseholdBalances[1].MemberIds)
+	assert.Equal(t, int64(-1000), resp.HouseholdBalances[2].Balance)
+
+	require.Len(t, resp.Settlements, 2)
+	settlements := make(map[string]*groupv1.Settlement)
+	for _, settlement := range resp.Settlements {
+		settlements[settlement.FromName] = settlement
+	}
+	require.Contains(t, settlements, "鈴木家")
+	assert.Equal(t, daveID, settlements["鈴木家"].FromMemberId)
+	assert.Equal(t, suzuki.ID, settlements["鈴木家"].FromHouseholdId)
+	assert.Equal(t, aliceID, settlements["鈴木家"].ToMemberId)
+	assert.Equal(t, tanaka.ID, settlements["鈴木家"].ToHouseholdId)
+	assert.Equal(t, "田中家", settlements["鈴木家"].ToName)
+	assert.Equal(t, int64(1000), settlements["鈴木家"].Amount)
+	require.Contains(t, settlements, "Carol")
+	assert.Equal(t, "", settlements["Carol"].FromHouseholdId)
+	assert.Equal(t, int64(1000), settlements["Carol"].Amount)
+}
@@ -1,6 +1,9 @@
 package service
 
-import groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+import (
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
 
 // GroupRepositoryInterface defines the interface for group repository operations
 type GroupRepositoryInterface interface {
@@ -8,8 +11,29 @@ type GroupRepositoryInterface interface {
 	GetGroupByID(groupID string) (*groupv1.Group, error)
 	UpdateGroup(groupID, name, description, currency string) (*groupv1.Group, error)
 	DeleteGroup(groupID string) error
-	AddMember(groupID, memberName string) (*groupv1.Member, error)
-	RemoveMember(groupID, memberID string) error
+	AddMember(groupID, memberName, memberEmail string) (*groupv1.Member, error)
+	RemoveMember(removal *domain.MemberRemoval) error
+	GetAccountMappings(groupID string) ([]*groupv1.AccountMapping, error)
+	ReplaceAccountMappings(groupID string, mappings []*groupv1.AccountMapping) error
+	SaveBankAccount(groupID string, account *domain.SealedBankAccount) error
+	GetBankAccounts(groupID string) ([]*domain.SealedBankAccount, error)
+	DeleteBankAccount(groupID, memberID string) error
+	GetBudgets(groupID string) ([]*groupv1.Budget, error)
+	ReplaceBudgets(groupID string, budgets []*groupv1.Budget) error
+	GetGroupSettings(groupID string) (*groupv1.GroupSettings, error)
+	SaveGroupSettings(groupID string, settings *groupv1.GroupSettings) error
+	CreateClosedPeriod(period *domain.ClosedPeriod) error
+	GetClosedPeriods(groupID string) ([]*domain.ClosedPeriod, error)
+	GetClosedPeriod(periodID string) (*domain.ClosedPeriod, error)
+	ReopenClosedPeriod(periodID, reopenedBy string) error
+	GetMemberActivePeriods(groupID string) ([]*domain.MemberActivePeriod, error)
+	SetMemberActivePeriod(groupID string, period *domain.MemberActivePeriod) error
+	MergeMembers(merge *domain.MemberMerge, combine func(expense *domain.Expense) (domain.CombinedSplit, bool)) error
+	GetMemberMerges(groupID string) ([]*domain.MemberMerge, error)
+	GetMemberProfiles(groupID string) ([]*domain.MemberProfile, error)
+	UpdateMember(groupID string, profile *domain.MemberProfile) error
+	GetHouseholds(groupID string) ([]*domain.Household, error)
+	ReplaceHouseholds(groupID string, households []*domain.Household) error
 }
 
 // GroupServiceInterface defines the interface for group service operations
@@ -18,7 +42,7 @@ type GroupServiceInterface interface {
 	GetGroup(groupID string) (*groupv1.Group, error)
 	UpdateGroup(groupID, name, description, currency string) (*groupv1.Group, error)
 	DeleteGroup(groupID string) error
-	AddMember(groupID, memberName string) (*groupv1.Member, error)
+	AddMember(groupID, memberName, memberEmail string) (*groupv1.Member, error)
 	RemoveMember(groupID, memberID string) error
 	CalculateSettlements(groupID string, expenses []*groupv1.Expense) ([]*groupv1.Settlement, []*groupv1.MemberBalance, error)
 }
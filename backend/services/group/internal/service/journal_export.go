@@ -0,0 +1,291 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// Accounts used when a category has no mapping, and the account holding what
+// the group owes each member, who is recorded as its sub-account or partner
+const (
+	defaultExpenseAccount = "雑費"
+	defaultIncomeAccount  = "雑収入"
+	payableAccount        = "未払金"
+)
+
+// journalDateLayout is how both freee and MoneyForward expect dates
+const journalDateLayout = "2006/01/02"
+
+// Expense shares are not booked, so the entries carry no consumption tax
+const journalTaxClass = "対象外"
+
+// GetAccountMappings returns the group's category to account mappings,
+// ordered by category
+func (s *GroupService) GetAccountMappings(ctx context.Context, req *groupv1.GetAccountMappingsRequest) (*groupv1.GetAccountMappingsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	mappings, err := s.repo.GetAccountMappings(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.GetAccountMappingsResponse{Mappings: mappings}, nil
+}
+
+// UpdateAccountMappings replaces the group's category to account mappings
+func (s *GroupService) UpdateAccountMappings(ctx context.Context, req *groupv1.UpdateAccountMappingsRequest) (*groupv1.UpdateAccountMappingsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	mappings := make([]*groupv1.AccountMapping, len(req.Mappings))
+	seen := make(map[string]bool, len(req.Mappings))
+	for i, mapping := range req.Mappings {
+		category := strings.TrimSpace(mapping.Category)
+		if category == "" {
+			return nil, validator.ValidationError{Field: "category", Message: "カテゴリは必須です"}
+		}
+		if err := validator.ValidateExpenseCategory(category); err != nil {
+			return nil, err
+		}
+		if seen[category] {
+			return nil, validator.ValidationError{Field: "category", Message: fmt.Sprintf("カテゴリ「%s」が重複しています", category)}
+		}
+		seen[category] = true
+
+		if err := validator.ValidateAccountName(mapping.Account); err != nil {
+			return nil, err
+		}
+
+		mappings[i] = &groupv1.AccountMapping{Category: category, Account: strings.TrimSpace(mapping.Account)}
+	}
+	slices.SortFunc(mappings, func(a, b *groupv1.AccountMapping) int {
+		return strings.Compare(a.Category, b.Category)
+	})
+
+	// Check the group exists
+	if _, err := s.repo.GetGroupByID(req.GroupId); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceAccountMappings(req.GroupId, mappings); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.UpdateAccountMappingsResponse{Mappings: mappings}, nil
+}
+
+// journalEntry is one double-entry journal row
+type journalEntry struct {
+	date          time.Time
+	debitAccount  string
+	debitMember   string
+	creditAccount string
+	creditMember  string
+	amount        int64
+	memo          string
+}
+
+// ExportJournal converts the group's expenses, income and settle-up payments
+// into journal entries in the CSV import format of freee or MoneyForward:
+//
+//	expense: category account / 未払金 (payer)
+//	income:  未払金 (recipient) / category account
+//	payment: 未払金 (recipient) / 未払金 (payer)
+//
+// so 未払金 per member tracks what the group owes them. With
+// include_settlements the transfers that settle the group are booked the same
+// way as payments, dated today. Deleted expenses are left out.
+func (s *GroupService) ExportJournal(ctx context.Context, req *groupv1.ExportJournalRequest) (*groupv1.ExportJournalResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	var write func(*csv.Writer, []journalEntry)
+	var formatName string
+	switch req.Format {
+	case groupv1.JournalFormat_JOURNAL_FORMAT_FREEE:
+		write, formatName = writeFreeeJournal, "freee"
+	case groupv1.JournalFormat_JOURNAL_FORMAT_MONEYFORWARD:
+		write, formatName = writeMoneyForwardJournal, "moneyforward"
+	default:
+		return nil, validator.ValidationError{Field: "format", Message: "出力形式が無効です"}
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	// Both services book in yen only
+	if group.Currency != "JPY" {
+		return nil, errors.New("仕訳の出力は日本円のグループのみ対応しています")
+	}
+
+	mappings, err := s.repo.GetAccountMappings(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	// Listed newest first
+	slices.Reverse(expenses)
+
+	now := time.Now()
+	// Only approved expenses are booked, as only they count towards the
+	// balances the settlements are worked out from
+	entries := journalEntries(approvedExpenses(expenses), mappings)
+	if req.IncludeSettlements {
+		settlements, err := algorithm.CalculateOptimalSettlements(memberBalances(group, expenses))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, settlementJournalEntries(settlements, now)...)
+	}
+
+	// Japanese accounting software reads Shift_JIS. Characters it lacks, such
+	// as emoji, are encoded as the SUB control character and then made "?";
+	// 0x1A never occurs inside a Shift_JIS multi-byte character.
+	var content bytes.Buffer
+	encoder := encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder())
+	encoded := transform.NewWriter(&content, encoder)
+	out := csv.NewWriter(encoded)
+	out.UseCRLF = true
+	write(out, entries)
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return nil, err
+	}
+	if err := encoded.Close(); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.ExportJournalResponse{
+		FileName:    fmt.Sprintf("%s_仕訳_%s_%s.csv", group.Name, formatName, now.Format("20060102")),
+		ContentType: "text/csv; charset=Shift_JIS",
+		Content:     bytes.ReplaceAll(content.Bytes(), []byte{0x1A}, []byte("?")),
+		EntryCount:  int32(len(entries)),
+	}, nil
+}
+
+// journalEntries books expenses listed oldest first
+func journalEntries(expenses []*domain.Expense, mappings []*groupv1.AccountMapping) []journalEntry {
+	accounts := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		accounts[mapping.Category] = mapping.Account
+	}
+	account := func(category, fallback string) string {
+		if account, ok := accounts[category]; ok {
+			return account
+		}
+		return fallback
+	}
+
+	entries := make([]journalEntry, 0, len(expenses))
+	for _, expense := range expenses {
+		entry := journalEntry{
+			date:   expense.CreatedAt.Local(),
+			amount: expense.Amount,
+			memo:   expense.Description,
+		}
+
+		switch expense.Kind {
+		case domain.ExpenseKindIncome:
+			entry.debitAccount, entry.debitMember = payableAccount, expense.PaidByName
+			entry.creditAccount = account(expense.Category, defaultIncomeAccount)
+		case domain.ExpenseKindPayment:
+			entry.debitAccount = payableAccount
+			if len(expense.SplitMembers) > 0 {
+				entry.debitMember = expense.SplitMembers[0].MemberName
+			}
+			entry.creditAccount, entry.creditMember = payableAccount, expense.PaidByName
+			if entry.memo == "" {
+				entry.memo = fmt.Sprintf("精算 %s → %s", entry.creditMember, entry.debitMember)
+			}
+		default:
+			entry.debitAccount = account(expense.Category, defaultExpenseAccount)
+			entry.creditAccount, entry.creditMember = payableAccount, expense.PaidByName
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func settlementJournalEntries(settlements []algorithm.Settlement, date time.Time) []journalEntry {
+	entries := make([]journalEntry, len(settlements))
+	for i, settlement := range settlements {
+		entries[i] = journalEntry{
+			date:          date,
+			debitAccount:  payableAccount,
+			debitMember:   settlement.ToName,
+			creditAccount: payableAccount,
+			creditMember:  settlement.FromName,
+			amount:        settlement.Amount,
+			memo:          fmt.Sprintf("精算 %s → %s", settlement.FromName, settlement.ToName),
+		}
+	}
+	return entries
+}
+
+// writeFreeeJournal writes freee's journal import layout, with members as
+// partners (取引先)
+func writeFreeeJournal(out *csv.Writer, entries []journalEntry) {
+	out.Write([]string{"取引日", "伝票番号", "借方勘定科目", "借方取引先", "借方税区分", "借方金額",
+		"貸方勘定科目", "貸方取引先", "貸方税区分", "貸方金額", "摘要"})
+	for i, entry := range entries {
+		amount := strconv.FormatInt(entry.amount, 10)
+		out.Write([]string{
+			entry.date.Format(journalDateLayout),
+			strconv.Itoa(i + 1),
+			entry.debitAccount, entry.debitMember, journalTaxClass, amount,
+			entry.creditAccount, entry.creditMember, journalTaxClass, amount,
+			entry.memo,
+		})
+	}
+}
+
+// writeMoneyForwardJournal writes MoneyForward's journal import layout, with
+// members as sub-accounts (補助科目)
+func writeMoneyForwardJournal(out *csv.Writer, entries []journalEntry) {
+	out.Write([]string{"取引No", "取引日", "借方勘定科目", "借方補助科目", "借方税区分", "借方金額(円)",
+		"貸方勘定科目", "貸方補助科目", "貸方税区分", "貸方金額(円)", "摘要"})
+	for i, entry := range entries {
+		amount := strconv.FormatInt(entry.amount, 10)
+		out.Write([]string{
+			strconv.Itoa(i + 1),
+			entry.date.Format(journalDateLayout),
+			entry.debitAccount, entry.debitMember, journalTaxClass, amount,
+			entry.creditAccount, entry.creditMember, journalTaxClass, amount,
+			entry.memo,
+		})
+	}
+}
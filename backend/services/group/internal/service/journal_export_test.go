@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_ExportJournal(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Name:     "サークル",
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+	mappings := []*groupv1.AccountMapping{{Category: "交通費", Account: "旅費交通費"}}
+
+	// Listed newest first, as the repository returns them
+	expenses := []*domain.Expense{
+		{
+			// Still under review, so neither booked nor settled
+			Amount:         4000,
+			Description:    "タクシー",
+			Category:       "交通費",
+			Kind:           domain.ExpenseKindExpense,
+			PaidByID:       aliceID,
+			PaidByName:     "Alice",
+			ApprovalStatus: domain.ApprovalStatusPending,
+			SplitMembers:   []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 2000}, {MemberID: bobID, MemberName: "Bob", Amount: 2000}},
+			CreatedAt:      time.Date(2024, 5, 4, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       500,
+			Description:  "返金",
+			Category:     "交通費",
+			Kind:         domain.ExpenseKindIncome,
+			PaidByID:     bobID,
+			PaidByName:   "Bob",
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 250}, {MemberID: bobID, MemberName: "Bob", Amount: 250}},
+			CreatedAt:    time.Date(2024, 5, 3, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       1000,
+			Kind:         domain.ExpenseKindPayment,
+			PaidByID:     bobID,
+			PaidByName:   "Bob",
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 1000}},
+			CreatedAt:    time.Date(2024, 5, 2, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       6000,
+			Description:  "新幹線 🚄",
+			Category:     "交通費",
+			Kind:         domain.ExpenseKindExpense,
+			PaidByID:     aliceID,
+			PaidByName:   "Alice",
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 3000}, {MemberID: bobID, MemberName: "Bob", Amount: 3000}},
+			CreatedAt:    time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       2000,
+			Description:  "備品",
+			Category:     "消耗品",
+			Kind:         domain.ExpenseKindExpense,
+			PaidByID:     bobID,
+			PaidByName:   "Bob",
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, MemberName: "Alice", Amount: 1000}, {MemberID: bobID, MemberName: "Bob", Amount: 1000}},
+			CreatedAt:    time.Date(2024, 4, 30, 12, 0, 0, 0, time.Local),
+		},
+	}
+
+	export := func(t *testing.T, req *groupv1.ExportJournalRequest) (*groupv1.ExportJournalResponse, [][]string) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockRepo.On("GetAccountMappings", groupID.String()).Return(mappings, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(append([]*domain.Expense(nil), expenses...), nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		req.GroupId = groupID.String()
+		resp, err := service.ExportJournal(context.Background(), req)
+		require.NoError(t, err)
+
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(resp.Content)
+		require.NoError(t, err)
+		assert.Contains(t, string(decoded), "\r\n")
+		records, err := csv.NewReader(strings.NewReader(string(decoded))).ReadAll()
+		require.NoError(t, err)
+		return resp, records
+	}
+
+	t.Run("freee", func(t *testing.T) {
+		resp, records := export(t, &groupv1.ExportJournalRequest{Format: groupv1.JournalFormat_JOURNAL_FORMAT_FREEE})
+
+		assert.Equal(t, "text/csv; charset=Shift_JIS", resp.ContentType)
+		assert.True(t, strings.HasPrefix(resp.FileName, "サークル_仕訳_freee_"))
+		assert.Equal(t, int32(4), resp.EntryCount)
+		assert.Equal(t, [][]string{
+			{"取引日", "伝票番号", "借方勘定科目", "借方取引先", "借方税区分", "借方金額", "貸方勘定科目", "貸方取引先", "貸方税区分", "貸方金額", "摘要"},
+			{"2024/04/30", "1", "雑費", "", "対象外", "2000", "未払金", "Bob", "対象外", "2000", "備品"},
+			{"2024/05/01", "2", "旅費交通費", "", "対象外", "6000", "未払金", "Alice", "対象外", "6000", "新幹線 ?"},
+			{"2024/05/02", "3", "未払金", "Alice", "対象外", "1000", "未払金", "Bob", "対象外", "1000", "精算 Bob → Alice"},
+			{"2024/05/03", "4", "未払金", "Bob", "対象外", "500", "旅費交通費", "", "対象外", "500", "返金"},
+		}, records)
+	})
+
+	t.Run("MoneyForward with settlements", func(t *testing.T) {
+		resp, records := export(t, &groupv1.ExportJournalRequest{
+			Format:             groupv1.JournalFormat_JOURNAL_FORMAT_MONEYFORWARD,
+			IncludeSettlements: true,
+		})
+
+		assert.Equal(t, int32(5), resp.EntryCount)
+		require.Len(t, records, 6)
+		assert.Equal(t, "借方補助科目", records[0][3])
+		assert.Equal(t, []string{"1", "2024/04/30", "雑費", "", "対象外", "2000", "未払金", "Bob", "対象外", "2000", "備品"}, records[1])
+
+		// Alice paid 6000 and was repaid 1000; her shares are 4000 less 250 of the refund
+		settlement := records[5]
+		assert.Equal(t, time.Now().Format("2006/01/02"), settlement[1])
+		assert.Equal(t, []string{"未払金", "Alice", "未払金", "Bob", "1250", "精算 Bob → Alice"},
+			[]string{settlement[2], settlement[3], settlement[6], settlement[7], settlement[9], settlement[10]})
+	})
+}
+
+func TestGroupService_ExportJournal_Invalid(t *testing.T) {
+	groupID := uuid.New()
+
+	t.Run("format is required", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		_, err := service.ExportJournal(context.Background(), &groupv1.ExportJournalRequest{GroupId: groupID.String()})
+		assert.EqualError(t, err, "format: 出力形式が無効です")
+	})
+
+	t.Run("yen only", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(&groupv1.Group{Id: groupID.String(), Currency: "USD"}, nil)
+		service := NewGroupService(mockRepo, new(MockExpenseRepository))
+
+		_, err := service.ExportJournal(context.Background(), &groupv1.ExportJournalRequest{
+			GroupId: groupID.String(),
+			Format:  groupv1.JournalFormat_JOURNAL_FORMAT_FREEE,
+		})
+		assert.EqualError(t, err, "仕訳の出力は日本円のグループのみ対応しています")
+	})
+}
+
+func TestGroupService_UpdateAccountMappings(t *testing.T) {
+	groupID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{Id: groupID}, nil)
+		expected := []*groupv1.AccountMapping{
+			{Category: "交通費", Account: "旅費交通費"},
+			{Category: "食費", Account: "会議費"},
+		}
+		mockRepo.On("ReplaceAccountMappings", groupID, expected).Return(nil)
+
+		service := NewGroupService(mockRepo, new(MockExpenseRepository))
+		resp, err := service.UpdateAccountMappings(context.Background(), &groupv1.UpdateAccountMappingsRequest{
+			GroupId: groupID,
+			Mappings: []*groupv1.AccountMapping{
+				{Category: " 食費 ", Account: "会議費"},
+				{Category: "交通費", Account: "旅費交通費 "},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, resp.Mappings)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid mappings", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			mappings []*groupv1.AccountMapping
+			wantErr  string
+		}{
+			{name: "empty category", mappings: []*groupv1.AccountMapping{{Category: "", Account: "雑費"}}, wantErr: "category: カテゴリは必須です"},
+			{name: "duplicate category", mappings: []*groupv1.AccountMapping{{Category: "食費", Account: "会議費"}, {Category: "食費", Account: "交際費"}}, wantErr: "category: カテゴリ「食費」が重複しています"},
+			{name: "empty account", mappings: []*groupv1.AccountMapping{{Category: "食費", Account: ""}}, wantErr: "account: 勘定科目は必須です"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+				_, err := service.UpdateAccountMappings(context.Background(), &groupv1.UpdateAccountMappingsRequest{GroupId: groupID, Mappings: tt.mappings})
+				assert.EqualError(t, err, tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("group not found", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockRepo.On("GetGroupByID", groupID).Return(nil, errors.New("group not found"))
+
+		service := NewGroupService(mockRepo, new(MockExpenseRepository))
+		_, err := service.UpdateAccountMappings(context.Background(), &groupv1.UpdateAccountMappingsRequest{GroupId: groupID})
+		assert.EqualError(t, err, "group not found")
+	})
+}
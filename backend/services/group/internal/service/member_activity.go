@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errSplitAmongPresentGiven refuses an explicit split on an expense split
+// among everyone present, rather than silently replacing it
+var errSplitAmongPresentGiven = validator.ValidationError{Field: "splitMemberIds", Message: "在籍メンバーで割る場合は割り勘メンバーを指定できません"}
+
+// SetMemberActivePeriod sets the span in which a member takes part in the
+// group's expenses. Expenses split among everyone present leave the member
+// out outside of it; expenses already saved keep their split.
+func (s *GroupService) SetMemberActivePeriod(ctx context.Context, req *groupv1.SetMemberActivePeriodRequest) (*groupv1.SetMemberActivePeriodResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	period := &domain.MemberActivePeriod{MemberID: req.MemberId}
+	if req.ActiveFrom != nil {
+		from := req.ActiveFrom.AsTime()
+		period.ActiveFrom = &from
+	}
+	if req.ActiveUntil != nil {
+		until := req.ActiveUntil.AsTime()
+		period.ActiveUntil = &until
+	}
+	if period.ActiveFrom != nil && period.ActiveUntil != nil && period.ActiveUntil.Before(*period.ActiveFrom) {
+		return nil, validator.ValidationError{Field: "activeUntil", Message: "参加期間の範囲が無効です"}
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	member := findMember(group, req.MemberId)
+	if member == nil {
+		return nil, errors.New("メンバーがグループに存在しません")
+	}
+
+	if err := s.repo.SetMemberActivePeriod(req.GroupId, period); err != nil {
+		return nil, err
+	}
+
+	setMemberActivePeriod(member, period)
+
+	return &groupv1.SetMemberActivePeriodResponse{Member: member}, nil
+}
+
+// withMemberActivePeriods fills in the active periods of the group's members
+func (s *GroupService) withMemberActivePeriods(group *groupv1.Group) error {
+	periods, err := s.repo.GetMemberActivePeriods(group.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, period := range periods {
+		if member := findMember(group, period.MemberID); member != nil {
+			setMemberActivePeriod(member, period)
+		}
+	}
+	return nil
+}
+
+func setMemberActivePeriod(member *groupv1.Member, period *domain.MemberActivePeriod) {
+	member.ActiveFrom = nil
+	member.ActiveUntil = nil
+	if period.ActiveFrom != nil {
+		member.ActiveFrom = timestamppb.New(*period.ActiveFrom)
+	}
+	if period.ActiveUntil != nil {
+		member.ActiveUntil = timestamppb.New(*period.ActiveUntil)
+	}
+}
+
+// splitAmongPresent fills in the split of a new expense with the members
+// present at its date
+func splitAmongPresent(req *groupv1.AddExpenseRequest, periods []*domain.MemberActivePeriod, at time.Time) error {
+	if len(req.SplitMemberIds) > 0 || len(req.SplitAmounts) > 0 {
+		return errSplitAmongPresentGiven
+	}
+
+	memberIDs, err := presentMemberIDs(periods, at)
+	if err != nil {
+		return err
+	}
+	req.SplitMemberIds = memberIDs
+	return nil
+}
+
+// presentMemberIDs returns the members taking part in the group's expenses
+// at the given time, in the order they joined
+func presentMemberIDs(periods []*domain.MemberActivePeriod, at time.Time) ([]string, error) {
+	var memberIDs []string
+	for _, period := range periods {
+		if period.Covers(at) {
+			memberIDs = append(memberIDs, period.MemberID)
+		}
+	}
+
+	if len(memberIDs) == 0 {
+		return nil, validator.ValidationError{Field: "splitMemberIds", Message: "支払い日に在籍しているメンバーがいません"}
+	}
+	return memberIDs, nil
+}
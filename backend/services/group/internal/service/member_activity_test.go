@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_SplitAmongPresent(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+	carolID := uuid.New().String()
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID, Name: "Alice"},
+			{Id: bobID, Name: "Bob"},
+			{Id: carolID, Name: "Carol"},
+		},
+	}
+
+	// Carol left the trip yesterday; Bob joined on its second day
+	left := time.Now().AddDate(0, 0, -1)
+	joined := time.Date(2024, 8, 11, 0, 0, 0, 0, time.UTC)
+	periods := []*domain.MemberActivePeriod{
+		{MemberID: aliceID},
+		{MemberID: bobID, ActiveFrom: &joined},
+		{MemberID: carolID, ActiveUntil: &left},
+	}
+
+	splitMemberIDs := func(expense *domain.Expense) []string {
+		var memberIDs []string
+		for _, split := range expense.SplitMembers {
+			memberIDs = append(memberIDs, split.MemberID.String())
+		}
+		return memberIDs
+	}
+
+	t.Run("new expense", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetMemberActivePeriods", groupID.String()).Return(periods, nil)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+		mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(nil, nil)
+		mockExpenseRepo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Expense")).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:           groupID.String(),
+			Amount:            3000,
+			Description:       "Dinner",
+			PaidById:          aliceID,
+			SplitAmongPresent: true,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Expense.SplitMembers, 2)
+		assert.Equal(t, aliceID, resp.Expense.SplitMembers[0].MemberId)
+		assert.Equal(t, bobID, resp.Expense.SplitMembers[1].MemberId)
+		assert.Equal(t, int64(1500), resp.Expense.SplitMembers[1].Amount)
+	})
+
+	t.Run("explicit split", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetMemberActivePeriods", groupID.String()).Return(periods, nil)
+
+		service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+		_, err := service.AddExpense(context.Background(), &groupv1.AddExpenseRequest{
+			GroupId:           groupID.String(),
+			Amount:            3000,
+			Description:       "Dinner",
+			PaidById:          aliceID,
+			SplitMemberIds:    []string{aliceID},
+			SplitAmongPresent: true,
+		})
+
+		assert.EqualError(t, err, "splitMemberIds: 在籍メンバーで割る場合は割り勘メンバーを指定できません")
+	})
+
+	t.Run("updated expense uses its own date", func(t *testing.T) {
+		existing := &domain.Expense{
+			ID:        uuid.New(),
+			GroupID:   groupID,
+			Amount:    2000,
+			Kind:      domain.ExpenseKindExpense,
+			PaidByID:  uuid.MustParse(aliceID),
+			CreatedAt: time.Date(2024, 8, 10, 19, 0, 0, 0, time.UTC),
+		}
+
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindByID", mock.Anything, existing.ID).Return(existing, nil)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+		mockGroupRepo.On("GetMemberActivePeriods", groupID.String()).Return(periods, nil)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockGroupRepo.On("GetGroupSettings", groupID.String()).Return(&groupv1.GroupSettings{}, nil)
+		mockGroupRepo.On("GetBudgets", groupID.String()).Return(nil, nil)
+		mockExpenseRepo.On("Update", mock.Anything, mock.MatchedBy(func(expense *domain.Expense) bool {
+			// Bob had not joined yet and Carol was still there
+			memberIDs := splitMemberIDs(expense)
+			return len(memberIDs) == 2 && memberIDs[0] == aliceID && memberIDs[1] == carolID
+		}), domain.ExpenseChange{}).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		_, err := service.UpdateExpense(context.Background(), &groupv1.UpdateExpenseRequest{
+			ExpenseId:         existing.ID.String(),
+			Amount:            2400,
+			Description:       "Dinner",
+			PaidById:          aliceID,
+			SplitAmongPresent: true,
+		})
+
+		require.NoError(t, err)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("nobody present", func(t *testing.T) {
+		_, err := presentMemberIDs(periods[2:], time.Now())
+		assert.EqualError(t, err, "splitMemberIds: 支払い日に在籍しているメンバーがいません")
+	})
+}
+
+func TestGroupService_SetMemberActivePeriod(t *testing.T) {
+	groupID := uuid.New().String()
+	memberID := uuid.New().String()
+	group := &groupv1.Group{
+		Id:      groupID,
+		Members: []*groupv1.Member{{Id: memberID, Name: "Alice"}},
+	}
+	from := time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 8, 12, 23, 59, 59, 0, time.UTC)
+
+	t.Run("sets the period", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(group, nil)
+		mockGroupRepo.On("SetMemberActivePeriod", groupID, mock.MatchedBy(func(period *domain.MemberActivePeriod) bool {
+			return period.MemberID == memberID && period.ActiveFrom == nil && period.ActiveUntil.Equal(until)
+		})).Return(nil)
+
+		service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+		resp, err := service.SetMemberActivePeriod(context.Background(), &groupv1.SetMemberActivePeriodRequest{
+			GroupId:     groupID,
+			MemberId:    memberID,
+			ActiveUntil: timestamppb.New(until),
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.Member.ActiveFrom)
+		assert.True(t, until.Equal(resp.Member.ActiveUntil.AsTime()))
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("until before from", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		_, err := service.SetMemberActivePeriod(context.Background(), &groupv1.SetMemberActivePeriodRequest{
+			GroupId:     groupID,
+			MemberId:    memberID,
+			ActiveFrom:  timestamppb.New(until),
+			ActiveUntil: timestamppb.New(from),
+		})
+
+		assert.EqualError(t, err, "activeUntil: 参加期間の範囲が無効です")
+	})
+
+	t.Run("member of another group", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(group, nil)
+
+		service := NewGroupService(mockGroupRepo, new(MockExpenseRepository))
+
+		_, err := service.SetMemberActivePeriod(context.Background(), &groupv1.SetMemberActivePeriodRequest{
+			GroupId:  groupID,
+			MemberId: uuid.New().String(),
+		})
+
+		assert.EqualError(t, err, "メンバーがグループに存在しません")
+		mockGroupRepo.AssertNotCalled(t, "SetMemberActivePeriod", mock.Anything, mock.Anything)
+	})
+}
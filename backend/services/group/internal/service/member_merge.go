@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MergeMembers folds a member added twice into the other one. The kept
+// member takes over the expenses, shares and payments of the merged member,
+// so balances add up the same, and the merge is kept in an audit trail.
+func (s *GroupService) MergeMembers(ctx context.Context, req *groupv1.MergeMembersRequest) (*groupv1.MergeMembersResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.KeepId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MergeId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	if req.KeepId == req.MergeId {
+		return nil, validator.ValidationError{Field: "mergeId", Message: "同じメンバー同士は統合できません"}
+	}
+
+	if req.MergedBy != "" {
+		if err := validator.ValidateUUID(req.MergedBy); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := findMember(group, req.KeepId)
+	merged := findMember(group, req.MergeId)
+	if kept == nil || merged == nil {
+		return nil, errors.New("統合するメンバーがグループに存在しません")
+	}
+	if req.MergedBy != "" && findMember(group, req.MergedBy) == nil {
+		return nil, errors.New("統合したメンバーがグループに存在しません")
+	}
+
+	// Expenses in the trash are merged too so restoring one still adds up
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.expenseRepo.FindDeletedByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	expenses = append(expenses, deleted...)
+
+	merge := &domain.MemberMerge{
+		ID:               uuid.New().String(),
+		GroupID:          req.GroupId,
+		KeptMemberID:     kept.Id,
+		KeptMemberName:   kept.Name,
+		MergedMemberID:   merged.Id,
+		MergedMemberName: merged.Name,
+		MergedBy:         req.MergedBy,
+		MergedAt:         time.Now(),
+	}
+
+	for _, expense := range expenses {
+		if expenseInvolves(expense, merged.Id) {
+			merge.ExpenseCount++
+		}
+	}
+
+	// The shares are combined from the expenses as locked by the merge, not
+	// as read here, so a concurrent edit cannot be overwritten
+	combine := func(expense *domain.Expense) (domain.CombinedSplit, bool) {
+		return combinedSplit(expense, kept.Id, merged.Id)
+	}
+	if err := s.repo.MergeMembers(merge, combine); err != nil {
+		return nil, err
+	}
+
+	return &groupv1.MergeMembersResponse{Merge: toProtoMemberMerge(merge)}, nil
+}
+
+func (s *GroupService) ListMemberMerges(ctx context.Context, req *groupv1.ListMemberMergesRequest) (*groupv1.ListMemberMergesResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	merges, err := s.repo.GetMemberMerges(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	protoMerges := make([]*groupv1.MemberMerge, len(merges))
+	for i, merge := range merges {
+		protoMerges[i] = toProtoMemberMerge(merge)
+	}
+
+	return &groupv1.ListMemberMergesResponse{Merges: protoMerges}, nil
+}
+
+// combinedSplit works out the shares of an expense both members are split
+// on once they are one member. Equal splits are stored rounded down and
+// split again when balances are calculated, so the shares they work out to
+// are stored exactly instead; otherwise dropping a member would change them.
+func combinedSplit(expense *domain.Expense, keptID, mergedID string) (domain.CombinedSplit, bool) {
+	keptIndex, mergedIndex := -1, -1
+	for i, split := range expense.SplitMembers {
+		switch split.MemberID.String() {
+		case keptID:
+			keptIndex = i
+		case mergedID:
+			mergedIndex = i
+		}
+	}
+	if keptIndex < 0 || mergedIndex < 0 {
+		return domain.CombinedSplit{}, false
+	}
+
+	shares := algorithmExpenses([]*domain.Expense{expense})[0].Shares()
+	combined := domain.CombinedSplit{ExpenseID: expense.ID}
+	for i, split := range expense.SplitMembers {
+		if i == mergedIndex {
+			continue
+		}
+		split.Amount = shares[i]
+		if i == keptIndex {
+			split.Amount += shares[mergedIndex]
+		}
+		combined.Splits = append(combined.Splits, split)
+	}
+
+	// The merged member's vote goes with its split
+	combined.ApprovalStatus = expense.ApprovalStatus
+	if !expense.IsApproved() {
+		combined.ApprovalStatus = domain.ApprovalStatusFromVotes(combined.Splits)
+	}
+	return combined, true
+}
+
+func toProtoMemberMerge(merge *domain.MemberMerge) *groupv1.MemberMerge {
+	return &groupv1.MemberMerge{
+		Id:               merge.ID,
+		GroupId:          merge.GroupID,
+		KeptMemberId:     merge.KeptMemberID,
+		KeptMemberName:   merge.KeptMemberName,
+		MergedMemberId:   merge.MergedMemberID,
+		MergedMemberName: merge.MergedMemberName,
+		ExpenseCount:     int32(merge.ExpenseCount),
+		MergedBy:         merge.MergedBy,
+		MergedAt:         timestamppb.New(merge.MergedAt),
+	}
+}
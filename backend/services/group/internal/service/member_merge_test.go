@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_MergeMembers(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	bob2ID := uuid.New()
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+			{Id: bob2ID.String(), Name: "ボブ"},
+		},
+	}
+
+	// 1000 split three ways is stored as 333 each; Alice's share is 334
+	dinner := &domain.Expense{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		Amount:   1000,
+		PaidByID: aliceID,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, Amount: 333},
+			{MemberID: bobID, Amount: 333},
+			{MemberID: bob2ID, Amount: 333},
+		},
+	}
+	taxi := &domain.Expense{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		Amount:   2000,
+		PaidByID: bob2ID,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, Amount: 1000},
+			{MemberID: bob2ID, Amount: 1000},
+		},
+	}
+	trashed := &domain.Expense{
+		ID:       uuid.New(),
+		GroupID:  groupID,
+		Amount:   500,
+		PaidByID: aliceID,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, Amount: 500},
+		},
+	}
+
+	newService := func() (*GroupService, *MockGroupRepositoryInterface) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return([]*domain.Expense{dinner, taxi}, nil)
+		mockExpenseRepo.On("FindDeletedByGroupID", mock.Anything, groupID).Return([]*domain.Expense{trashed}, nil)
+		return NewGroupService(mockGroupRepo, mockExpenseRepo), mockGroupRepo
+	}
+
+	t.Run("shares combined", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+		var merge *domain.MemberMerge
+		var combine func(expense *domain.Expense) (domain.CombinedSplit, bool)
+		mockGroupRepo.On("MergeMembers", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				merge = args.Get(0).(*domain.MemberMerge)
+				combine = args.Get(1).(func(expense *domain.Expense) (domain.CombinedSplit, bool))
+			}).
+			Return(nil)
+
+		resp, err := service.MergeMembers(context.Background(), &groupv1.MergeMembersRequest{
+			GroupId:  groupID.String(),
+			KeepId:   bobID.String(),
+			MergeId:  bob2ID.String(),
+			MergedBy: aliceID.String(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Bob", resp.Merge.KeptMemberName)
+		assert.Equal(t, "ボブ", resp.Merge.MergedMemberName)
+		assert.Equal(t, int32(2), resp.Merge.ExpenseCount)
+		assert.Equal(t, aliceID.String(), merge.MergedBy)
+
+		// The repository combines the expenses both members share; Alice
+		// keeps her share of the remainder
+		combined, ok := combine(dinner)
+		require.True(t, ok)
+		assert.Equal(t, dinner.ID, combined.ExpenseID)
+		assert.Equal(t, []domain.SplitMember{
+			{MemberID: aliceID, Amount: 334},
+			{MemberID: bobID, Amount: 666},
+		}, combined.Splits)
+
+		_, ok = combine(taxi)
+		assert.False(t, ok)
+
+		// The merged member's dispute goes with its split; everyone left approved
+		disputed := *dinner
+		disputed.ApprovalStatus = domain.ApprovalStatusDisputed
+		disputed.SplitMembers = []domain.SplitMember{
+			{MemberID: aliceID, Amount: 333, Vote: domain.ApprovalVoteApprove},
+			{MemberID: bobID, Amount: 333, Vote: domain.ApprovalVoteApprove},
+			{MemberID: bob2ID, Amount: 333, Vote: domain.ApprovalVoteDispute},
+		}
+		combined, ok = combine(&disputed)
+		require.True(t, ok)
+		assert.Equal(t, domain.ApprovalStatusApproved, combined.ApprovalStatus)
+
+		// Expenses not under review stay approved without any votes
+		combined, _ = combine(dinner)
+		assert.Equal(t, dinner.ApprovalStatus, combined.ApprovalStatus)
+	})
+
+	t.Run("same member", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+
+		_, err := service.MergeMembers(context.Background(), &groupv1.MergeMembersRequest{
+			GroupId: groupID.String(),
+			KeepId:  bobID.String(),
+			MergeId: bobID.String(),
+		})
+
+		assert.EqualError(t, err, "mergeId: 同じメンバー同士は統合できません")
+		mockGroupRepo.AssertNotCalled(t, "MergeMembers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("member not in group", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+
+		_, err := service.MergeMembers(context.Background(), &groupv1.MergeMembersRequest{
+			GroupId: groupID.String(),
+			KeepId:  bobID.String(),
+			MergeId: uuid.New().String(),
+		})
+
+		assert.EqualError(t, err, "統合するメンバーがグループに存在しません")
+		mockGroupRepo.AssertNotCalled(t, "MergeMembers", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// UpdateMember edits a member's name, email, display color and payment
+// handle. Expenses are shown with the member's current name.
+func (s *GroupService) UpdateMember(ctx context.Context, req *groupv1.UpdateMemberRequest) (*groupv1.UpdateMemberResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	if err := validator.ValidateUUID(req.MemberId); err != nil {
+		return nil, errors.New("メンバーIDが無効です")
+	}
+
+	profile := &domain.MemberProfile{
+		MemberID:      req.MemberId,
+		Name:          strings.TrimSpace(req.Name),
+		Email:         strings.TrimSpace(req.Email),
+		Color:         strings.TrimSpace(req.Color),
+		PaymentHandle: strings.TrimSpace(req.PaymentHandle),
+	}
+
+	if err := validator.ValidateMemberName(profile.Name); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateEmail(profile.Email); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateMemberColor(profile.Color); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidatePaymentHandle(profile.PaymentHandle); err != nil {
+		return nil, err
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	member := findMember(group, req.MemberId)
+	if member == nil {
+		return nil, errors.New("メンバーがグループに存在しません")
+	}
+
+	// Expenses only tell members apart by name
+	for _, other := range group.Members {
+		if other.Id != member.Id && strings.EqualFold(strings.TrimSpace(other.Name), profile.Name) {
+			return nil, validator.ValidationError{Field: "name", Message: "重複するメンバー名があります: " + profile.Name}
+		}
+	}
+
+	if err := s.repo.UpdateMember(req.GroupId, profile); err != nil {
+		return nil, err
+	}
+
+	setMemberProfile(member, profile)
+
+	return &groupv1.UpdateMemberResponse{Member: member}, nil
+}
+
+// withMemberProfiles fills in the display color and payment handle of the
+// group's members
+func (s *GroupService) withMemberProfiles(group *groupv1.Group) error {
+	profiles, err := s.repo.GetMemberProfiles(group.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, profile := range profiles {
+		if member := findMember(group, profile.MemberID); member != nil {
+			setMemberProfile(member, profile)
+		}
+	}
+	return nil
+}
+
+func setMemberProfile(member *groupv1.Member, profile *domain.MemberProfile) {
+	member.Name = profile.Name
+	member.Email = profile.Email
+	member.Color = profile.Color
+	member.PaymentHandle = profile.PaymentHandle
+}
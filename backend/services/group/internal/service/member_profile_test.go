@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_UpdateMember(t *testing.T) {
+	groupID := uuid.New().String()
+	aliceID := uuid.New().String()
+	bobID := uuid.New().String()
+
+	newService := func() (*GroupService, *MockGroupRepositoryInterface) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetGroupByID", groupID).Return(&groupv1.Group{
+			Id: groupID,
+			Members: []*groupv1.Member{
+				{Id: aliceID, Name: "Alice"},
+				{Id: bobID, Name: "Bob"},
+			},
+		}, nil)
+		return NewGroupService(mockGroupRepo, nil), mockGroupRepo
+	}
+
+	t.Run("profile saved", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+		mockGroupRepo.On("UpdateMember", groupID, &domain.MemberProfile{
+			MemberID:      aliceID,
+			Name:          "Alice Smith",
+			Email:         "alice@example.com",
+			Color:         "#1E90FF",
+			PaymentHandle: "alice-paypay",
+		}).Return(nil)
+
+		resp, err := service.UpdateMember(context.Background(), &groupv1.UpdateMemberRequest{
+			GroupId:       groupID,
+			MemberId:      aliceID,
+			Name:          " Alice Smith ",
+			Email:         "alice@example.com",
+			Color:         "#1E90FF",
+			PaymentHandle: "alice-paypay",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Smith", resp.Member.Name)
+		assert.Equal(t, "alice@example.com", resp.Member.Email)
+		assert.Equal(t, "#1E90FF", resp.Member.Color)
+		assert.Equal(t, "alice-paypay", resp.Member.PaymentHandle)
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			req     *groupv1.UpdateMemberRequest
+			wantErr string
+		}{
+			{name: "no name", req: &groupv1.UpdateMemberRequest{Name: " "}, wantErr: "memberName: メンバー名は必須です"},
+			{name: "bad email", req: &groupv1.UpdateMemberRequest{Name: "Alice", Email: "alice"}, wantErr: "email: メールアドレスの形式が正しくありません"},
+			{name: "bad color", req: &groupv1.UpdateMemberRequest{Name: "Alice", Color: "blue"}, wantErr: "color: 表示色は#RRGGBB形式で入力してください"},
+			{name: "bad payment handle", req: &groupv1.UpdateMemberRequest{Name: "Alice", PaymentHandle: "<script>"}, wantErr: "paymentHandle: 送金用IDに使用できない文字が含まれています"},
+			{name: "name of another member", req: &groupv1.UpdateMemberRequest{Name: "bob"}, wantErr: "name: 重複するメンバー名があります: bob"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				service, mockGroupRepo := newService()
+				tt.req.GroupId = groupID
+				tt.req.MemberId = aliceID
+
+				_, err := service.UpdateMember(context.Background(), tt.req)
+
+				assert.EqualError(t, err, tt.wantErr)
+				mockGroupRepo.AssertNotCalled(t, "UpdateMember", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("member not in group", func(t *testing.T) {
+		service, mockGroupRepo := newService()
+
+		_, err := service.UpdateMember(context.Background(), &groupv1.UpdateMemberRequest{
+			GroupId:  groupID,
+			MemberId: uuid.New().String(),
+			Name:     "Carol",
+		})
+
+		assert.EqualError(t, err, "メンバーがグループに存在しません")
+		mockGroupRepo.AssertNotCalled(t, "UpdateMember", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// balanceTransfers checks the redistribution of a removed member's balance
+// and builds the payments that hand it over. A member owed money is paid off
+// by the members taking over the claim; a member owing money pays the
+// members taking over the debt. Either way the member's balance ends at zero.
+func balanceTransfers(group *groupv1.Group, groupID uuid.UUID, member *groupv1.Member, balance int64, redistribution []*groupv1.BalanceTransfer, now time.Time) ([]*domain.Expense, error) {
+	if balance == 0 {
+		if len(redistribution) > 0 {
+			return nil, validator.ValidationError{Field: "redistribution", Message: "残高が0のため引き継ぎは不要です"}
+		}
+		return nil, nil
+	}
+
+	owed := balance
+	if owed < 0 {
+		owed = -owed
+	}
+	if len(redistribution) == 0 {
+		return nil, errors.New("残高が精算されていないメンバーは削除できません。残高を引き継ぐメンバーを指定してください")
+	}
+
+	memberID := uuid.MustParse(member.Id)
+	seen := make(map[string]bool, len(redistribution))
+	transfers := make([]*domain.Expense, 0, len(redistribution))
+	var total int64
+	for i, transfer := range redistribution {
+		if err := validator.ValidateUUID(transfer.MemberId); err != nil {
+			return nil, errors.New("メンバーIDが無効です")
+		}
+		if transfer.MemberId == member.Id {
+			return nil, validator.ValidationError{Field: "redistribution", Message: "削除するメンバー自身には引き継げません"}
+		}
+		if seen[transfer.MemberId] {
+			return nil, validator.ValidationError{Field: "redistribution", Message: "引き継ぐメンバーが重複しています"}
+		}
+		seen[transfer.MemberId] = true
+
+		heir := findMember(group, transfer.MemberId)
+		if heir == nil {
+			return nil, errors.New("引き継ぐメンバーがグループに存在しません")
+		}
+		if transfer.Amount <= 0 {
+			return nil, validator.ValidationError{Field: "redistribution", Message: "引き継ぐ金額は1円以上で入力してください"}
+		}
+		total += transfer.Amount
+
+		heirID := uuid.MustParse(heir.Id)
+		payer, recipient := heirID, memberID
+		payerName, recipientName := heir.Name, member.Name
+		if balance < 0 {
+			payer, recipient = memberID, heirID
+			payerName, recipientName = member.Name, heir.Name
+		}
+
+		// Space the timestamps so the payments list in the order they were given
+		createdAt := now.Add(time.Duration(i) * time.Microsecond)
+		transfers = append(transfers, &domain.Expense{
+			ID:          uuid.New(),
+			GroupID:     groupID,
+			Amount:      transfer.Amount,
+			Description: fmt.Sprintf("%sの残高の引き継ぎ", member.Name),
+			Kind:        domain.ExpenseKindPayment,
+			Currency:    group.Currency,
+			PaidByID:    payer,
+			PaidByName:  payerName,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: recipient, MemberName: recipientName, Amount: transfer.Amount},
+			},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+			// Agreed on by removing the member; nothing left to review
+			ApprovalStatus: domain.ApprovalStatusApproved,
+		})
+	}
+
+	if total != owed {
+		return nil, validator.ValidationError{
+			Field:   "redistribution",
+			Message: fmt.Sprintf("引き継ぐ金額の合計（%d）が残高（%d）と一致しません", total, owed),
+		}
+	}
+
+	return transfers, nil
+}
+
+// expenseInvolves reports whether a member paid or shares the expense
+func expenseInvolves(expense *domain.Expense, memberID string) bool {
+	if expense.PaidByID.String() == memberID {
+		return true
+	}
+	for _, split := range expense.SplitMembers {
+		if split.MemberID.String() == memberID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_RemoveMember(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+			{Id: carolID.String(), Name: "Carol"},
+		},
+	}
+
+	// Alice paid 9000 for all three: Alice +6000, Bob -3000, Carol -3000
+	dinner := &domain.Expense{
+		ID:             uuid.New(),
+		GroupID:        groupID,
+		Amount:         9000,
+		Kind:           domain.ExpenseKindExpense,
+		PaidByID:       aliceID,
+		ApprovalStatus: domain.ApprovalStatusApproved,
+		SplitMembers: []domain.SplitMember{
+			{MemberID: aliceID, Amount: 3000},
+			{MemberID: bobID, Amount: 3000},
+			{MemberID: carolID, Amount: 3000},
+		},
+	}
+
+	newService := func(expenses ...*domain.Expense) (*GroupService, *MockGroupRepositoryInterface, *MockExpenseRepository) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(expenses, nil)
+		return NewGroupService(mockGroupRepo, mockExpenseRepo), mockGroupRepo, mockExpenseRepo
+	}
+
+	t.Run("balance left", func(t *testing.T) {
+		service, mockGroupRepo, _ := newService(dinner)
+
+		_, err := service.RemoveMember(context.Background(), &groupv1.RemoveMemberRequest{
+			GroupId:  groupID.String(),
+			MemberId: aliceID.String(),
+		})
+
+		assert.EqualError(t, err, "残高が精算されていないメンバーは削除できません。残高を引き継ぐメンバーを指定してください")
+		mockGroupRepo.AssertNotCalled(t, "RemoveMember", mock.Anything)
+	})
+
+	t.Run("claim handed over", func(t *testing.T) {
+		service, mockGroupRepo, _ := newService(dinner)
+		var removal *domain.MemberRemoval
+		mockGroupRepo.On("RemoveMember", mock.AnythingOfType("*domain.MemberRemoval")).
+			Run(func(args mock.Arguments) { removal = args.Get(0).(*domain.MemberRemoval) }).
+			Return(nil)
+
+		resp, err := service.RemoveMember(context.Background(), &groupv1.RemoveMemberRequest{
+			GroupId:  groupID.String(),
+			MemberId: aliceID.String(),
+			Redistribution: []*groupv1.BalanceTransfer{
+				{MemberId: bobID.String(), Amount: 3000},
+				{MemberId: carolID.String(), Amount: 3000},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Success)
+		require.Len(t, resp.Transfers, 2)
+		assert.Equal(t, aliceID.String(), removal.MemberID)
+		// The repository checks the dinner has not changed before saving
+		assert.Equal(t, []domain.ExpenseVersion{{ExpenseID: dinner.ID, ApprovalStatus: domain.ApprovalStatusApproved}}, removal.Expenses)
+		transfers := removal.Transfers
+		assert.Equal(t, bobID.String(), resp.Transfers[0].PaidById)
+		assert.Equal(t, groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT, resp.Transfers[0].Kind)
+
+		// Alice is settled and the others now owe nothing more to anyone
+		balances := memberBalances(group, append([]*domain.Expense{dinner}, transfers...))
+		for _, balance := range balances {
+			assert.Equal(t, int64(0), balance.Amount, balance.Name)
+		}
+		mockGroupRepo.AssertExpectations(t)
+	})
+
+	t.Run("debt handed over", func(t *testing.T) {
+		service, mockGroupRepo, _ := newService(dinner)
+		var removal *domain.MemberRemoval
+		mockGroupRepo.On("RemoveMember", mock.AnythingOfType("*domain.MemberRemoval")).
+			Run(func(args mock.Arguments) { removal = args.Get(0).(*domain.MemberRemoval) }).
+			Return(nil)
+
+		_, err := service.RemoveMember(context.Background(), &groupv1.RemoveMemberRequest{
+			GroupId:        groupID.String(),
+			MemberId:       bobID.String(),
+			Redistribution: []*groupv1.BalanceTransfer{{MemberId: carolID.String(), Amount: 3000}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, bobID.String(), removal.MemberID)
+		transfers := removal.Transfers
+		remaining := &groupv1.Group{Members: []*groupv1.Member{group.Members[0], group.Members[2]}}
+		balances := memberBalances(remaining, append([]*domain.Expense{dinner}, transfers...))
+		assert.Equal(t, []algorithm.Balance{
+			{MemberID: aliceID.String(), Name: "Alice", Amount: 6000},
+			{MemberID: carolID.String(), Name: "Carol", Amount: -6000},
+		}, balances)
+	})
+
+	t.Run("redistribution does not add up", func(t *testing.T) {
+		service, mockGroupRepo, _ := newService(dinner)
+
+		_, err := service.RemoveMember(context.Background(), &groupv1.RemoveMemberRequest{
+			GroupId:        groupID.String(),
+			MemberId:       aliceID.String(),
+			Redistribution: []*groupv1.BalanceTransfer{{MemberId: bobID.String(), Amount: 5000}},
+		})
+
+		assert.EqualError(t, err, "redistribution: 引き継ぐ金額の合計（5000）が残高（6000）と一致しません")
+		mockGroupRepo.AssertNotCalled(t, "RemoveMember", mock.Anything)
+	})
+
+	t.Run("part of a pending expense", func(t *testing.T) {
+		pending := *dinner
+		pending.ApprovalStatus = domain.ApprovalStatusPending
+		service, _, _ := newService(&pending)
+
+		_, err := service.RemoveMember(context.Background(), &groupv1.RemoveMemberRequest{
+			GroupId:  groupID.String(),
+			MemberId: carolID.String(),
+		})
+
+		assert.EqualError(t, err, "承認待ちの支払いに含まれるメンバーは削除できません")
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -20,6 +21,11 @@ func (m *MockExpenseRepository) Create(ctx context.Context, expense *domain.Expe
 	return args.Error(0)
 }
 
+func (m *MockExpenseRepository) CreateBatch(ctx context.Context, expenses []*domain.Expense) error {
+	args := m.Called(ctx, expenses)
+	return args.Error(0)
+}
+
 func (m *MockExpenseRepository) FindByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error) {
 	args := m.Called(ctx, groupID)
 	if args.Get(0) == nil {
@@ -28,6 +34,14 @@ func (m *MockExpenseRepository) FindByGroupID(ctx context.Context, groupID uuid.
 	return args.Get(0).([]*domain.Expense), args.Error(1)
 }
 
+func (m *MockExpenseRepository) FindByQuery(ctx context.Context, query domain.ExpenseQuery) ([]*domain.Expense, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Expense), args.Error(1)
+}
+
 func (m *MockExpenseRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -36,16 +50,165 @@ func (m *MockExpenseRepository) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return args.Get(0).(*domain.Expense), args.Error(1)
 }
 
-func (m *MockExpenseRepository) Delete(ctx context.Context, id uuid.UUID) error {
+func (m *MockExpenseRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy *uuid.UUID) error {
+	args := m.Called(ctx, id, deletedBy)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) FindDeletedByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.Expense, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Expense), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*domain.Expense, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Expense), args.Error(1)
+}
+
+func (m *MockExpenseRepository) Restore(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
-func (m *MockExpenseRepository) Update(ctx context.Context, expense *domain.Expense) error {
-	args := m.Called(ctx, expense)
+func (m *MockExpenseRepository) PurgeDeleted(ctx context.Context, before time.Time) (int64, []string, error) {
+	args := m.Called(ctx, before)
+	keys, _ := args.Get(1).([]string)
+	return args.Get(0).(int64), keys, args.Error(2)
+}
+
+func (m *MockExpenseRepository) Update(ctx context.Context, expense *domain.Expense, change domain.ExpenseChange) error {
+	args := m.Called(ctx, expense, change)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) CreateAttachment(ctx context.Context, attachment *domain.Attachment) error {
+	args := m.Called(ctx, attachment)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) FindAttachmentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, expenseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindAttachmentByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Attachment), args.Error(1)
+}
+
+func (m *MockExpenseRepository) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
 
+func (m *MockExpenseRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) UpdateComment(ctx context.Context, comment *domain.Comment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) FindCommentsByExpenseID(ctx context.Context, expenseID uuid.UUID) ([]*domain.Comment, error) {
+	args := m.Called(ctx, expenseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Comment), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindCommentByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Comment), args.Error(1)
+}
+
+func (m *MockExpenseRepository) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) SearchExpenses(ctx context.Context, groupID uuid.UUID, text string, limit int) ([]*domain.ExpenseSearchHit, error) {
+	args := m.Called(ctx, groupID, text, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ExpenseSearchHit), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindRevisions(ctx context.Context, expenseID uuid.UUID) ([]*domain.ExpenseRevision, error) {
+	args := m.Called(ctx, expenseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ExpenseRevision), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindRevision(ctx context.Context, expenseID uuid.UUID, revision int) (*domain.ExpenseRevision, error) {
+	args := m.Called(ctx, expenseID, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExpenseRevision), args.Error(1)
+}
+
+func (m *MockExpenseRepository) CreateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) UpdateTemplate(ctx context.Context, template *domain.ExpenseTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) FindTemplatesByGroupID(ctx context.Context, groupID uuid.UUID) ([]*domain.ExpenseTemplate, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ExpenseTemplate), args.Error(1)
+}
+
+func (m *MockExpenseRepository) FindTemplateByID(ctx context.Context, id uuid.UUID) (*domain.ExpenseTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ExpenseTemplate), args.Error(1)
+}
+
+func (m *MockExpenseRepository) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) MergeExpenses(ctx context.Context, keepID, duplicateID uuid.UUID, mergedBy *uuid.UUID) error {
+	args := m.Called(ctx, keepID, duplicateID, mergedBy)
+	return args.Error(0)
+}
+
+func (m *MockExpenseRepository) SaveVote(ctx context.Context, expenseID, memberID uuid.UUID, vote domain.ApprovalVote, votedAt time.Time) (domain.ApprovalStatus, error) {
+	args := m.Called(ctx, expenseID, memberID, vote, votedAt)
+	return args.Get(0).(domain.ApprovalStatus), args.Error(1)
+}
+
 // MockGroupRepositoryInterface for testing
 type MockGroupRepositoryInterface struct {
 	mock.Mock
@@ -88,7 +251,142 @@ func (m *MockGroupRepositoryInterface) AddMember(groupId, memberName, memberEmai
 	return args.Get(0).(*groupv1.Member), args.Error(1)
 }
 
-func (m *MockGroupRepositoryInterface) RemoveMember(groupId, memberId string) error {
+func (m *MockGroupRepositoryInterface) RemoveMember(removal *domain.MemberRemoval) error {
+	args := m.Called(removal)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetAccountMappings(groupId string) ([]*groupv1.AccountMapping, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*groupv1.AccountMapping), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) ReplaceAccountMappings(groupId string, mappings []*groupv1.AccountMapping) error {
+	args := m.Called(groupId, mappings)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) SaveBankAccount(groupId string, account *domain.SealedBankAccount) error {
+	args := m.Called(groupId, account)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetBankAccounts(groupId string) ([]*domain.SealedBankAccount, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SealedBankAccount), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) DeleteBankAccount(groupId, memberId string) error {
 	args := m.Called(groupId, memberId)
 	return args.Error(0)
 }
+
+func (m *MockGroupRepositoryInterface) GetBudgets(groupId string) ([]*groupv1.Budget, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*groupv1.Budget), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) ReplaceBudgets(groupId string, budgets []*groupv1.Budget) error {
+	args := m.Called(groupId, budgets)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetGroupSettings(groupId string) (*groupv1.GroupSettings, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*groupv1.GroupSettings), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) SaveGroupSettings(groupId string, settings *groupv1.GroupSettings) error {
+	args := m.Called(groupId, settings)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) CreateClosedPeriod(period *domain.ClosedPeriod) error {
+	args := m.Called(period)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetClosedPeriods(groupId string) ([]*domain.ClosedPeriod, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ClosedPeriod), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) GetClosedPeriod(periodID string) (*domain.ClosedPeriod, error) {
+	args := m.Called(periodID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ClosedPeriod), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) ReopenClosedPeriod(periodID, reopenedBy string) error {
+	args := m.Called(periodID, reopenedBy)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetMemberActivePeriods(groupId string) ([]*domain.MemberActivePeriod, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberActivePeriod), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) SetMemberActivePeriod(groupId string, period *domain.MemberActivePeriod) error {
+	args := m.Called(groupId, period)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) MergeMembers(merge *domain.MemberMerge, combine func(expense *domain.Expense) (domain.CombinedSplit, bool)) error {
+	args := m.Called(merge, combine)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetMemberMerges(groupId string) ([]*domain.MemberMerge, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberMerge), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) GetMemberProfiles(groupId string) ([]*domain.MemberProfile, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MemberProfile), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) UpdateMember(groupId string, profile *domain.MemberProfile) error {
+	args := m.Called(groupId, profile)
+	return args.Error(0)
+}
+
+func (m *MockGroupRepositoryInterface) GetHouseholds(groupId string) ([]*domain.Household, error) {
+	args := m.Called(groupId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Household), args.Error(1)
+}
+
+func (m *MockGroupRepositoryInterface) ReplaceHouseholds(groupId string, households []*domain.Household) error {
+	args := m.Called(groupId, households)
+	return args.Error(0)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// CalculateScopedSettlements settles part of a group's saved expenses, such
+// as one leg of a trip, chosen by a date range and/or expense IDs. Balances
+// only reflect the expenses in scope.
+func (s *GroupService) CalculateScopedSettlements(ctx context.Context, req *groupv1.CalculateScopedSettlementsRequest) (*groupv1.CalculateScopedSettlementsResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	query := domain.ExpenseQuery{GroupID: groupID, Ascending: true}
+	if req.CreatedFrom != nil {
+		from := req.CreatedFrom.AsTime()
+		query.CreatedFrom = &from
+	}
+	if req.CreatedTo != nil {
+		to := req.CreatedTo.AsTime()
+		query.CreatedTo = &to
+	}
+	if query.CreatedFrom != nil && query.CreatedTo != nil && !query.CreatedFrom.Before(*query.CreatedTo) {
+		return nil, validator.ValidationError{Field: "createdTo", Message: "日付の範囲が無効です"}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.ExpenseIds))
+	for _, id := range req.ExpenseIds {
+		if err := validator.ValidateUUID(id); err != nil {
+			return nil, errors.New("支払いIDが無効です")
+		}
+		expenseID := uuid.MustParse(id)
+		if !seen[expenseID] {
+			seen[expenseID] = true
+			query.IDs = append(query.IDs, expenseID)
+		}
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.FindByQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Expense IDs outside the group, deleted or outside the date range are
+	// refused rather than silently settling less than was asked for
+	if len(query.IDs) > 0 && len(expenses) != len(query.IDs) {
+		return nil, errors.New("指定した支払いが見つかりません")
+	}
+
+	// Likewise for expenses asked for by ID that do not count yet; a date
+	// range only settles the approved ones
+	if len(query.IDs) > 0 {
+		for _, expense := range expenses {
+			if !expense.IsApproved() {
+				return nil, errors.New("承認されていない支払いは精算できません")
+			}
+		}
+	}
+
+	expenses = approvedExpenses(expenses)
+	balances := memberBalances(group, expenses)
+
+	settlements, err := algorithm.CalculateOptimalSettlements(balances)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &groupv1.CalculateScopedSettlementsResponse{
+		Settlements:  make([]*groupv1.Settlement, len(settlements)),
+		Balances:     make([]*groupv1.MemberBalance, len(balances)),
+		ExpenseCount: int32(len(expenses)),
+	}
+	for i, settlement := range settlements {
+		resp.Settlements[i] = &groupv1.Settlement{
+			FromMemberId: settlement.FromMemberID,
+			ToMemberId:   settlement.ToMemberID,
+			Amount:       settlement.Amount,
+			FromName:     settlement.FromName,
+			ToName:       settlement.ToName,
+		}
+	}
+	for i, balance := range balances {
+		resp.Balances[i] = &groupv1.MemberBalance{
+			MemberId:   balance.MemberID,
+			MemberName: balance.Name,
+			Balance:    balance.Amount,
+		}
+	}
+	for _, expense := range expenses {
+		switch expense.Kind {
+		case domain.ExpenseKindIncome:
+			resp.TotalSpent -= expense.Amount
+		case domain.ExpenseKindPayment:
+		default:
+			resp.TotalSpent += expense.Amount
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_CalculateScopedSettlements(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	group := &groupv1.Group{
+		Id: groupID.String(),
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+	from := time.Date(2024, 8, 10, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 8, 13, 0, 0, 0, 0, time.UTC)
+
+	expense := func(payerID uuid.UUID, amount int64, kind domain.ExpenseKind, status domain.ApprovalStatus) *domain.Expense {
+		return &domain.Expense{
+			ID:             uuid.New(),
+			GroupID:        groupID,
+			Amount:         amount,
+			Kind:           kind,
+			PaidByID:       payerID,
+			CreatedAt:      from.Add(time.Hour),
+			ApprovalStatus: status,
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, Amount: amount / 2},
+				{MemberID: bobID, Amount: amount / 2},
+			},
+		}
+	}
+
+	t.Run("date range", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByQuery", mock.Anything, mock.MatchedBy(func(q domain.ExpenseQuery) bool {
+			return q.GroupID == groupID && q.CreatedFrom.Equal(from) && q.CreatedTo.Equal(to) && len(q.IDs) == 0
+		})).Return([]*domain.Expense{
+			expense(aliceID, 6000, domain.ExpenseKindExpense, domain.ApprovalStatusApproved),
+			expense(bobID, 1000, domain.ExpenseKindIncome, domain.ApprovalStatusApproved),
+			expense(bobID, 9000, domain.ExpenseKindExpense, domain.ApprovalStatusPending),
+		}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:     groupID.String(),
+			CreatedFrom: timestamppb.New(from),
+			CreatedTo:   timestamppb.New(to),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), resp.ExpenseCount)
+		assert.Equal(t, int64(5000), resp.TotalSpent)
+		require.Len(t, resp.Balances, 2)
+		// Bob owes 3000 of Alice's payment plus Alice's 500 of the income he received
+		assert.Equal(t, int64(3500), resp.Balances[0].Balance)
+		assert.Equal(t, int64(-3500), resp.Balances[1].Balance)
+		require.Len(t, resp.Settlements, 1)
+		assert.Equal(t, bobID.String(), resp.Settlements[0].FromMemberId)
+		assert.Equal(t, int64(3500), resp.Settlements[0].Amount)
+	})
+
+	t.Run("expense IDs", func(t *testing.T) {
+		selected := expense(aliceID, 4000, domain.ExpenseKindExpense, domain.ApprovalStatusApproved)
+
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByQuery", mock.Anything, mock.MatchedBy(func(q domain.ExpenseQuery) bool {
+			return len(q.IDs) == 1 && q.IDs[0] == selected.ID && q.CreatedFrom == nil
+		})).Return([]*domain.Expense{selected}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		resp, err := service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:    groupID.String(),
+			ExpenseIds: []string{selected.ID.String(), selected.ID.String()},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.ExpenseCount)
+		assert.Equal(t, int64(2000), resp.Balances[0].Balance)
+	})
+
+	t.Run("unknown expense ID", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByQuery", mock.Anything, mock.Anything).Return([]*domain.Expense{}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		_, err := service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:    groupID.String(),
+			ExpenseIds: []string{uuid.New().String()},
+		})
+
+		assert.EqualError(t, err, "指定した支払いが見つかりません")
+	})
+
+	t.Run("pending expense ID", func(t *testing.T) {
+		pending := expense(bobID, 9000, domain.ExpenseKindExpense, domain.ApprovalStatusPending)
+
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockGroupRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByQuery", mock.Anything, mock.Anything).Return([]*domain.Expense{pending}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+		_, err := service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:    groupID.String(),
+			ExpenseIds: []string{pending.ID.String()},
+		})
+
+		assert.EqualError(t, err, "承認されていない支払いは精算できません")
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+		_, err := service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:     groupID.String(),
+			CreatedFrom: timestamppb.New(to),
+			CreatedTo:   timestamppb.New(from),
+		})
+		assert.EqualError(t, err, "createdTo: 日付の範囲が無効です")
+
+		_, err = service.CalculateScopedSettlements(context.Background(), &groupv1.CalculateScopedSettlementsRequest{
+			GroupId:    groupID.String(),
+			ExpenseIds: []string{"osaka"},
+		})
+		assert.EqualError(t, err, "支払いIDが無効です")
+	})
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/algorithm"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/report"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+)
+
+// RenderSettlementReport renders a printable summary of a group: the total
+// spent, what each member paid and consumed, and the transfers that settle
+// the group. Deleted expenses are left out.
+func (s *GroupService) RenderSettlementReport(ctx context.Context, req *groupv1.RenderSettlementReportRequest) (*groupv1.RenderSettlementReportResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	var extension, contentType string
+	switch req.Format {
+	case groupv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED, groupv1.ReportFormat_REPORT_FORMAT_HTML:
+		extension, contentType = "html", "text/html; charset=utf-8"
+	case groupv1.ReportFormat_REPORT_FORMAT_PDF:
+		extension, contentType = "pdf", "application/pdf"
+	default:
+		return nil, validator.ValidationError{Field: "format", Message: "出力形式が無効です"}
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	group, err := s.repo.GetGroupByID(req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := s.expenseRepo.FindByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	// Listed newest first
+	slices.Reverse(expenses)
+
+	settlementReport, err := buildSettlementReport(group, expenses)
+	if err != nil {
+		return nil, err
+	}
+	settlementReport.GeneratedAt = time.Now()
+
+	var content bytes.Buffer
+	if extension == "pdf" {
+		err = report.RenderPDF(&content, settlementReport)
+	} else {
+		err = report.RenderHTML(&content, settlementReport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.RenderSettlementReportResponse{
+		FileName:    fmt.Sprintf("%s_精算レポート_%s.%s", group.Name, settlementReport.GeneratedAt.Format("20060102"), extension),
+		ContentType: contentType,
+		Content:     content.Bytes(),
+	}, nil
+}
+
+// buildSettlementReport sums up the expenses, listed oldest first, per member.
+// For every member Paid - Consumed + Settled equals their balance.
+func buildSettlementReport(group *groupv1.Group, expenses []*domain.Expense) (*report.Report, error) {
+	// Totals have to add up to the balances
+	expenses = approvedExpenses(expenses)
+
+	r := &report.Report{
+		GroupName:    group.Name,
+		Description:  group.Description,
+		Currency:     group.Currency,
+		ExpenseCount: len(expenses),
+	}
+	if len(expenses) > 0 {
+		r.From = expenses[0].CreatedAt.Local()
+		r.To = expenses[len(expenses)-1].CreatedAt.Local()
+	}
+
+	index := make(map[string]int, len(group.Members))
+	r.Members = make([]report.MemberSummary, len(group.Members))
+	for i, member := range group.Members {
+		index[member.Id] = i
+		r.Members[i].Name = member.Name
+	}
+	// Expenses may still name members who have since left the group
+	summary := func(memberID string) *report.MemberSummary {
+		if i, ok := index[memberID]; ok {
+			return &r.Members[i]
+		}
+		return &report.MemberSummary{}
+	}
+
+	for i, expense := range algorithmExpenses(expenses) {
+		kind := expenses[i].Kind
+		switch kind {
+		case domain.ExpenseKindPayment:
+			summary(expense.PayerID).Settled += expense.Amount
+			for _, memberID := range expense.SplitBetween {
+				summary(memberID).Settled -= expense.Amount
+			}
+			continue
+		case domain.ExpenseKindIncome:
+			r.TotalSpent -= expense.Amount
+			summary(expense.PayerID).Paid -= expense.Amount
+		default:
+			r.TotalSpent += expense.Amount
+			summary(expense.PayerID).Paid += expense.Amount
+		}
+
+		for j, share := range expense.Shares() {
+			if kind == domain.ExpenseKindIncome {
+				share = -share
+			}
+			summary(expense.SplitBetween[j]).Consumed += share
+		}
+	}
+
+	balances := memberBalances(group, expenses)
+	for i, balance := range balances {
+		r.Members[i].Net = balance.Amount
+	}
+
+	settlements, err := algorithm.CalculateOptimalSettlements(balances)
+	if err != nil {
+		return nil, err
+	}
+	for _, settlement := range settlements {
+		r.Transfers = append(r.Transfers, report.Transfer{
+			From:   settlement.FromName,
+			To:     settlement.ToName,
+			Amount: settlement.Amount,
+		})
+	}
+
+	return r, nil
+}
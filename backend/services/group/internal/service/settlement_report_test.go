@@ -0,0 +1,158 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/report"
+)
+
+func TestBuildSettlementReport(t *testing.T) {
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:          uuid.New().String(),
+		Name:        "旅行",
+		Description: "沖縄",
+		Currency:    "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+			{Id: carolID.String(), Name: "Carol"},
+		},
+	}
+
+	expenses := []*domain.Expense{
+		{
+			Amount:   9001,
+			Kind:     domain.ExpenseKindExpense,
+			PaidByID: aliceID,
+			// Equal splits store the rounded-down share
+			SplitMembers: []domain.SplitMember{
+				{MemberID: aliceID, Amount: 3000},
+				{MemberID: bobID, Amount: 3000},
+				{MemberID: carolID, Amount: 3000},
+			},
+			CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       600,
+			Kind:         domain.ExpenseKindIncome,
+			PaidByID:     bobID,
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, Amount: 300}, {MemberID: bobID, Amount: 300}},
+			CreatedAt:    time.Date(2024, 5, 2, 12, 0, 0, 0, time.Local),
+		},
+		{
+			Amount:       1000,
+			Kind:         domain.ExpenseKindPayment,
+			PaidByID:     carolID,
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, Amount: 1000}},
+			CreatedAt:    time.Date(2024, 5, 3, 12, 0, 0, 0, time.Local),
+		},
+	}
+
+	r, err := buildSettlementReport(group, expenses)
+	require.NoError(t, err)
+
+	assert.Equal(t, "旅行", r.GroupName)
+	assert.Equal(t, 3, r.ExpenseCount)
+	assert.Equal(t, int64(8401), r.TotalSpent)
+	assert.Equal(t, 1, r.From.Day())
+	assert.Equal(t, 3, r.To.Day())
+
+	assert.Equal(t, []report.MemberSummary{
+		{Name: "Alice", Paid: 9001, Consumed: 2701, Settled: -1000, Net: 5300},
+		{Name: "Bob", Paid: -600, Consumed: 2700, Settled: 0, Net: -3300},
+		{Name: "Carol", Paid: 0, Consumed: 3000, Settled: 1000, Net: -2000},
+	}, r.Members)
+	for _, member := range r.Members {
+		assert.Equal(t, member.Net, member.Paid-member.Consumed+member.Settled, member.Name)
+	}
+
+	assert.Equal(t, []report.Transfer{
+		{From: "Bob", To: "Alice", Amount: 3300},
+		{From: "Carol", To: "Alice", Amount: 2000},
+	}, r.Transfers)
+}
+
+func TestGroupService_RenderSettlementReport(t *testing.T) {
+	groupID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+
+	group := &groupv1.Group{
+		Id:       groupID.String(),
+		Name:     "旅行",
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: aliceID.String(), Name: "Alice"},
+			{Id: bobID.String(), Name: "Bob"},
+		},
+	}
+	expenses := []*domain.Expense{
+		{
+			Amount:       2000,
+			Kind:         domain.ExpenseKindExpense,
+			PaidByID:     aliceID,
+			SplitMembers: []domain.SplitMember{{MemberID: aliceID, Amount: 1000}, {MemberID: bobID, Amount: 1000}},
+			CreatedAt:    time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local),
+		},
+	}
+
+	render := func(t *testing.T, format groupv1.ReportFormat) *groupv1.RenderSettlementReportResponse {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockRepo.On("GetGroupByID", groupID.String()).Return(group, nil)
+		mockExpenseRepo.On("FindByGroupID", mock.Anything, groupID).Return(append([]*domain.Expense(nil), expenses...), nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.RenderSettlementReport(context.Background(), &groupv1.RenderSettlementReportRequest{
+			GroupId: groupID.String(),
+			Format:  format,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("HTML by default", func(t *testing.T) {
+		resp := render(t, groupv1.ReportFormat_REPORT_FORMAT_UNSPECIFIED)
+
+		assert.Equal(t, "text/html; charset=utf-8", resp.ContentType)
+		assert.True(t, strings.HasPrefix(resp.FileName, "旅行_精算レポート_"))
+		assert.True(t, strings.HasSuffix(resp.FileName, ".html"))
+		assert.Contains(t, string(resp.Content), "<td>Bob</td><td>Alice</td><td class=\"amount\">¥1,000</td>")
+	})
+
+	t.Run("PDF", func(t *testing.T) {
+		resp := render(t, groupv1.ReportFormat_REPORT_FORMAT_PDF)
+
+		assert.Equal(t, "application/pdf", resp.ContentType)
+		assert.True(t, strings.HasSuffix(resp.FileName, ".pdf"))
+		assert.True(t, bytes.HasPrefix(resp.Content, []byte("%PDF-")))
+	})
+}
+
+func TestGroupService_RenderSettlementReport_Invalid(t *testing.T) {
+	service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+
+	_, err := service.RenderSettlementReport(context.Background(), &groupv1.RenderSettlementReportRequest{GroupId: "invalid"})
+	assert.EqualError(t, err, "グループIDが無効です")
+
+	_, err = service.RenderSettlementReport(context.Background(), &groupv1.RenderSettlementReportRequest{
+		GroupId: uuid.New().String(),
+		Format:  groupv1.ReportFormat(99),
+	})
+	assert.EqualError(t, err, "format: 出力形式が無効です")
+}
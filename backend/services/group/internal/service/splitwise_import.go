@@ -0,0 +1,521 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"golang.org/x/text/width"
+)
+
+// Columns of a Splitwise group export before the member columns
+var splitwiseColumns = []string{"Date", "Description", "Category", "Cost", "Currency"}
+
+const (
+	splitwiseTotalBalance    = "total balance"
+	splitwisePaymentCategory = "payment"
+)
+
+// splitwiseRow is one row of a Splitwise export. balances holds the net
+// change of each member's balance, positive for the members who are owed.
+type splitwiseRow struct {
+	line        int
+	date        string
+	description string
+	category    string
+	cost        string
+	currency    string
+	balances    []string
+}
+
+// splitwiseShare is the share of one member, by member column
+type splitwiseShare struct {
+	member int
+	amount int64
+}
+
+// splitwiseEntry is an expense or payment read from the export, with members
+// by column so it can be built for the group before and after it is created
+type splitwiseEntry struct {
+	line        int
+	kind        domain.ExpenseKind
+	description string
+	category    string
+	createdAt   time.Time
+	amount      int64
+	payer       int
+	shares      []splitwiseShare
+}
+
+// ImportSplitwise creates a group from a Splitwise group export, with a
+// member for each member column and the expenses with their exact shares.
+// Settle-ups become payments. The group is only created when every row is
+// valid and the balances calculated from the imported expenses equal the
+// export's total balance row.
+func (s *GroupService) ImportSplitwise(ctx context.Context, req *groupv1.ImportSplitwiseRequest) (*groupv1.ImportSplitwiseResponse, error) {
+	// 入力値検証
+	if err := validator.ValidateGroupName(req.GroupName); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateDescription(req.Description); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateImportFileSize(len(req.Csv)); err != nil {
+		return nil, err
+	}
+
+	memberNames, rows, totalRow, err := readSplitwiseExport(req.Csv)
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range memberNames {
+		memberNames[i] = strings.TrimSpace(name)
+	}
+
+	if err := validator.ValidateImportRowCount(len(rows)); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateMemberNames(memberNames); err != nil {
+		return nil, err
+	}
+
+	currency := "JPY"
+	if len(rows) > 0 {
+		currency = strings.ToUpper(strings.TrimSpace(rows[0].currency))
+	}
+	if err := validator.ValidateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	resp := &groupv1.ImportSplitwiseResponse{TotalRows: int32(len(rows))}
+	rowError := func(line int, column, message string) {
+		resp.Errors = append(resp.Errors, &groupv1.CsvRowError{
+			Line:    int32(line),
+			Column:  column,
+			Message: message,
+		})
+	}
+
+	var entries []splitwiseEntry
+	for i, row := range rows {
+		rowEntries, err := splitwiseRowEntries(row, currency)
+		if err != nil {
+			var validationErr validator.ValidationError
+			if errors.As(err, &validationErr) {
+				rowError(row.line, validationErr.Field, validationErr.Message)
+			} else {
+				rowError(row.line, "", err.Error())
+			}
+			continue
+		}
+		if len(rowEntries) == 0 {
+			resp.SkippedLines = append(resp.SkippedLines, int32(row.line))
+			continue
+		}
+
+		for _, entry := range rowEntries {
+			// Keep the file order among rows of the same day
+			entry.createdAt = entry.createdAt.Add(time.Duration(i) * time.Microsecond)
+			entries = append(entries, entry)
+		}
+	}
+
+	// Splitwise's balances, from the total balance row when the export has one
+	splitwiseBalances := make([]int64, len(memberNames))
+	if totalRow != nil {
+		for i := range memberNames {
+			if splitwiseBalances[i], err = parseSplitwiseAmount(totalRow.balances[i], currency); err != nil {
+				rowError(totalRow.line, memberNames[i], "残高を数値として読み取れません")
+			}
+		}
+	} else {
+		for _, entry := range entries {
+			splitwiseBalances[entry.payer] += entry.amount
+			for _, share := range entry.shares {
+				splitwiseBalances[share.member] -= share.amount
+			}
+		}
+	}
+
+	// Build the expenses for a group that is not saved yet to check them
+	// before creating anything
+	group := &groupv1.Group{Id: uuid.New().String(), Currency: currency}
+	for _, name := range memberNames {
+		group.Members = append(group.Members, &groupv1.Member{Id: uuid.New().String(), Name: name})
+	}
+
+	expenses := make([]*domain.Expense, 0, len(entries))
+	for _, entry := range entries {
+		expense, err := entry.expense(group)
+		if err != nil {
+			var validationErr validator.ValidationError
+			if errors.As(err, &validationErr) {
+				rowError(entry.line, splitwiseColumnForField(validationErr.Field), validationErr.Message)
+			} else {
+				rowError(entry.line, "", err.Error())
+			}
+			continue
+		}
+		expenses = append(expenses, expense)
+	}
+
+	resp.Balances, resp.BalancesMatch = compareSplitwiseBalances(group, expenses, splitwiseBalances)
+
+	if req.DryRun || len(resp.Errors) > 0 || !resp.BalancesMatch {
+		resp.Expenses = toProtoExpenses(expenses)
+		return resp, nil
+	}
+
+	group, err = s.repo.CreateGroup(strings.TrimSpace(req.GroupName), req.Description, currency, memberNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range expenses {
+		if expenses[i], err = entries[i].expense(group); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.expenseRepo.CreateBatch(ctx, expenses); err != nil {
+		// Do not leave a group without its expenses behind
+		if deleteErr := s.repo.DeleteGroup(group.Id); deleteErr != nil {
+			log.Printf("failed to delete group %s after a failed Splitwise import: %v", group.Id, deleteErr)
+		}
+		return nil, err
+	}
+
+	resp.Group = group
+	resp.Expenses = toProtoExpenses(expenses)
+	resp.Balances, resp.BalancesMatch = compareSplitwiseBalances(group, expenses, splitwiseBalances)
+	resp.Committed = true
+
+	return resp, nil
+}
+
+// readSplitwiseExport reads the member names from the header, the data rows
+// and the total balance row, if any
+func readSplitwiseExport(data []byte) ([]string, []splitwiseRow, *splitwiseRow, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, nil, validator.ValidationError{Field: "csv", Message: "ヘッダー行がありません"}
+	}
+	if err != nil {
+		return nil, nil, nil, validator.ValidationError{Field: "csv", Message: "CSVを読み込めません: " + err.Error()}
+	}
+
+	if len(header) <= len(splitwiseColumns) {
+		return nil, nil, nil, validator.ValidationError{Field: "csv", Message: "Splitwiseのエクスポート形式ではありません"}
+	}
+	for i, column := range splitwiseColumns {
+		if normalizeMemberName(header[i]) != normalizeMemberName(column) {
+			return nil, nil, nil, validator.ValidationError{Field: "csv", Message: "Splitwiseのエクスポート形式ではありません"}
+		}
+	}
+	memberNames := header[len(splitwiseColumns):]
+
+	var rows []splitwiseRow
+	var totalRow *splitwiseRow
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, validator.ValidationError{Field: "csv", Message: "CSVを読み込めません: " + err.Error()}
+		}
+
+		if strings.TrimSpace(strings.Join(fields, "")) == "" {
+			continue
+		}
+
+		field := func(index int) string {
+			if index >= len(fields) {
+				return ""
+			}
+			return fields[index]
+		}
+		line, _ := reader.FieldPos(0)
+		row := splitwiseRow{
+			line:        line,
+			date:        field(0),
+			description: field(1),
+			category:    field(2),
+			cost:        field(3),
+			currency:    field(4),
+			balances:    make([]string, len(memberNames)),
+		}
+		for i := range memberNames {
+			row.balances[i] = field(len(splitwiseColumns) + i)
+		}
+
+		if normalizeMemberName(row.description) == splitwiseTotalBalance {
+			totalRow = &row
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return memberNames, rows, totalRow, nil
+}
+
+// splitwiseRowEntries converts a row to the expenses or payment that change
+// the balances the same way. A member with a positive balance change paid;
+// when only one member paid, the payer's share is the cost less what the
+// others owe them. Rows paid by several members become one expense per payer
+// covering what that payer is owed. Rows that change no balance return no
+// entries.
+func splitwiseRowEntries(row splitwiseRow, currency string) ([]splitwiseEntry, error) {
+	if !strings.EqualFold(strings.TrimSpace(row.currency), currency) {
+		return nil, validator.ValidationError{Field: "Currency", Message: fmt.Sprintf("通貨が混在しています（グループの通貨: %s）", currency)}
+	}
+
+	date, err := parseCsvDate(row.date)
+	if err != nil {
+		return nil, validator.ValidationError{Field: "Date", Message: "日付はYYYY-MM-DDの形式で入力してください"}
+	}
+
+	var creditors, debtors []int
+	balances := make([]int64, len(row.balances))
+	var total int64
+	for i, value := range row.balances {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		if balances[i], err = parseSplitwiseAmount(value, currency); err != nil {
+			return nil, errors.New("メンバーの残高を数値として読み取れません")
+		}
+		switch {
+		case balances[i] > 0:
+			creditors = append(creditors, i)
+		case balances[i] < 0:
+			debtors = append(debtors, i)
+		}
+		total += balances[i]
+	}
+
+	if total != 0 {
+		return nil, errors.New("メンバーの残高の合計が0になりません")
+	}
+	if len(creditors) == 0 {
+		return nil, nil
+	}
+
+	entry := splitwiseEntry{
+		line:        row.line,
+		kind:        domain.ExpenseKindExpense,
+		description: strings.TrimSpace(row.description),
+		category:    strings.TrimSpace(row.category),
+		createdAt:   date,
+	}
+
+	if normalizeMemberName(row.category) == splitwisePaymentCategory {
+		if len(creditors) != 1 || len(debtors) != 1 {
+			return nil, errors.New("精算は2人の間の支払いのみ取り込めます")
+		}
+		entry.kind = domain.ExpenseKindPayment
+		entry.category = ""
+		entry.amount = balances[creditors[0]]
+		entry.payer = creditors[0]
+		entry.shares = []splitwiseShare{{member: debtors[0], amount: balances[creditors[0]]}}
+		return []splitwiseEntry{entry}, nil
+	}
+
+	if len(creditors) == 1 {
+		cost, err := parseSplitwiseAmount(row.cost, currency)
+		if err != nil {
+			return nil, validator.ValidationError{Field: "Cost", Message: "金額を数値として読み取れません"}
+		}
+
+		payer := creditors[0]
+		payerShare := cost - balances[payer]
+		if payerShare < 0 {
+			return nil, validator.ValidationError{Field: "Cost", Message: "支払者の残高が金額を超えています"}
+		}
+
+		entry.amount = cost
+		entry.payer = payer
+		for i, balance := range balances {
+			switch {
+			case i == payer && payerShare > 0:
+				entry.shares = append(entry.shares, splitwiseShare{member: i, amount: payerShare})
+			case balance < 0:
+				entry.shares = append(entry.shares, splitwiseShare{member: i, amount: -balance})
+			}
+		}
+		return []splitwiseEntry{entry}, nil
+	}
+
+	// Several payers: settle each payer's amount from the debtors in column order
+	var entries []splitwiseEntry
+	owed := make([]int64, len(balances))
+	for _, debtor := range debtors {
+		owed[debtor] = -balances[debtor]
+	}
+	next := 0
+	for _, creditor := range creditors {
+		payerEntry := entry
+		payerEntry.amount = balances[creditor]
+		payerEntry.payer = creditor
+		payerEntry.shares = nil
+
+		remaining := balances[creditor]
+		for remaining > 0 {
+			debtor := debtors[next]
+			amount := min(remaining, owed[debtor])
+			payerEntry.shares = append(payerEntry.shares, splitwiseShare{member: debtor, amount: amount})
+			owed[debtor] -= amount
+			remaining -= amount
+			if owed[debtor] == 0 {
+				next++
+			}
+		}
+		entries = append(entries, payerEntry)
+	}
+
+	return entries, nil
+}
+
+// expense builds the domain expense of the entry for a group whose members
+// are in the export's member column order
+func (e splitwiseEntry) expense(group *groupv1.Group) (*domain.Expense, error) {
+	groupID, err := uuid.Parse(group.Id)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	payer := group.Members[e.payer]
+	splitMemberIds := make([]string, len(e.shares))
+	for i, share := range e.shares {
+		splitMemberIds[i] = group.Members[share.member].Id
+	}
+
+	if err := validateExpenseEntry(e.kind, e.amount, e.description, payer.Id, splitMemberIds); err != nil {
+		return nil, err
+	}
+
+	if err := validator.ValidateExpenseCategory(e.category); err != nil {
+		return nil, err
+	}
+
+	paidByID, err := uuid.Parse(payer.Id)
+	if err != nil {
+		return nil, errors.New("invalid paid by ID")
+	}
+
+	splitMembers := make([]domain.SplitMember, len(e.shares))
+	for i, share := range e.shares {
+		member := group.Members[share.member]
+		memberID, err := uuid.Parse(member.Id)
+		if err != nil {
+			return nil, errors.New("invalid member ID: " + member.Id)
+		}
+		splitMembers[i] = domain.SplitMember{
+			MemberID:   memberID,
+			MemberName: member.Name,
+			Amount:     share.amount,
+		}
+	}
+
+	return &domain.Expense{
+		ID:           uuid.New(),
+		GroupID:      groupID,
+		Amount:       e.amount,
+		Description:  e.description,
+		Category:     e.category,
+		Kind:         e.kind,
+		Currency:     group.Currency,
+		PaidByID:     paidByID,
+		PaidByName:   payer.Name,
+		SplitMembers: splitMembers,
+		CreatedAt:    e.createdAt,
+		UpdatedAt:    e.createdAt,
+		// Settled in Splitwise already
+		ApprovalStatus: domain.ApprovalStatusApproved,
+	}, nil
+}
+
+// compareSplitwiseBalances calculates the balance of each member from the
+// imported expenses, the same way settlements are calculated, and compares it
+// with Splitwise's
+func compareSplitwiseBalances(group *groupv1.Group, expenses []*domain.Expense, splitwiseBalances []int64) ([]*groupv1.SplitwiseBalance, bool) {
+	match := true
+	balances := make([]*groupv1.SplitwiseBalance, len(group.Members))
+	for i, balance := range memberBalances(group, expenses) {
+		balances[i] = &groupv1.SplitwiseBalance{
+			MemberId:         balance.MemberID,
+			MemberName:       balance.Name,
+			ImportedBalance:  balance.Amount,
+			SplitwiseBalance: splitwiseBalances[i],
+		}
+		if balances[i].ImportedBalance != balances[i].SplitwiseBalance {
+			match = false
+		}
+	}
+
+	return balances, match
+}
+
+// parseSplitwiseAmount reads a decimal amount such as "1200.00" or "-12.50"
+// in the smallest unit of the currency, rejecting fractions it cannot hold
+func parseSplitwiseAmount(value, currency string) (int64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(width.Fold.String(value)), ",", "")
+
+	decimals := domain.CurrencyDecimals(currency)
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) > decimals {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", value, decimals)
+	}
+	fraction += strings.Repeat("0", decimals-len(fraction))
+
+	amount, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return amount, nil
+}
+
+// splitwiseColumnForField maps a validation error field of an expense to its
+// column in the export
+func splitwiseColumnForField(field string) string {
+	switch field {
+	case "amount":
+		return "Cost"
+	case "description":
+		return "Description"
+	case "category":
+		return "Category"
+	default:
+		return ""
+	}
+}
+
+func toProtoExpenses(expenses []*domain.Expense) []*groupv1.ExpenseWithDetails {
+	protoExpenses := make([]*groupv1.ExpenseWithDetails, len(expenses))
+	for i, expense := range expenses {
+		protoExpenses[i] = toProtoExpense(expense)
+	}
+	return protoExpenses
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+)
+
+func TestGroupService_ImportSplitwise(t *testing.T) {
+	const export = "Date,Description,Category,Cost,Currency,Alice,Bob,Carol\n" +
+		"2024-05-01,Dinner,Dining out,9000.00,JPY,6000.00,-4000.00,-2000.00\n" +
+		"2024-05-02,Taxi,Taxi,3000.00,JPY,1000.00,-2000.00,1000.00\n" +
+		"2024-05-03,Own coffee,General,500.00,JPY,0.00,0.00,0.00\n" +
+		"2024-05-04,Bob paid Alice,Payment,3000.00,JPY,-3000.00,3000.00,0.00\n" +
+		"\n" +
+		"2024-05-05,Total balance, , ,JPY,4000.00,-3000.00,-1000.00\n"
+
+	group := &groupv1.Group{
+		Id:       "550e8400-e29b-41d4-a716-446655440000",
+		Name:     "沖縄旅行",
+		Currency: "JPY",
+		Members: []*groupv1.Member{
+			{Id: "550e8400-e29b-41d4-a716-446655440001", Name: "Alice"},
+			{Id: "550e8400-e29b-41d4-a716-446655440002", Name: "Bob"},
+			{Id: "550e8400-e29b-41d4-a716-446655440003", Name: "Carol"},
+		},
+	}
+
+	t.Run("creates the group with exact shares and payments", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockRepo.On("CreateGroup", "沖縄旅行", "", "JPY", []string{"Alice", "Bob", "Carol"}).Return(group, nil)
+		mockExpenseRepo.On("CreateBatch", mock.Anything, mock.MatchedBy(func(expenses []*domain.Expense) bool {
+			if len(expenses) != 4 {
+				return false
+			}
+			dinner, payment := expenses[0], expenses[3]
+			return dinner.Amount == 9000 &&
+				dinner.PaidByName == "Alice" &&
+				dinner.GroupID.String() == group.Id &&
+				len(dinner.SplitMembers) == 3 &&
+				dinner.SplitMembers[0].Amount == 3000 &&
+				dinner.SplitMembers[1].Amount == 4000 &&
+				dinner.SplitMembers[2].Amount == 2000 &&
+				// Paid by two members: one expense per payer
+				expenses[1].PaidByName == "Alice" && expenses[1].Amount == 1000 &&
+				expenses[2].PaidByName == "Carol" && expenses[2].Amount == 1000 &&
+				payment.Kind == domain.ExpenseKindPayment &&
+				payment.PaidByName == "Bob" &&
+				payment.Category == "" &&
+				len(payment.SplitMembers) == 1 &&
+				payment.SplitMembers[0].MemberName == "Alice"
+		})).Return(nil)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.ImportSplitwise(context.Background(), &groupv1.ImportSplitwiseRequest{
+			GroupName: "沖縄旅行",
+			// Names are stored trimmed, as the dry run shows them
+			Csv: []byte(strings.Replace(export, ",Alice,", ",Alice ,", 1)),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, resp.Committed)
+		assert.True(t, resp.BalancesMatch)
+		assert.Equal(t, group, resp.Group)
+		assert.Equal(t, int32(4), resp.TotalRows)
+		assert.Equal(t, []int32{4}, resp.SkippedLines)
+		assert.Empty(t, resp.Errors)
+		require.Len(t, resp.Balances, 3)
+		assert.Equal(t, group.Members[0].Id, resp.Balances[0].MemberId)
+		assert.Equal(t, int64(4000), resp.Balances[0].ImportedBalance)
+		assert.Equal(t, int64(-3000), resp.Balances[1].SplitwiseBalance)
+		assert.Equal(t, groupv1.ExpenseKind_EXPENSE_KIND_PAYMENT, resp.Expenses[3].Kind)
+		mockRepo.AssertExpectations(t)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("dry run verifies without creating anything", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.ImportSplitwise(context.Background(), &groupv1.ImportSplitwiseRequest{
+			GroupName: "沖縄旅行",
+			Csv:       []byte(export),
+			DryRun:    true,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Committed)
+		assert.True(t, resp.BalancesMatch)
+		assert.Nil(t, resp.Group)
+		assert.Len(t, resp.Expenses, 4)
+		mockRepo.AssertNotCalled(t, "CreateGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockExpenseRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("balances that differ from the total row are not imported", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		differing := strings.Replace(export, "4000.00,-3000.00,-1000.00", "4000.00,-2000.00,-2000.00", 1)
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.ImportSplitwise(context.Background(), &groupv1.ImportSplitwiseRequest{
+			GroupName: "沖縄旅行",
+			Csv:       []byte(differing),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Committed)
+		assert.False(t, resp.BalancesMatch)
+		assert.Equal(t, int64(-3000), resp.Balances[1].ImportedBalance)
+		assert.Equal(t, int64(-2000), resp.Balances[1].SplitwiseBalance)
+		mockRepo.AssertNotCalled(t, "CreateGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid rows are reported by line", func(t *testing.T) {
+		mockRepo := new(MockGroupRepositoryInterface)
+		mockExpenseRepo := new(MockExpenseRepository)
+		invalid := "Date,Description,Category,Cost,Currency,Alice,Bob\n" +
+			"2024-05-01,Dinner,Dining out,9000.00,JPY,6000.00,-4000.00\n" +
+			"2024-05-02,Hotel,Lodging,50.00,USD,25.00,-25.00\n" +
+			"2024-05-03,Lunch,Dining out,1000.50,JPY,500.50,-500.50\n"
+
+		service := NewGroupService(mockRepo, mockExpenseRepo)
+		resp, err := service.ImportSplitwise(context.Background(), &groupv1.ImportSplitwiseRequest{
+			GroupName: "沖縄旅行",
+			Csv:       []byte(invalid),
+		})
+
+		require.NoError(t, err)
+		assert.False(t, resp.Committed)
+		require.Len(t, resp.Errors, 3)
+		assert.Equal(t, int32(2), resp.Errors[0].Line)
+		assert.Contains(t, resp.Errors[0].Message, "合計が0になりません")
+		assert.Equal(t, "Currency", resp.Errors[1].Column)
+		assert.Equal(t, int32(4), resp.Errors[2].Line)
+		mockRepo.AssertNotCalled(t, "CreateGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects files that are not a Splitwise export", func(t *testing.T) {
+		service := NewGroupService(new(MockGroupRepositoryInterface), new(MockExpenseRepository))
+		_, err := service.ImportSplitwise(context.Background(), &groupv1.ImportSplitwiseRequest{
+			GroupName: "沖縄旅行",
+			Csv:       []byte("amount,description,paid_by,participants\n1000,Lunch,Alice,\n"),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Splitwiseのエクスポート形式ではありません")
+	})
+}
+
+func TestParseSplitwiseAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     int64
+		wantErr  bool
+	}{
+		{value: "1200.00", currency: "JPY", want: 1200},
+		{value: "-4000.00", currency: "JPY", want: -4000},
+		{value: "1,200", currency: "JPY", want: 1200},
+		{value: "12.5", currency: "USD", want: 1250},
+		{value: "-0.05", currency: "USD", want: -5},
+		{value: "12", currency: "EUR", want: 1200},
+		{value: "100.50", currency: "JPY", wantErr: true},
+		{value: "1.005", currency: "USD", wantErr: true},
+		{value: "", currency: "JPY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.currency, func(t *testing.T) {
+			got, err := parseSplitwiseAmount(tt.value, tt.currency)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
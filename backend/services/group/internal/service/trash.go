@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/validator"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WithTrashRetention sets how long deleted expenses are kept before
+// PurgeDeletedExpenses removes them. Zero keeps them forever.
+func WithTrashRetention(retention time.Duration) Option {
+	return func(s *GroupService) {
+		s.trashRetention = retention
+	}
+}
+
+func (s *GroupService) ListDeletedExpenses(ctx context.Context, req *groupv1.ListDeletedExpensesRequest) (*groupv1.ListDeletedExpensesResponse, error) {
+	if err := validator.ValidateUUID(req.GroupId); err != nil {
+		return nil, errors.New("グループIDが無効です")
+	}
+
+	groupID, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		return nil, errors.New("invalid group ID")
+	}
+
+	expenses, err := s.expenseRepo.FindDeletedByGroupID(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoExpenses := make([]*groupv1.DeletedExpense, len(expenses))
+	for i, expense := range expenses {
+		deleted := &groupv1.DeletedExpense{
+			Expense:       toProtoExpense(expense),
+			DeletedByName: expense.DeletedByName,
+		}
+		if expense.DeletedAt != nil {
+			deleted.DeletedAt = timestamppb.New(*expense.DeletedAt)
+			if s.trashRetention > 0 {
+				deleted.PurgeAt = timestamppb.New(expense.DeletedAt.Add(s.trashRetention))
+			}
+		}
+		if expense.DeletedByID != nil {
+			deleted.DeletedById = expense.DeletedByID.String()
+		}
+		protoExpenses[i] = deleted
+	}
+
+	return &groupv1.ListDeletedExpensesResponse{
+		Expenses: protoExpenses,
+	}, nil
+}
+
+func (s *GroupService) RestoreExpense(ctx context.Context, req *groupv1.RestoreExpenseRequest) (*groupv1.RestoreExpenseResponse, error) {
+	if err := validator.ValidateUUID(req.ExpenseId); err != nil {
+		return nil, errors.New("支払いIDが無効です")
+	}
+
+	expenseID, err := uuid.Parse(req.ExpenseId)
+	if err != nil {
+		return nil, errors.New("invalid expense ID")
+	}
+
+	trashed, err := s.expenseRepo.FindDeletedByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Coming back into a closed period would change its frozen balances
+	if err := s.checkExpenseUnlocked(trashed); err != nil {
+		return nil, err
+	}
+
+	if err := s.expenseRepo.Restore(ctx, expenseID); err != nil {
+		return nil, err
+	}
+
+	expense, err := s.expenseRepo.FindByID(ctx, expenseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupv1.RestoreExpenseResponse{
+		Expense: toProtoExpense(expense),
+	}, nil
+}
+
+// PurgeDeletedExpenses permanently removes expenses that have been in the
+// trash for longer than the retention period, including their attachment
+// files. It returns the number of purged expenses.
+func (s *GroupService) PurgeDeletedExpenses(ctx context.Context, now time.Time) (int64, error) {
+	if s.trashRetention <= 0 {
+		return 0, nil
+	}
+
+	purged, storageKeys, err := s.expenseRepo.PurgeDeleted(ctx, now.Add(-s.trashRetention))
+	if err != nil {
+		return 0, err
+	}
+
+	if s.blobStore != nil {
+		for _, key := range storageKeys {
+			s.deleteBlob(ctx, key)
+		}
+	} else if len(storageKeys) > 0 {
+		log.Printf("Purged %d attachments without a blob store; their files were left in place", len(storageKeys))
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	groupv1 "github.com/jt-chihara/warikan/backend/proto/group/v1"
+	"github.com/jt-chihara/warikan/services/group/internal/domain"
+	"github.com/jt-chihara/warikan/services/group/internal/storage"
+)
+
+func TestGroupService_DeleteExpense_RecordsDeletingMember(t *testing.T) {
+	expenseID := uuid.New()
+	memberID := uuid.New()
+
+	groupID := uuid.New()
+
+	mockGroupRepo := new(MockGroupRepositoryInterface)
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, GroupID: groupID}, nil)
+	mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+	mockExpenseRepo.On("Delete", mock.Anything, expenseID, &memberID).Return(nil)
+
+	service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+
+	resp, err := service.DeleteExpense(context.Background(), &groupv1.DeleteExpenseRequest{
+		ExpenseId: expenseID.String(),
+		DeletedBy: memberID.String(),
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	_, err = service.DeleteExpense(context.Background(), &groupv1.DeleteExpenseRequest{
+		ExpenseId: expenseID.String(),
+		DeletedBy: "alice",
+	})
+	assert.EqualError(t, err, "メンバーIDが無効です")
+	mockExpenseRepo.AssertNumberOfCalls(t, "Delete", 1)
+}
+
+func TestGroupService_ListDeletedExpenses(t *testing.T) {
+	groupID := uuid.New()
+	deletedByID := uuid.New()
+	deletedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	mockExpenseRepo := new(MockExpenseRepository)
+	mockExpenseRepo.On("FindDeletedByGroupID", mock.Anything, groupID).Return([]*domain.Expense{
+		{ID: uuid.New(), GroupID: groupID, Amount: 3000, Description: "Lunch", DeletedAt: &deletedAt, DeletedByID: &deletedByID, DeletedByName: "Bob"},
+		{ID: uuid.New(), GroupID: groupID, Amount: 1200, Description: "Coffee", DeletedAt: &deletedAt},
+	}, nil)
+
+	service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo, WithTrashRetention(30*24*time.Hour))
+
+	resp, err := service.ListDeletedExpenses(context.Background(), &groupv1.ListDeletedExpensesRequest{GroupId: groupID.String()})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Expenses, 2)
+	assert.Equal(t, "Lunch", resp.Expenses[0].Expense.Description)
+	assert.Equal(t, deletedByID.String(), resp.Expenses[0].DeletedById)
+	assert.Equal(t, "Bob", resp.Expenses[0].DeletedByName)
+	assert.True(t, deletedAt.Equal(resp.Expenses[0].DeletedAt.AsTime()))
+	assert.True(t, deletedAt.AddDate(0, 0, 30).Equal(resp.Expenses[0].PurgeAt.AsTime()))
+	assert.Empty(t, resp.Expenses[1].DeletedById)
+}
+
+func TestGroupService_RestoreExpense(t *testing.T) {
+	expenseID := uuid.New()
+	groupID := uuid.New()
+	createdAt := time.Date(2025, 5, 20, 12, 0, 0, 0, time.UTC)
+	trashed := &domain.Expense{ID: expenseID, GroupID: groupID, Description: "Lunch", CreatedAt: createdAt}
+
+	t.Run("restores and returns the expense", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return(nil, nil)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindDeletedByID", mock.Anything, expenseID).Return(trashed, nil)
+		mockExpenseRepo.On("Restore", mock.Anything, expenseID).Return(nil)
+		mockExpenseRepo.On("FindByID", mock.Anything, expenseID).Return(&domain.Expense{ID: expenseID, Description: "Lunch"}, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+		resp, err := service.RestoreExpense(context.Background(), &groupv1.RestoreExpenseRequest{ExpenseId: expenseID.String()})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Lunch", resp.Expense.Description)
+		mockExpenseRepo.AssertExpectations(t)
+	})
+
+	t.Run("expense not in the trash", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindDeletedByID", mock.Anything, expenseID).Return(nil, domain.ErrExpenseNotFound)
+
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+		resp, err := service.RestoreExpense(context.Background(), &groupv1.RestoreExpenseRequest{ExpenseId: expenseID.String()})
+
+		assert.ErrorIs(t, err, domain.ErrExpenseNotFound)
+		assert.Nil(t, resp)
+		mockExpenseRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expense dated in a closed period", func(t *testing.T) {
+		mockGroupRepo := new(MockGroupRepositoryInterface)
+		mockGroupRepo.On("GetClosedPeriods", groupID.String()).Return([]*domain.ClosedPeriod{
+			{ID: uuid.New().String(), GroupID: groupID.String(), ClosedUntil: createdAt.AddDate(0, 0, 10)},
+		}, nil)
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("FindDeletedByID", mock.Anything, expenseID).Return(trashed, nil)
+
+		service := NewGroupService(mockGroupRepo, mockExpenseRepo)
+		resp, err := service.RestoreExpense(context.Background(), &groupv1.RestoreExpenseRequest{ExpenseId: expenseID.String()})
+
+		assert.ErrorIs(t, err, domain.ErrExpenseLocked)
+		assert.Nil(t, resp)
+		mockExpenseRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGroupService_PurgeDeletedExpenses(t *testing.T) {
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("purges past the retention and removes attachment files", func(t *testing.T) {
+		blobStore, err := storage.NewLocalBlobStore(t.TempDir())
+		require.NoError(t, err)
+		key := "expenses/" + uuid.New().String() + "/" + uuid.New().String()
+		require.NoError(t, blobStore.Put(context.Background(), key, strings.NewReader("x"), "image/png"))
+
+		mockExpenseRepo := new(MockExpenseRepository)
+		mockExpenseRepo.On("PurgeDeleted", mock.Anything, now.AddDate(0, 0, -30)).Return(int64(2), []string{key}, nil)
+
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo,
+			WithBlobStore(blobStore), WithTrashRetention(30*24*time.Hour))
+
+		purged, err := service.PurgeDeletedExpenses(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+		_, err = blobStore.Get(context.Background(), key)
+		assert.Error(t, err)
+	})
+
+	t.Run("retention disabled", func(t *testing.T) {
+		mockExpenseRepo := new(MockExpenseRepository)
+		service := NewGroupService(new(MockGroupRepositoryInterface), mockExpenseRepo)
+
+		purged, err := service.PurgeDeletedExpenses(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Zero(t, purged)
+		mockExpenseRepo.AssertNotCalled(t, "PurgeDeleted", mock.Anything, mock.Anything)
+	})
+}
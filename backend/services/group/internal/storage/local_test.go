@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore_PutGetDelete(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = store.Put(ctx, "expenses/abc/receipt", strings.NewReader("receipt body"), "image/png")
+	require.NoError(t, err)
+
+	r, err := store.Get(ctx, "expenses/abc/receipt")
+	require.NoError(t, err)
+	body, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "receipt body", string(body))
+
+	require.NoError(t, store.Delete(ctx, "expenses/abc/receipt"))
+
+	_, err = store.Get(ctx, "expenses/abc/receipt")
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+
+	// Deleting a missing blob is not an error
+	assert.NoError(t, store.Delete(ctx, "expenses/abc/receipt"))
+}
+
+func TestLocalBlobStore_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../outside", "a/../../outside", "/etc/passwd"} {
+		t.Run(key, func(t *testing.T) {
+			err := store.Put(ctx, key, strings.NewReader("x"), "")
+			assert.Error(t, err)
+		})
+	}
+}
@@ -14,6 +14,21 @@ const (
 	MinExpenseAmount      = 1
 	MaxExpenseAmount      = 999999999 // 9億円まで
 	MaxMembersPerGroup    = 50
+	MaxAttachmentFileName = 255
+	MaxAttachmentSize     = 10 << 20 // 10MB
+	MaxCommentLength      = 1000
+	MaxCategoryLength     = 50
+	MaxPageSize           = 100
+	MaxSearchQueryLength  = 100
+	MaxExpensesPerBatch   = 100
+	MaxImportFileSize     = 1 << 20 // 1MB
+	MaxImportRows         = 1000
+	MaxAccountNameLength  = 100
+	MaxTemplateNameLength = 50
+	MaxSplitShares        = 100
+	MaxEmailLength        = 254
+	MaxPaymentHandle      = 100
+	MaxHouseholdName      = 50
 )
 
 var (
@@ -27,8 +42,33 @@ var (
 		"KRW": true,
 	}
 
+	// 添付可能なファイル形式
+	validAttachmentContentTypes = map[string]bool{
+		"image/jpeg":      true,
+		"image/png":       true,
+		"image/webp":      true,
+		"image/heic":      true,
+		"application/pdf": true,
+	}
+
 	// 危険な文字をチェックする正規表現
 	dangerousCharsRegex = regexp.MustCompile(`[<>\"'&]`)
+
+	// コメントは会話文なので引用符や&は許可し、タグのみ拒否する
+	commentDangerousCharsRegex = regexp.MustCompile(`[<>]`)
+
+	// 全銀協フォーマットのコード
+	bankCodeRegex      = regexp.MustCompile(`^[0-9]{4}$`)
+	branchCodeRegex    = regexp.MustCompile(`^[0-9]{3}$`)
+	accountNumberRegex = regexp.MustCompile(`^[0-9]{1,7}$`)
+	requesterCodeRegex = regexp.MustCompile(`^[0-9]{10}$`)
+
+	// メンバーのプロフィール
+	emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	colorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+	// 振込先にできる預金種目（普通・当座・貯蓄）
+	validAccountTypes = map[int32]bool{1: true, 2: true, 4: true}
 )
 
 // ValidationError は検証エラーを表す
@@ -226,4 +266,428 @@ func ValidateSplitMemberIds(memberIds []string) error {
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// ValidateAttachmentFileName 添付ファイル名を検証
+func ValidateAttachmentFileName(fileName string) error {
+	fileName = strings.TrimSpace(fileName)
+
+	if fileName == "" {
+		return ValidationError{Field: "fileName", Message: "ファイル名は必須です"}
+	}
+
+	if utf8.RuneCountInString(fileName) > MaxAttachmentFileName {
+		return ValidationError{Field: "fileName", Message: "ファイル名は255文字以内で入力してください"}
+	}
+
+	if strings.ContainsAny(fileName, "/\\\x00") || fileName == "." || fileName == ".." {
+		return ValidationError{Field: "fileName", Message: "ファイル名に使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidateAttachmentContentType 添付ファイルの形式を検証
+func ValidateAttachmentContentType(contentType string) error {
+	if contentType == "" {
+		return ValidationError{Field: "contentType", Message: "ファイル形式は必須です"}
+	}
+
+	if !validAttachmentContentTypes[strings.ToLower(strings.TrimSpace(contentType))] {
+		return ValidationError{Field: "contentType", Message: "サポートされていないファイル形式です（JPEG・PNG・WebP・HEIC・PDFのみ）"}
+	}
+
+	return nil
+}
+
+// ValidateAttachmentSize 添付ファイルのサイズを検証
+func ValidateAttachmentSize(size int64) error {
+	if size <= 0 {
+		return ValidationError{Field: "file", Message: "空のファイルは添付できません"}
+	}
+
+	if size > MaxAttachmentSize {
+		return ValidationError{Field: "file", Message: "ファイルサイズが大きすぎます（上限: 10MB）"}
+	}
+
+	return nil
+}
+
+// ValidateCommentBody コメント本文を検証
+func ValidateCommentBody(body string) error {
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		return ValidationError{Field: "body", Message: "コメントは必須です"}
+	}
+
+	if utf8.RuneCountInString(body) > MaxCommentLength {
+		return ValidationError{Field: "body", Message: "コメントは1000文字以内で入力してください"}
+	}
+
+	if commentDangerousCharsRegex.MatchString(body) {
+		return ValidationError{Field: "body", Message: "コメントに使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidateExpenseCategory 支払いカテゴリを検証
+func ValidateExpenseCategory(category string) error {
+	if category == "" {
+		return nil // カテゴリは任意
+	}
+
+	category = strings.TrimSpace(category)
+
+	if utf8.RuneCountInString(category) > MaxCategoryLength {
+		return ValidationError{Field: "category", Message: "カテゴリは50文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(category) {
+		return ValidationError{Field: "category", Message: "カテゴリに使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidatePageSize ページサイズを検証（0は全件取得）
+func ValidatePageSize(pageSize int32) error {
+	if pageSize < 0 || pageSize > MaxPageSize {
+		return ValidationError{Field: "pageSize", Message: "ページサイズは1〜100で指定してください"}
+	}
+
+	return nil
+}
+
+// ValidateSearchQuery 検索キーワードを検証（SQLはパラメータ化しているため文字種は制限しない）
+func ValidateSearchQuery(query string) error {
+	query = strings.TrimSpace(query)
+
+	if query == "" {
+		return ValidationError{Field: "query", Message: "検索キーワードは必須です"}
+	}
+
+	if utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		return ValidationError{Field: "query", Message: "検索キーワードは100文字以内で入力してください"}
+	}
+
+	return nil
+}
+
+// ValidateSearchLimit 検索件数を検証（0は既定の件数）
+func ValidateSearchLimit(limit int32) error {
+	if limit < 0 || limit > MaxPageSize {
+		return ValidationError{Field: "limit", Message: "検索件数は1〜100で指定してください"}
+	}
+
+	return nil
+}
+
+// ValidateExpenseBatchSize 一括登録する支払いの件数を検証
+func ValidateExpenseBatchSize(count int) error {
+	if count == 0 {
+		return ValidationError{Field: "expenses", Message: "登録する支払いがありません"}
+	}
+
+	if count > MaxExpensesPerBatch {
+		return ValidationError{Field: "expenses", Message: "一度に登録できる支払いは100件までです"}
+	}
+
+	return nil
+}
+
+// ValidateImportFileSize 取り込むCSVファイルのサイズを検証
+func ValidateImportFileSize(size int) error {
+	if size == 0 {
+		return ValidationError{Field: "csv", Message: "CSVファイルが空です"}
+	}
+
+	if size > MaxImportFileSize {
+		return ValidationError{Field: "csv", Message: "CSVファイルは1MB以内にしてください"}
+	}
+
+	return nil
+}
+
+// ValidateImportRowCount 取り込むCSVの行数を検証（ヘッダー行を除く）
+func ValidateImportRowCount(count int) error {
+	if count == 0 {
+		return ValidationError{Field: "csv", Message: "取り込む行がありません"}
+	}
+
+	if count > MaxImportRows {
+		return ValidationError{Field: "csv", Message: "一度に取り込めるのは1000行までです"}
+	}
+
+	return nil
+}
+
+// ValidateIncome 入金（返金など）を検証
+// 支払いとは逆向きのお金の流れなので、メッセージも受取人・分配先として返す
+func ValidateIncome(amount int64, description, recipientID string, splitMemberIds []string) error {
+	if amount < MinExpenseAmount {
+		return ValidationError{Field: "amount", Message: "入金額は1円以上で入力してください"}
+	}
+
+	if amount > MaxExpenseAmount {
+		return ValidationError{Field: "amount", Message: "入金額が大きすぎます（上限: 9億円）"}
+	}
+
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return ValidationError{Field: "description", Message: "入金の説明は必須です"}
+	}
+
+	if utf8.RuneCountInString(description) > MaxExpenseDescription {
+		return ValidationError{Field: "description", Message: "入金の説明は200文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(description) {
+		return ValidationError{Field: "description", Message: "入金の説明に使用できない文字が含まれています"}
+	}
+
+	if err := ValidateUUID(recipientID); err != nil {
+		return ValidationError{Field: "paidById", Message: "受取人IDが無効です"}
+	}
+
+	if len(splitMemberIds) == 0 {
+		return ValidationError{Field: "splitMemberIds", Message: "分配先は必須です"}
+	}
+
+	if err := ValidateSplitMemberIds(splitMemberIds); err != nil {
+		return err
+	}
+
+	// 受取人だけに分配する入金は残高に影響しない
+	if len(splitMemberIds) == 1 && splitMemberIds[0] == recipientID {
+		return ValidationError{Field: "splitMemberIds", Message: "受取人以外の分配先を指定してください"}
+	}
+
+	return nil
+}
+
+// ValidatePayment 精算（立て替えの返済）を検証
+// 支払者から受取人1人へのお金の移動なので、説明は任意
+func ValidatePayment(amount int64, description, payerID string, recipientIds []string) error {
+	if amount < MinExpenseAmount {
+		return ValidationError{Field: "amount", Message: "精算額は1円以上で入力してください"}
+	}
+
+	if amount > MaxExpenseAmount {
+		return ValidationError{Field: "amount", Message: "精算額が大きすぎます（上限: 9億円）"}
+	}
+
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > MaxExpenseDescription {
+		return ValidationError{Field: "description", Message: "精算の説明は200文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(description) {
+		return ValidationError{Field: "description", Message: "精算の説明に使用できない文字が含まれています"}
+	}
+
+	if err := ValidateUUID(payerID); err != nil {
+		return ValidationError{Field: "paidById", Message: "支払者IDが無効です"}
+	}
+
+	if len(recipientIds) != 1 {
+		return ValidationError{Field: "splitMemberIds", Message: "精算の受取人を1人指定してください"}
+	}
+
+	if err := ValidateUUID(recipientIds[0]); err != nil {
+		return ValidationError{Field: "splitMemberIds", Message: "受取人IDが無効です"}
+	}
+
+	if recipientIds[0] == payerID {
+		return ValidationError{Field: "splitMemberIds", Message: "支払者と受取人が同じです"}
+	}
+
+	return nil
+}
+
+// ValidateSplitAmounts 分配先ごとの分担額を検証
+// 省略時は均等割りになる。指定する場合は分配先と同じ数で、合計が金額と一致すること
+func ValidateSplitAmounts(amount int64, memberCount int, splitAmounts []int64) error {
+	if len(splitAmounts) == 0 {
+		return nil
+	}
+
+	if len(splitAmounts) != memberCount {
+		return ValidationError{Field: "splitAmounts", Message: "分担額の数が分配先の数と一致しません"}
+	}
+
+	var total int64
+	for _, splitAmount := range splitAmounts {
+		if splitAmount < 0 {
+			return ValidationError{Field: "splitAmounts", Message: "分担額は0円以上で入力してください"}
+		}
+		total += splitAmount
+	}
+
+	if total != amount {
+		return ValidationError{Field: "splitAmounts", Message: "分担額の合計が金額と一致しません"}
+	}
+
+	return nil
+}
+
+// ValidateAccountName 会計ソフトの勘定科目名を検証
+func ValidateAccountName(account string) error {
+	account = strings.TrimSpace(account)
+
+	if account == "" {
+		return ValidationError{Field: "account", Message: "勘定科目は必須です"}
+	}
+
+	if utf8.RuneCountInString(account) > MaxAccountNameLength {
+		return ValidationError{Field: "account", Message: "勘定科目は100文字以内で入力してください"}
+	}
+
+	// CSVの区切りになる文字も受け付けない
+	if dangerousCharsRegex.MatchString(account) || strings.ContainsAny(account, ",\r\n") {
+		return ValidationError{Field: "account", Message: "勘定科目に使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidateBankAccount 振込先口座の金融機関コード・支店コード・預金種目・口座番号を検証
+func ValidateBankAccount(bankCode, branchCode string, accountType int32, accountNumber string) error {
+	if !bankCodeRegex.MatchString(bankCode) {
+		return ValidationError{Field: "bankCode", Message: "金融機関コードは4桁の数字で入力してください"}
+	}
+
+	if !branchCodeRegex.MatchString(branchCode) {
+		return ValidationError{Field: "branchCode", Message: "支店コードは3桁の数字で入力してください"}
+	}
+
+	if !validAccountTypes[accountType] {
+		return ValidationError{Field: "accountType", Message: "預金種目が無効です"}
+	}
+
+	if !accountNumberRegex.MatchString(accountNumber) {
+		return ValidationError{Field: "accountNumber", Message: "口座番号は7桁以内の数字で入力してください"}
+	}
+
+	return nil
+}
+
+// ValidateRequesterCode 総合振込の依頼人コード（銀行が割り当てる10桁）を検証
+func ValidateRequesterCode(code string) error {
+	if !requesterCodeRegex.MatchString(code) {
+		return ValidationError{Field: "requesterCode", Message: "依頼人コードは10桁の数字で入力してください"}
+	}
+
+	return nil
+}
+
+// ValidateTemplateName 支払いテンプレート名を検証
+func ValidateTemplateName(name string) error {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return ValidationError{Field: "name", Message: "テンプレート名は必須です"}
+	}
+
+	if utf8.RuneCountInString(name) > MaxTemplateNameLength {
+		return ValidationError{Field: "name", Message: "テンプレート名は50文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(name) {
+		return ValidationError{Field: "name", Message: "テンプレート名に使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidateSplitShares 比率で分ける場合の分配先ごとの比率を検証
+// 分配先と同じ数で、それぞれ1以上であること
+func ValidateSplitShares(memberCount int, splitShares []int32) error {
+	if len(splitShares) != memberCount {
+		return ValidationError{Field: "splitShares", Message: "比率の数が分配先の数と一致しません"}
+	}
+
+	for _, shares := range splitShares {
+		if shares < 1 || shares > MaxSplitShares {
+			return ValidationError{Field: "splitShares", Message: "比率は1から100の整数で入力してください"}
+		}
+	}
+
+	return nil
+}
+
+// ValidateBudgetAmount 予算額を検証
+func ValidateBudgetAmount(amount int64) error {
+	if amount < MinExpenseAmount {
+		return ValidationError{Field: "amount", Message: "予算は1円以上で入力してください"}
+	}
+
+	if amount > MaxExpenseAmount {
+		return ValidationError{Field: "amount", Message: "予算が大きすぎます（上限: 9億円）"}
+	}
+
+	return nil
+}
+
+// ValidateEmail メンバーのメールアドレスを検証（空は未設定）
+func ValidateEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+
+	if len(email) > MaxEmailLength || !emailRegex.MatchString(email) {
+		return ValidationError{Field: "email", Message: "メールアドレスの形式が正しくありません"}
+	}
+
+	return nil
+}
+
+// ValidateMemberColor メンバーの表示色を検証（空は未設定、#RRGGBB形式）
+func ValidateMemberColor(color string) error {
+	if color == "" {
+		return nil
+	}
+
+	if !colorRegex.MatchString(color) {
+		return ValidationError{Field: "color", Message: "表示色は#RRGGBB形式で入力してください"}
+	}
+
+	return nil
+}
+
+// ValidatePaymentHandle PayPay IDなど送金用のIDを検証（空は未設定）
+func ValidatePaymentHandle(handle string) error {
+	if handle == "" {
+		return nil
+	}
+
+	if utf8.RuneCountInString(handle) > MaxPaymentHandle {
+		return ValidationError{Field: "paymentHandle", Message: "送金用IDは100文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(handle) || strings.ContainsAny(handle, " \t\r\n") {
+		return ValidationError{Field: "paymentHandle", Message: "送金用IDに使用できない文字が含まれています"}
+	}
+
+	return nil
+}
+
+// ValidateHouseholdName 世帯名を検証
+func ValidateHouseholdName(name string) error {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return ValidationError{Field: "name", Message: "世帯名は必須です"}
+	}
+
+	if utf8.RuneCountInString(name) > MaxHouseholdName {
+		return ValidationError{Field: "name", Message: "世帯名は50文字以内で入力してください"}
+	}
+
+	if dangerousCharsRegex.MatchString(name) {
+		return ValidationError{Field: "name", Message: "世帯名に使用できない文字が含まれています"}
+	}
+
+	return nil
+}
@@ -399,4 +399,563 @@ func TestValidateSplitMemberIds(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestValidateAttachmentFileName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid file name", input: "領収書.jpg", wantErr: false},
+		{name: "empty file name", input: "", wantErr: true},
+		{name: "too long file name", input: strings.Repeat("a", 256), wantErr: true},
+		{name: "path separator", input: "../receipt.pdf", wantErr: true},
+		{name: "windows path separator", input: "C:\\receipt.pdf", wantErr: true},
+		{name: "parent directory", input: "..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttachmentFileName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAttachmentFileName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAttachmentContentType(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "jpeg", input: "image/jpeg", wantErr: false},
+		{name: "pdf", input: "application/pdf", wantErr: false},
+		{name: "upper case", input: "IMAGE/PNG", wantErr: false},
+		{name: "empty", input: "", wantErr: true},
+		{name: "unsupported", input: "text/html", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttachmentContentType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAttachmentContentType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAttachmentSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int64
+		wantErr bool
+	}{
+		{name: "valid size", input: 1024, wantErr: false},
+		{name: "maximum size", input: MaxAttachmentSize, wantErr: false},
+		{name: "empty file", input: 0, wantErr: true},
+		{name: "too large", input: MaxAttachmentSize + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAttachmentSize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAttachmentSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCommentBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid comment", input: "飲んでいないので外してください", wantErr: false},
+		{name: "apostrophe and ampersand", input: "I didn't drink & left early", wantErr: false},
+		{name: "empty comment", input: "", wantErr: true},
+		{name: "whitespace only", input: "   ", wantErr: true},
+		{name: "too long comment", input: strings.Repeat("あ", 1001), wantErr: true},
+		{name: "markup", input: "<script>alert(1)</script>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCommentBody(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCommentBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExpenseCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid category", input: "食費", wantErr: false},
+		{name: "empty category", input: "", wantErr: false},
+		{name: "too long category", input: strings.Repeat("あ", 51), wantErr: true},
+		{name: "dangerous characters", input: "<b>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExpenseCategory(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExpenseCategory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int32
+		wantErr bool
+	}{
+		{name: "all results", input: 0, wantErr: false},
+		{name: "valid size", input: 20, wantErr: false},
+		{name: "maximum size", input: MaxPageSize, wantErr: false},
+		{name: "negative size", input: -1, wantErr: true},
+		{name: "too large", input: MaxPageSize + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePageSize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePageSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid query", input: "タクシー", wantErr: false},
+		{name: "symbols are allowed", input: "50% off <sale>", wantErr: false},
+		{name: "maximum length", input: strings.Repeat("あ", MaxSearchQueryLength), wantErr: false},
+		{name: "empty query", input: "", wantErr: true},
+		{name: "whitespace only", input: "   ", wantErr: true},
+		{name: "too long", input: strings.Repeat("あ", MaxSearchQueryLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchQuery(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSearchQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSearchLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int32
+		wantErr bool
+	}{
+		{name: "default", input: 0, wantErr: false},
+		{name: "maximum", input: MaxPageSize, wantErr: false},
+		{name: "negative", input: -1, wantErr: true},
+		{name: "too large", input: MaxPageSize + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSearchLimit(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSearchLimit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExpenseBatchSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		wantErr bool
+	}{
+		{name: "single", input: 1, wantErr: false},
+		{name: "maximum", input: MaxExpensesPerBatch, wantErr: false},
+		{name: "empty", input: 0, wantErr: true},
+		{name: "too many", input: MaxExpensesPerBatch + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExpenseBatchSize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExpenseBatchSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateImportFileSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		wantErr bool
+	}{
+		{name: "small file", input: 100, wantErr: false},
+		{name: "maximum", input: MaxImportFileSize, wantErr: false},
+		{name: "empty", input: 0, wantErr: true},
+		{name: "too large", input: MaxImportFileSize + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImportFileSize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateImportFileSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateImportRowCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   int
+		wantErr bool
+	}{
+		{name: "single row", input: 1, wantErr: false},
+		{name: "maximum", input: MaxImportRows, wantErr: false},
+		{name: "no rows", input: 0, wantErr: true},
+		{name: "too many", input: MaxImportRows + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateImportRowCount(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateImportRowCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateIncome(t *testing.T) {
+	recipientID := "550e8400-e29b-41d4-a716-446655440000"
+	otherID := "550e8400-e29b-41d4-a716-446655440001"
+
+	tests := []struct {
+		name           string
+		amount         int64
+		description    string
+		recipientID    string
+		splitMemberIds []string
+		wantErr        string
+	}{
+		{name: "valid refund", amount: 20000, description: "デポジット返金", recipientID: recipientID, splitMemberIds: []string{recipientID, otherID}},
+		{name: "other members only", amount: 20000, description: "キャンセル返金", recipientID: recipientID, splitMemberIds: []string{otherID}},
+		{name: "zero amount", amount: 0, description: "返金", recipientID: recipientID, splitMemberIds: []string{otherID}, wantErr: "入金額は1円以上"},
+		{name: "empty description", amount: 100, description: " ", recipientID: recipientID, splitMemberIds: []string{otherID}, wantErr: "入金の説明は必須です"},
+		{name: "invalid recipient", amount: 100, description: "返金", recipientID: "alice", splitMemberIds: []string{otherID}, wantErr: "受取人IDが無効です"},
+		{name: "no participants", amount: 100, description: "返金", recipientID: recipientID, wantErr: "分配先は必須です"},
+		{name: "recipient only", amount: 100, description: "返金", recipientID: recipientID, splitMemberIds: []string{recipientID}, wantErr: "受取人以外の分配先"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIncome(tt.amount, tt.description, tt.recipientID, tt.splitMemberIds)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateIncome() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateIncome() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePayment(t *testing.T) {
+	payerID := "550e8400-e29b-41d4-a716-446655440000"
+	recipientID := "550e8400-e29b-41d4-a716-446655440001"
+
+	tests := []struct {
+		name         string
+		amount       int64
+		description  string
+		payerID      string
+		recipientIds []string
+		wantErr      string
+	}{
+		{name: "valid settle-up", amount: 3000, description: "Alice paid Bob", payerID: payerID, recipientIds: []string{recipientID}},
+		{name: "description is optional", amount: 3000, payerID: payerID, recipientIds: []string{recipientID}},
+		{name: "zero amount", amount: 0, payerID: payerID, recipientIds: []string{recipientID}, wantErr: "精算額は1円以上"},
+		{name: "invalid payer", amount: 100, payerID: "alice", recipientIds: []string{recipientID}, wantErr: "支払者IDが無効です"},
+		{name: "no recipient", amount: 100, payerID: payerID, wantErr: "受取人を1人指定"},
+		{name: "several recipients", amount: 100, payerID: payerID, recipientIds: []string{recipientID, "550e8400-e29b-41d4-a716-446655440002"}, wantErr: "受取人を1人指定"},
+		{name: "paying oneself", amount: 100, payerID: payerID, recipientIds: []string{payerID}, wantErr: "支払者と受取人が同じです"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePayment(tt.amount, tt.description, tt.payerID, tt.recipientIds)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePayment() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidatePayment() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSplitAmounts(t *testing.T) {
+	tests := []struct {
+		name         string
+		amount       int64
+		memberCount  int
+		splitAmounts []int64
+		wantErr      string
+	}{
+		{name: "equal split", amount: 1000, memberCount: 3},
+		{name: "exact shares", amount: 1000, memberCount: 3, splitAmounts: []int64{500, 300, 200}},
+		{name: "zero share", amount: 1000, memberCount: 2, splitAmounts: []int64{1000, 0}},
+		{name: "count mismatch", amount: 1000, memberCount: 3, splitAmounts: []int64{500, 500}, wantErr: "分配先の数と一致しません"},
+		{name: "negative share", amount: 1000, memberCount: 2, splitAmounts: []int64{1500, -500}, wantErr: "0円以上"},
+		{name: "total mismatch", amount: 1000, memberCount: 2, splitAmounts: []int64{500, 400}, wantErr: "合計が金額と一致しません"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSplitAmounts(tt.amount, tt.memberCount, tt.splitAmounts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateSplitAmounts() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateSplitAmounts() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAccountName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid account", input: "旅費交通費", wantErr: false},
+		{name: "empty account", input: " ", wantErr: true},
+		{name: "too long account", input: strings.Repeat("あ", 101), wantErr: true},
+		{name: "dangerous characters", input: "<b>", wantErr: true},
+		{name: "comma", input: "会議費,交際費", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAccountName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAccountName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBankAccount(t *testing.T) {
+	tests := []struct {
+		name          string
+		bankCode      string
+		branchCode    string
+		accountType   int32
+		accountNumber string
+		wantField     string
+	}{
+		{name: "valid account", bankCode: "0001", branchCode: "100", accountType: 1, accountNumber: "1234567"},
+		{name: "short account number", bankCode: "9900", branchCode: "018", accountType: 4, accountNumber: "12"},
+		{name: "short bank code", bankCode: "001", branchCode: "100", accountType: 1, accountNumber: "1234567", wantField: "bankCode"},
+		{name: "letters in branch code", bankCode: "0001", branchCode: "1a0", accountType: 1, accountNumber: "1234567", wantField: "branchCode"},
+		{name: "unknown account type", bankCode: "0001", branchCode: "100", accountType: 3, accountNumber: "1234567", wantField: "accountType"},
+		{name: "long account number", bankCode: "0001", branchCode: "100", accountType: 1, accountNumber: "12345678", wantField: "accountNumber"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBankAccount(tt.bankCode, tt.branchCode, tt.accountType, tt.accountNumber)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("ValidateBankAccount() error = %v", err)
+				}
+				return
+			}
+			validationErr, ok := err.(ValidationError)
+			if !ok || validationErr.Field != tt.wantField {
+				t.Errorf("ValidateBankAccount() error = %v, want field %s", err, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestValidateRequesterCode(t *testing.T) {
+	if err := ValidateRequesterCode("0000012345"); err != nil {
+		t.Errorf("ValidateRequesterCode() error = %v", err)
+	}
+	if err := ValidateRequesterCode("12345"); err == nil {
+		t.Error("ValidateRequesterCode() expected error for 5 digits")
+	}
+}
+
+func TestValidateTemplateName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid name", input: "週末の買い出し", wantErr: false},
+		{name: "empty name", input: "  ", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", MaxTemplateNameLength+1), wantErr: true},
+		{name: "dangerous characters", input: "<b>ガソリン</b>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplateName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateTemplateName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSplitShares(t *testing.T) {
+	tests := []struct {
+		name        string
+		memberCount int
+		splitShares []int32
+		wantErr     string
+	}{
+		{name: "valid shares", memberCount: 3, splitShares: []int32{2, 1, 1}},
+		{name: "count mismatch", memberCount: 3, splitShares: []int32{2, 1}, wantErr: "分配先の数と一致しません"},
+		{name: "zero share", memberCount: 2, splitShares: []int32{1, 0}, wantErr: "1から100"},
+		{name: "too many shares", memberCount: 1, splitShares: []int32{MaxSplitShares + 1}, wantErr: "1から100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSplitShares(tt.memberCount, tt.splitShares)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateSplitShares() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateSplitShares() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBudgetAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		wantErr string
+	}{
+		{name: "valid amount", amount: 30000},
+		{name: "zero", amount: 0, wantErr: "1円以上"},
+		{name: "too large", amount: MaxExpenseAmount + 1, wantErr: "大きすぎます"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBudgetAmount(tt.amount)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateBudgetAmount() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateBudgetAmount() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMemberProfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		validate func(string) error
+		input    string
+		wantErr  string
+	}{
+		{name: "valid email", validate: ValidateEmail, input: "alice@example.com"},
+		{name: "no email", validate: ValidateEmail, input: ""},
+		{name: "email without domain", validate: ValidateEmail, input: "alice@", wantErr: "メールアドレス"},
+		{name: "email with space", validate: ValidateEmail, input: "alice @example.com", wantErr: "メールアドレス"},
+		{name: "email too long", validate: ValidateEmail, input: strings.Repeat("a", MaxEmailLength) + "@example.com", wantErr: "メールアドレス"},
+		{name: "valid color", validate: ValidateMemberColor, input: "#1e90FF"},
+		{name: "no color", validate: ValidateMemberColor, input: ""},
+		{name: "color name", validate: ValidateMemberColor, input: "red", wantErr: "#RRGGBB"},
+		{name: "short color", validate: ValidateMemberColor, input: "#fff", wantErr: "#RRGGBB"},
+		{name: "valid handle", validate: ValidatePaymentHandle, input: "@alice-01"},
+		{name: "handle too long", validate: ValidatePaymentHandle, input: strings.Repeat("a", MaxPaymentHandle+1), wantErr: "100文字以内"},
+		{name: "handle with space", validate: ValidatePaymentHandle, input: "alice paypay", wantErr: "使用できない文字"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate(tt.input)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateHouseholdName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "valid name", input: "田中家", wantErr: false},
+		{name: "empty name", input: "  ", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", MaxHouseholdName+1), wantErr: true},
+		{name: "dangerous characters", input: "<b>田中家</b>", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHouseholdName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateHouseholdName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package zengin
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "full-width katakana", input: "ヤマダ　タロウ", want: "ﾔﾏﾀﾞ ﾀﾛｳ"},
+		{name: "hiragana", input: "すずき はなこ", want: "ｽｽﾞｷ ﾊﾅｺ"},
+		{name: "semi-voiced and small kana", input: "パッキャオ", want: "ﾊﾟﾂｷﾔｵ"},
+		{name: "long vowel", input: "ジョージ", want: "ｼﾞﾖ-ｼﾞ"},
+		{name: "half-width input", input: "ｶ)ﾜﾘｶﾝ", want: "ｶ)ﾜﾘｶﾝ"},
+		{name: "letters and digits", input: "Ａｂｃ 123", want: "ABC 123"},
+		{name: "company abbreviation", input: "カ）ワリカン", want: "ｶ)ﾜﾘｶﾝ"},
+		{name: "kanji", input: "山田", wantErr: true},
+		{name: "empty", input: "　", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeName(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	header := Header{
+		RequesterCode: "1234567890",
+		RequesterName: "ｶ)ﾜﾘｶﾝ",
+		TransferDate:  time.Date(2024, 5, 25, 0, 0, 0, 0, time.Local),
+		BankCode:      "0001",
+		BankName:      "ﾐｽﾞﾎ",
+		BranchCode:    "100",
+		BranchName:    "ﾎﾝﾃﾝ",
+		AccountType:   AccountTypeChecking,
+		AccountNumber: "1234567",
+	}
+	transfers := []Transfer{
+		{BankCode: "0005", BranchCode: "001", AccountType: AccountTypeOrdinary, AccountNumber: "765", RecipientName: "ﾔﾏﾀﾞ ﾀﾛｳ", Amount: 12345},
+		{BankCode: "9900", BankName: "ﾕｳﾁﾖ", BranchCode: "018", AccountType: AccountTypeOrdinary, AccountNumber: "1111111", RecipientName: "ｽｽﾞｷ ﾊﾅｺ", Amount: 500},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, header, transfers))
+
+	assert.True(t, strings.HasSuffix(out.String(), "\r\n"))
+	records := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	require.Len(t, records, 5)
+	for _, record := range records {
+		assert.Len(t, record, recordLength)
+	}
+
+	decode := func(record string) string {
+		decoded, err := japanese.ShiftJIS.NewDecoder().String(record)
+		require.NoError(t, err)
+		return decoded
+	}
+
+	headerRecord := decode(records[0])
+	assert.Equal(t, "1210"+"1234567890", headerRecord[:14])
+	assert.Equal(t, "ｶ)ﾜﾘｶﾝ"+strings.Repeat(" ", 34)+"0525"+"0001", string([]rune(headerRecord)[14:62]))
+	assert.True(t, strings.HasSuffix(headerRecord, "21234567"+strings.Repeat(" ", 17)))
+
+	data := []rune(decode(records[1]))
+	assert.Equal(t, "20005", string(data[:5]))
+	assert.Equal(t, "001", string(data[20:23]))
+	assert.Equal(t, "1"+"0000765"+"ﾔﾏﾀﾞ ﾀﾛｳ"+strings.Repeat(" ", 22)+"0000012345"+"0", string(data[42:91]))
+
+	assert.Equal(t, "8"+"000002"+"000000012845", records[3][:19])
+	assert.Equal(t, "9"+strings.Repeat(" ", 119), records[4])
+}
+
+func TestWrite_Invalid(t *testing.T) {
+	header := Header{RequesterCode: "1234567890", RequesterName: "ﾜﾘｶﾝ", BankCode: "0001", BranchCode: "100", AccountType: AccountTypeOrdinary, AccountNumber: "1"}
+
+	err := Write(&bytes.Buffer{}, header, []Transfer{{BankCode: "0005", BranchCode: "001", AccountNumber: "1", RecipientName: strings.Repeat("ｱ", 31), Amount: 1}})
+	assert.ErrorContains(t, err, "transfer 1")
+
+	err = Write(&bytes.Buffer{}, header, []Transfer{{BankCode: "0005", BranchCode: "001", AccountNumber: "1", RecipientName: "ｱ", Amount: 0}})
+	assert.ErrorContains(t, err, "out of range")
+
+	header.RequesterName = "割勘"
+	err = Write(&bytes.Buffer{}, header, nil)
+	assert.ErrorContains(t, err, "header")
+}